@@ -17,15 +17,44 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// BackupConfig is the configuration of the chunked archive
+type BackupConfig struct {
+	// Compression is the algorithm used to compress the chunks
+	Compression Compression
+	// ChunkSize is the maximum number of blocks in a chunk
+	ChunkSize uint64
+	// ParentPath is the path to the previous archive. If set, the backup is incremental
+	// and starts from the block following the latest block of the previous archive
+	ParentPath string
+}
+
 // CreateBackup fetches blockchain data with the specific range via gRPC
-// and save this data as binary archive to given path
+// and save this data as chunked binary archive to given path
 func CreateBackup(
 	conn *grpc.ClientConn,
 	logger hclog.Logger,
 	from uint64,
 	to *uint64,
 	outPath string,
+	config *BackupConfig,
 ) (uint64, uint64, error) {
+	var parent *ParentInfo
+
+	if config.ParentPath != "" {
+		parentMetadata, err := ReadMetadata(config.ParentPath)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read the previous archive: %w", err)
+		}
+
+		parent = &ParentInfo{
+			Number: parentMetadata.Latest,
+			Hash:   parentMetadata.LatestHash,
+		}
+		from = parent.Number + 1
+
+		logger.Info("Continuing from the previous backup", "latest", parent.Number, "hash", parent.Hash)
+	}
+
 	// always create new file, throw error if the file exists
 	fs, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
 	if err != nil {
@@ -73,6 +102,27 @@ func CreateBackup(
 		return 0, 0, err
 	}
 
+	if parent != nil {
+		if err := checkParent(ctx, clt, parent); err != nil {
+			closeAndRemoveFile()
+
+			return 0, 0, err
+		}
+	}
+
+	if from > reqTo {
+		closeAndRemoveFile()
+
+		return 0, 0, fmt.Errorf("no blocks to back up, the backup starts at %d but the latest block is %d", from, reqTo)
+	}
+
+	writer, err := newChunkWriter(fs, config.Compression, config.ChunkSize)
+	if err != nil {
+		closeAndRemoveFile()
+
+		return 0, 0, err
+	}
+
 	stream, err := clt.Export(ctx, &proto.ExportRequest{
 		From: from,
 		To:   reqTo,
@@ -83,14 +133,14 @@ func CreateBackup(
 		return 0, 0, err
 	}
 
-	if err := writeMetadata(fs, logger, reqTo, reqToHash); err != nil {
+	resFrom, resTo, err := processExportStream(stream, logger, writer, from, reqTo)
+	if err != nil {
 		closeAndRemoveFile()
 
 		return 0, 0, err
 	}
 
-	resFrom, resTo, err := processExportStream(stream, logger, fs, from, reqTo)
-	if err != nil {
+	if err := writeManifest(writer, logger, reqTo, reqToHash, parent); err != nil {
 		closeAndRemoveFile()
 
 		return 0, 0, err
@@ -105,6 +155,66 @@ func CreateBackup(
 	return *resFrom, *resTo, nil
 }
 
+// ReadMetadata reads the latest block height and the block hash from the archive of any format
+func ReadMetadata(filePath string) (*Metadata, error) {
+	fp, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fp.Close()
+
+	info, err := fp.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := readManifest(fp, uint64(info.Size()))
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest != nil {
+		return manifest.Metadata(), nil
+	}
+
+	metadata, err := newBlockStream(fp).getMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata == nil {
+		return nil, errors.New("expected metadata in archive but doesn't exist")
+	}
+
+	return metadata, nil
+}
+
+// checkParent checks the node has the latest block of the previous archive,
+// so the incremental backup continues the same chain
+func checkParent(ctx context.Context, clt proto.SystemClient, parent *ParentInfo) error {
+	resp, err := clt.BlockByNumber(ctx, &proto.BlockByNumberRequest{Number: parent.Number})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest block of the previous archive: %w", err)
+	}
+
+	block := types.Block{}
+	if err := block.UnmarshalRLP(resp.Data); err != nil {
+		return err
+	}
+
+	if block.Hash() != parent.Hash {
+		return fmt.Errorf(
+			"the hash of block %d (%s) doesn't match the previous archive (%s)",
+			parent.Number,
+			block.Hash(),
+			parent.Hash,
+		)
+	}
+
+	return nil
+}
+
 func determineTo(ctx context.Context, clt proto.SystemClient, to *uint64) (uint64, types.Hash, error) {
 	status, err := clt.GetStatus(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -127,27 +237,30 @@ func determineTo(ctx context.Context, clt proto.SystemClient, to *uint64) (uint6
 	return uint64(status.Current.Number), types.StringToHash(status.Current.Hash), nil
 }
 
-// writeMetadata writes the latest block height and the block hash to the writer
-func writeMetadata(writer io.Writer, logger hclog.Logger, to uint64, toHash types.Hash) error {
-	metadata := Metadata{
+// writeManifest writes the manifest with the latest block height and the block hash to the archive
+func writeManifest(writer *chunkWriter, logger hclog.Logger, to uint64, toHash types.Hash, parent *ParentInfo) error {
+	if err := writer.writeManifest(&Manifest{
 		Latest:     to,
 		LatestHash: toHash,
-	}
-
-	_, err := writer.Write(metadata.MarshalRLP())
-	if err != nil {
+		Parent:     parent,
+	}); err != nil {
 		return err
 	}
 
-	logger.Info("Wrote metadata to backup", "latest", to, "hash", toHash)
+	logger.Info("Wrote manifest to backup", "latest", to, "hash", toHash, "chunks", len(writer.chunks))
+
+	return nil
+}
 
-	return err
+// blockWriter writes the exported blocks to the archive
+type blockWriter interface {
+	writeBlocks(from, to uint64, data []byte) error
 }
 
 func processExportStream(
 	stream proto.System_ExportClient,
 	logger hclog.Logger,
-	writer io.Writer,
+	writer blockWriter,
 	targetFrom, targetTo uint64,
 ) (*uint64, *uint64, error) {
 	var from, to *uint64
@@ -193,7 +306,7 @@ func processExportStream(
 			return nil, nil, err
 		}
 
-		if _, err := writer.Write(event.Data); err != nil {
+		if err := writer.writeBlocks(event.From, event.To, event.Data); err != nil {
 			return nil, nil, err
 		}
 
@@ -1,7 +1,6 @@
 package archive
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -71,6 +70,16 @@ func init() {
 	}
 }
 
+type mockBlockWriter struct {
+	data []byte
+}
+
+func (w *mockBlockWriter) writeBlocks(_, _ uint64, data []byte) error {
+	w.data = append(w.data, data...)
+
+	return nil
+}
+
 type systemClientMock struct {
 	proto.SystemClient
 	status       *proto.ServerStatus
@@ -251,8 +260,8 @@ func Test_processExportStream(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var buffer bytes.Buffer
-			from, to, err := processExportStream(tt.mockSystemExportClient, hclog.NewNullLogger(), &buffer, 0, 0)
+			writer := &mockBlockWriter{}
+			from, to, err := processExportStream(tt.mockSystemExportClient, hclog.NewNullLogger(), writer, 0, 0)
 
 			assert.Equal(t, tt.err, err)
 			if err != nil {
@@ -270,7 +279,7 @@ func Test_processExportStream(t *testing.T) {
 				}
 				expectedData = append(expectedData, rv.event.Data...)
 			}
-			assert.Equal(t, expectedData, buffer.Bytes())
+			assert.Equal(t, expectedData, writer.data)
 		})
 	}
 }
@@ -0,0 +1,390 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// archiveMagic is written in the beginning of the chunked archive.
+	// The legacy archive starts with RLP encoded Metadata, which never begins with these bytes
+	archiveMagic = "HYDRABAK"
+
+	// ArchiveVersion is the version of the chunked archive format,
+	// the legacy format without the manifest is the version 1
+	ArchiveVersion uint8 = 2
+
+	// DefaultChunkSize is the default maximum number of blocks in a chunk
+	DefaultChunkSize uint64 = 1000
+
+	// archiveHeaderSize is the size of the magic bytes and the version in the beginning of the archive
+	archiveHeaderSize = uint64(len(archiveMagic) + 1)
+
+	// manifestSizeLength is the size of the manifest length stored in the end of the archive
+	manifestSizeLength = uint64(8)
+)
+
+var (
+	errInvalidManifest   = errors.New("invalid archive manifest")
+	errChunkHashMismatch = errors.New("chunk hash doesn't match the manifest")
+)
+
+// ParseCompression parses the name of the compression algorithm
+func ParseCompression(raw string) (Compression, error) {
+	switch compression := Compression(raw); compression {
+	case CompressionZstd, CompressionGzip:
+		return compression, nil
+	default:
+		return "", fmt.Errorf("unsupported compression %q, expected %s or %s", raw, CompressionZstd, CompressionGzip)
+	}
+}
+
+// newCompressor returns the writer compressing the data into the given writer
+func newCompressor(compression Compression, writer io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case CompressionZstd:
+		return zstd.NewWriter(writer)
+	case CompressionGzip:
+		return gzip.NewWriter(writer), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// newDecompressor returns the reader decompressing the data from the given reader
+func newDecompressor(compression Compression, reader io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return decoder.IOReadCloser(), nil
+	case CompressionGzip:
+		return gzip.NewReader(reader)
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer
+type countingWriter struct {
+	writer io.Writer
+	count  uint64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.count += uint64(n)
+
+	return n, err
+}
+
+// chunkWriter writes the exported blocks to the archive as compressed chunks
+// and records them in the manifest
+type chunkWriter struct {
+	output      *countingWriter
+	compression Compression
+	chunkSize   uint64
+
+	// the chunk being written
+	current *ChunkInfo
+	encoder io.WriteCloser
+	hasher  hash.Hash
+
+	chunks []*ChunkInfo
+}
+
+// newChunkWriter writes the header of the chunked archive and returns the writer for the chunks
+func newChunkWriter(output io.Writer, compression Compression, chunkSize uint64) (*chunkWriter, error) {
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	w := &chunkWriter{
+		output:      &countingWriter{writer: output},
+		compression: compression,
+		chunkSize:   chunkSize,
+	}
+
+	if _, err := w.output.Write(append([]byte(archiveMagic), ArchiveVersion)); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// writeBlocks appends RLP encoded blocks to the current chunk,
+// the chunk is closed once it has enough blocks
+func (w *chunkWriter) writeBlocks(from, to uint64, data []byte) error {
+	if w.current == nil {
+		w.hasher = sha256.New()
+
+		encoder, err := newCompressor(w.compression, io.MultiWriter(w.output, w.hasher))
+		if err != nil {
+			return err
+		}
+
+		w.encoder = encoder
+		w.current = &ChunkInfo{
+			From:   from,
+			Offset: w.output.count,
+		}
+	}
+
+	if _, err := w.encoder.Write(data); err != nil {
+		return err
+	}
+
+	w.current.To = to
+
+	if w.current.To-w.current.From+1 >= w.chunkSize {
+		return w.closeChunk()
+	}
+
+	return nil
+}
+
+// closeChunk flushes the compressed data of the current chunk
+func (w *chunkWriter) closeChunk() error {
+	if w.current == nil {
+		return nil
+	}
+
+	if err := w.encoder.Close(); err != nil {
+		return err
+	}
+
+	w.current.Size = w.output.count - w.current.Offset
+	w.current.Hash = hex.EncodeToString(w.hasher.Sum(nil))
+	w.chunks = append(w.chunks, w.current)
+
+	w.current = nil
+	w.encoder = nil
+	w.hasher = nil
+
+	return nil
+}
+
+// writeManifest closes the last chunk and writes the manifest followed by its size
+func (w *chunkWriter) writeManifest(manifest *Manifest) error {
+	if err := w.closeChunk(); err != nil {
+		return err
+	}
+
+	if len(w.chunks) == 0 {
+		return errors.New("couldn't get any blocks")
+	}
+
+	manifest.Version = ArchiveVersion
+	manifest.Compression = w.compression
+	manifest.From = w.chunks[0].From
+	manifest.Chunks = w.chunks
+
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.output.Write(raw); err != nil {
+		return err
+	}
+
+	return binary.Write(w.output, binary.BigEndian, uint64(len(raw)))
+}
+
+// readManifest reads and validates the manifest of the chunked archive.
+// Returns nil manifest if the archive has the legacy format
+func readManifest(archive io.ReaderAt, size uint64) (*Manifest, error) {
+	header := make([]byte, archiveHeaderSize)
+
+	if _, err := archive.ReadAt(header, 0); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if !bytes.Equal(header[:len(archiveMagic)], []byte(archiveMagic)) {
+		return nil, nil
+	}
+
+	if version := header[len(archiveMagic)]; version != ArchiveVersion {
+		return nil, fmt.Errorf("unsupported archive version %d", version)
+	}
+
+	if size < archiveHeaderSize+manifestSizeLength {
+		return nil, fmt.Errorf("%w: archive is truncated", errInvalidManifest)
+	}
+
+	rawSize := make([]byte, manifestSizeLength)
+	if _, err := archive.ReadAt(rawSize, int64(size-manifestSizeLength)); err != nil {
+		return nil, err
+	}
+
+	manifestSize := binary.BigEndian.Uint64(rawSize)
+	if manifestSize > size-archiveHeaderSize-manifestSizeLength {
+		return nil, fmt.Errorf("%w: archive is truncated", errInvalidManifest)
+	}
+
+	manifestOffset := size - manifestSizeLength - manifestSize
+	rawManifest := make([]byte, manifestSize)
+
+	if _, err := archive.ReadAt(rawManifest, int64(manifestOffset)); err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(rawManifest, manifest); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidManifest, err)
+	}
+
+	if err := validateManifest(manifest, manifestOffset); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// validateManifest checks the chunks are contiguous and cover the block range of the archive
+func validateManifest(manifest *Manifest, manifestOffset uint64) error {
+	if manifest.Version != ArchiveVersion {
+		return fmt.Errorf("%w: unsupported version %d", errInvalidManifest, manifest.Version)
+	}
+
+	if _, err := ParseCompression(string(manifest.Compression)); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidManifest, err)
+	}
+
+	if len(manifest.Chunks) == 0 {
+		return fmt.Errorf("%w: no chunks", errInvalidManifest)
+	}
+
+	if manifest.Parent != nil && manifest.Parent.Number+1 != manifest.From {
+		return fmt.Errorf(
+			"%w: archive starts at %d but the parent ends at %d",
+			errInvalidManifest, manifest.From, manifest.Parent.Number,
+		)
+	}
+
+	nextBlock, nextOffset := manifest.From, archiveHeaderSize
+
+	for i, chunk := range manifest.Chunks {
+		if chunk.From != nextBlock || chunk.To < chunk.From {
+			return fmt.Errorf("%w: chunk %d has invalid block range [%d, %d]", errInvalidManifest, i, chunk.From, chunk.To)
+		}
+
+		if chunk.Offset != nextOffset || chunk.Size == 0 {
+			return fmt.Errorf("%w: chunk %d has invalid location", errInvalidManifest, i)
+		}
+
+		nextBlock, nextOffset = chunk.To+1, chunk.Offset+chunk.Size
+	}
+
+	if nextBlock-1 != manifest.Latest {
+		return fmt.Errorf("%w: chunks end at %d but latest is %d", errInvalidManifest, nextBlock-1, manifest.Latest)
+	}
+
+	if nextOffset != manifestOffset {
+		return fmt.Errorf("%w: chunks don't end at the manifest", errInvalidManifest)
+	}
+
+	return nil
+}
+
+// verifyChunks checks the hashes of the chunks against the manifest
+func verifyChunks(archive io.ReaderAt, manifest *Manifest) error {
+	for i, chunk := range manifest.Chunks {
+		hasher := sha256.New()
+
+		if _, err := io.Copy(hasher, io.NewSectionReader(archive, int64(chunk.Offset), int64(chunk.Size))); err != nil {
+			return err
+		}
+
+		if hash := hex.EncodeToString(hasher.Sum(nil)); hash != chunk.Hash {
+			return fmt.Errorf("%w: chunk %d (blocks %d-%d)", errChunkHashMismatch, i, chunk.From, chunk.To)
+		}
+	}
+
+	return nil
+}
+
+// chunkReader reads the decompressed blocks from the chunks of the archive
+type chunkReader struct {
+	archive  io.ReaderAt
+	manifest *Manifest
+
+	next    int
+	current io.ReadCloser
+}
+
+func newChunkReader(archive io.ReaderAt, manifest *Manifest) *chunkReader {
+	return &chunkReader{
+		archive:  archive,
+		manifest: manifest,
+	}
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if r.next >= len(r.manifest.Chunks) {
+				return 0, io.EOF
+			}
+
+			chunk := r.manifest.Chunks[r.next]
+			r.next++
+
+			decoder, err := newDecompressor(
+				r.manifest.Compression,
+				io.NewSectionReader(r.archive, int64(chunk.Offset), int64(chunk.Size)),
+			)
+			if err != nil {
+				return 0, err
+			}
+
+			r.current = decoder
+		}
+
+		n, err := r.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			if closeErr := r.current.Close(); closeErr != nil {
+				return n, closeErr
+			}
+
+			r.current = nil
+
+			if n == 0 {
+				continue
+			}
+
+			return n, nil
+		}
+
+		return n, err
+	}
+}
+
+// Close releases the decompressor of the current chunk
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+
+	err := r.current.Close()
+	r.current = nil
+
+	return err
+}
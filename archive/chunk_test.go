@@ -0,0 +1,165 @@
+package archive
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestChunkedArchive writes the blocks into the chunked archive, a chunk per two blocks
+func newTestChunkedArchive(
+	t *testing.T,
+	compression Compression,
+	parent *ParentInfo,
+	archiveBlocks ...*types.Block,
+) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	writer, err := newChunkWriter(&buffer, compression, 2)
+	require.NoError(t, err)
+
+	for _, b := range archiveBlocks {
+		require.NoError(t, writer.writeBlocks(b.Number(), b.Number(), b.MarshalRLP()))
+	}
+
+	latest := archiveBlocks[len(archiveBlocks)-1]
+
+	require.NoError(t, writer.writeManifest(&Manifest{
+		Latest:     latest.Number(),
+		LatestHash: latest.Hash(),
+		Parent:     parent,
+	}))
+
+	return buffer.Bytes()
+}
+
+func Test_chunkedArchive(t *testing.T) {
+	for _, compression := range []Compression{CompressionZstd, CompressionGzip} {
+		compression := compression
+
+		t.Run(string(compression), func(t *testing.T) {
+			data := newTestChunkedArchive(t, compression, nil, genesis, blocks[0], blocks[1], blocks[2])
+
+			manifest, err := readManifest(bytes.NewReader(data), uint64(len(data)))
+			require.NoError(t, err)
+			require.NotNil(t, manifest)
+
+			assert.Equal(t, ArchiveVersion, manifest.Version)
+			assert.Equal(t, compression, manifest.Compression)
+			assert.Equal(t, uint64(0), manifest.From)
+			assert.Equal(t, blocks[2].Number(), manifest.Latest)
+			assert.Equal(t, blocks[2].Hash(), manifest.LatestHash)
+			require.Len(t, manifest.Chunks, 2)
+			assert.Equal(t, uint64(1), manifest.Chunks[0].To)
+			assert.Equal(t, uint64(2), manifest.Chunks[1].From)
+
+			chain := &mockChain{
+				genesis: genesis,
+				blocks:  []*types.Block{},
+			}
+
+			require.NoError(t, importChunks(
+				chain,
+				bytes.NewReader(data),
+				manifest,
+				progress.NewProgressionWrapper(progress.ChainSyncRestore),
+			))
+			assert.Equal(t, blocks, chain.blocks)
+		})
+	}
+}
+
+func Test_readManifest(t *testing.T) {
+	t.Run("should return nil manifest for legacy archive", func(t *testing.T) {
+		var buffer bytes.Buffer
+
+		buffer.Write(metadata.MarshalRLP())
+		buffer.Write(genesis.MarshalRLP())
+
+		manifest, err := readManifest(bytes.NewReader(buffer.Bytes()), uint64(buffer.Len()))
+		assert.NoError(t, err)
+		assert.Nil(t, manifest)
+	})
+
+	t.Run("should fail for truncated archive", func(t *testing.T) {
+		data := newTestChunkedArchive(t, CompressionZstd, nil, genesis, blocks[0])
+		data = data[:len(data)-1]
+
+		_, err := readManifest(bytes.NewReader(data), uint64(len(data)))
+		assert.ErrorIs(t, err, errInvalidManifest)
+	})
+
+	t.Run("should fail for incremental archive not following its parent", func(t *testing.T) {
+		data := newTestChunkedArchive(t, CompressionZstd, &ParentInfo{Number: 2}, blocks[0], blocks[1])
+
+		_, err := readManifest(bytes.NewReader(data), uint64(len(data)))
+		assert.ErrorIs(t, err, errInvalidManifest)
+	})
+}
+
+func Test_importChunks(t *testing.T) {
+	t.Run("should fail when chunk is corrupted", func(t *testing.T) {
+		data := newTestChunkedArchive(t, CompressionGzip, nil, genesis, blocks[0], blocks[1])
+
+		manifest, err := readManifest(bytes.NewReader(data), uint64(len(data)))
+		require.NoError(t, err)
+
+		data[manifest.Chunks[1].Offset] ^= 0xff
+
+		chain := &mockChain{
+			genesis: genesis,
+			blocks:  []*types.Block{},
+		}
+
+		err = importChunks(
+			chain,
+			bytes.NewReader(data),
+			manifest,
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		)
+		assert.ErrorIs(t, err, errChunkHashMismatch)
+		assert.Empty(t, chain.blocks)
+	})
+
+	t.Run("should import incremental archive on top of its parent", func(t *testing.T) {
+		parent := &ParentInfo{
+			Number: blocks[0].Number(),
+			Hash:   blocks[0].Hash(),
+		}
+		data := newTestChunkedArchive(t, CompressionZstd, parent, blocks[1], blocks[2])
+
+		manifest, err := readManifest(bytes.NewReader(data), uint64(len(data)))
+		require.NoError(t, err)
+
+		// the chain without the parent block
+		chain := &mockChain{
+			genesis: genesis,
+			blocks:  []*types.Block{},
+		}
+
+		err = importChunks(
+			chain,
+			bytes.NewReader(data),
+			manifest,
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		)
+		assert.Error(t, err)
+		assert.Empty(t, chain.blocks)
+
+		chain.blocks = []*types.Block{blocks[0]}
+
+		require.NoError(t, importChunks(
+			chain,
+			bytes.NewReader(data),
+			manifest,
+			progress.NewProgressionWrapper(progress.ChainSyncRestore),
+		))
+		assert.Equal(t, blocks, chain.blocks)
+	})
+}
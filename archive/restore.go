@@ -27,22 +27,66 @@ type blockchainInterface interface {
 	VerifyFinalizedBlock(*types.Block) (*types.FullBlock, error)
 }
 
-// RestoreChain reads blocks from the archive and write to the chain
+// RestoreChain reads blocks from the archive and write to the chain.
+// The chunked archive is verified against its manifest before importing
 func RestoreChain(chain blockchainInterface, filePath string, progression *progress.ProgressionWrapper) error {
 	fp, err := os.Open(filePath)
 	if err != nil {
 		return err
 	}
 
-	blockStream := newBlockStream(fp)
+	defer fp.Close()
 
-	return importBlocks(chain, blockStream, progression)
+	info, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+
+	manifest, err := readManifest(fp, uint64(info.Size()))
+	if err != nil {
+		return err
+	}
+
+	if manifest == nil {
+		// the legacy archive with the metadata followed by the blocks
+		blockStream := newBlockStream(fp)
+
+		return importBlocks(chain, blockStream, progression)
+	}
+
+	return importChunks(chain, fp, manifest, progression)
+}
+
+// importChunks verifies the chunked archive and writes its blocks to chain
+func importChunks(
+	chain blockchainInterface,
+	archive io.ReaderAt,
+	manifest *Manifest,
+	progression *progress.ProgressionWrapper,
+) error {
+	if err := verifyChunks(archive, manifest); err != nil {
+		return err
+	}
+
+	// the incremental archive can only be imported on top of its parent
+	if manifest.Parent != nil {
+		if hash := chain.GetHashByNumber(manifest.Parent.Number); hash != manifest.Parent.Hash {
+			return fmt.Errorf(
+				"the chain doesn't have the block %d (%s) the archive starts from, restore the previous archive first",
+				manifest.Parent.Number,
+				manifest.Parent.Hash,
+			)
+		}
+	}
+
+	reader := newChunkReader(archive, manifest)
+	defer reader.Close()
+
+	return writeBlocks(chain, manifest.Metadata(), newBlockStream(reader), progression)
 }
 
 // import blocks scans all blocks from stream and write them to chain
 func importBlocks(chain blockchainInterface, blockStream *blockStream, progression *progress.ProgressionWrapper) error {
-	shutdownCh := common.GetTerminationSignalCh()
-
 	metadata, err := blockStream.getMetadata()
 	if err != nil {
 		return err
@@ -52,6 +96,18 @@ func importBlocks(chain blockchainInterface, blockStream *blockStream, progressi
 		return errors.New("expected metadata in archive but doesn't exist")
 	}
 
+	return writeBlocks(chain, metadata, blockStream, progression)
+}
+
+// writeBlocks writes the blocks following the latest block of chain from the stream
+func writeBlocks(
+	chain blockchainInterface,
+	metadata *Metadata,
+	blockStream *blockStream,
+	progression *progress.ProgressionWrapper,
+) error {
+	shutdownCh := common.GetTerminationSignalCh()
+
 	// check whether the local chain has the latest block already
 	latestBlock, ok := chain.GetBlockByNumber(metadata.Latest, false)
 	if ok && latestBlock.Hash() == metadata.LatestHash {
@@ -224,7 +280,7 @@ func (b *blockStream) loadRLPArray() (uint64, error) {
 // loadRLPPrefix loads first byte of RLP encoded data from input
 func (b *blockStream) loadRLPPrefix() (byte, error) {
 	buf := b.buffer[:1]
-	if _, err := b.input.Read(buf); err != nil {
+	if _, err := io.ReadFull(b.input, buf); err != nil {
 		return 0, err
 	}
 
@@ -246,9 +302,9 @@ func (b *blockStream) loadPrefixSize(offset uint64, prefix byte) (uint64, uint64
 
 		b.reserveCap(offset + payloadSizeSize)
 		payloadSizeBytes := b.buffer[offset : offset+payloadSizeSize]
-		n, err := b.input.Read(payloadSizeBytes)
+		n, err := io.ReadFull(b.input, payloadSizeBytes)
 
-		if err != nil {
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
 			return 0, 0, err
 		}
 
@@ -270,7 +326,8 @@ func (b *blockStream) loadPayload(offset uint64, size uint64) error {
 	b.reserveCap(offset + size)
 	buf := b.buffer[offset : offset+size]
 
-	if _, err := b.input.Read(buf); err != nil {
+	// the decompressed input may return less bytes than requested
+	if _, err := io.ReadFull(b.input, buf); err != nil {
 		return err
 	}
 
@@ -59,3 +59,49 @@ func (m *Metadata) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 
 	return nil
 }
+
+// Compression is the algorithm used to compress the chunks of the archive
+type Compression string
+
+const (
+	CompressionZstd Compression = "zstd"
+	CompressionGzip Compression = "gzip"
+)
+
+// ChunkInfo describes the compressed chunk of blocks in the archive
+type ChunkInfo struct {
+	// From and To are the first and the last block numbers in the chunk
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	// Offset and Size locate the compressed chunk in the archive file
+	Offset uint64 `json:"offset"`
+	Size   uint64 `json:"size"`
+	// Hash is the hex encoded SHA-256 hash of the compressed chunk
+	Hash string `json:"hash"`
+}
+
+// ParentInfo is the latest block of the archive the incremental backup continues from
+type ParentInfo struct {
+	Number uint64     `json:"number"`
+	Hash   types.Hash `json:"hash"`
+}
+
+// Manifest is the data stored in the end of the chunked backup
+type Manifest struct {
+	Version     uint8       `json:"version"`
+	Compression Compression `json:"compression"`
+	From        uint64      `json:"from"`
+	Latest      uint64      `json:"latest"`
+	LatestHash  types.Hash  `json:"latestHash"`
+	// Parent is set when the backup is incremental
+	Parent *ParentInfo  `json:"parent,omitempty"`
+	Chunks []*ChunkInfo `json:"chunks"`
+}
+
+// Metadata returns the latest block of the archive
+func (m *Manifest) Metadata() *Metadata {
+	return &Metadata{
+		Latest:     m.Latest,
+		LatestHash: m.LatestHash,
+	}
+}
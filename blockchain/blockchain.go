@@ -9,6 +9,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -617,6 +618,84 @@ func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock,
 	return &types.FullBlock{Block: block, Receipts: receipts}, nil
 }
 
+// VerifyFinalizedBlockWithoutState verifies the header, the body and the given receipts of the finalized block,
+// without executing its transactions. It is used for the blocks preceding the block
+// whose state is downloaded by the state sync, whose receipts are fetched from the peers
+func (b *Blockchain) VerifyFinalizedBlockWithoutState(
+	block *types.Block,
+	receipts []*types.Receipt,
+) (*types.FullBlock, error) {
+	if block == nil {
+		return nil, ErrNoBlock
+	}
+
+	// Make sure the consensus layer verifies this block header
+	if err := b.consensus.VerifyHeader(block.Header); err != nil {
+		return nil, fmt.Errorf("failed to verify the header: %w", err)
+	}
+
+	// Make sure the block is in line with the parent block
+	if err := b.verifyBlockParent(block); err != nil {
+		return nil, err
+	}
+
+	if err := b.verifyBlockRoots(block); err != nil {
+		return nil, err
+	}
+
+	if err := b.verifyBlockReceipts(block, receipts); err != nil {
+		return nil, err
+	}
+
+	return &types.FullBlock{Block: block, Receipts: receipts}, nil
+}
+
+// verifyBlockReceipts verifies the receipts of the block which are not the result of the local execution,
+// and fills in their context fields, which are not covered by the receipts root
+func (b *Blockchain) verifyBlockReceipts(block *types.Block, receipts []*types.Receipt) error {
+	// Make sure the number of receipts matches the number of transactions
+	if len(receipts) != len(block.Transactions) {
+		return ErrInvalidReceiptsSize
+	}
+
+	// the sender is needed for the address of the created contracts
+	if err := b.recoverFromFieldsInBlock(block); err != nil {
+		return err
+	}
+
+	totalGas := uint64(0)
+
+	for i, receipt := range receipts {
+		txn := block.Transactions[i]
+
+		if receipt.CumulativeGasUsed < totalGas {
+			return ErrInvalidGasUsed
+		}
+
+		receipt.TxHash = txn.Hash
+		receipt.GasUsed = receipt.CumulativeGasUsed - totalGas
+		receipt.ContractAddress = nil
+
+		if txn.To == nil {
+			receipt.ContractAddress = crypto.CreateAddress(txn.From, txn.Nonce).Ptr()
+		}
+
+		totalGas = receipt.CumulativeGasUsed
+	}
+
+	// Make sure the gas used is valid
+	if totalGas != block.Header.GasUsed {
+		return ErrInvalidGasUsed
+	}
+
+	// Make sure the receipts root matches up
+	if buildroot.CalculateReceiptsRoot(receipts) != block.Header.ReceiptsRoot {
+		return ErrInvalidReceiptsRoot
+	}
+
+	return nil
+}
+
 // verifyBlock does the base (common) block verification steps by
 // verifying the block body as well as the parent information
 func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
@@ -690,6 +769,26 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 // - The receipts match up
 // - The execution result matches up
 func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, error) {
+	if err := b.verifyBlockRoots(block); err != nil {
+		return nil, err
+	}
+
+	// Execute the transactions in the block and grab the result
+	blockResult, executeErr := b.executeBlockTransactions(block)
+	if executeErr != nil {
+		return nil, fmt.Errorf("unable to execute block transactions, %w", executeErr)
+	}
+
+	// Verify the local execution result with the proposed block data
+	if err := blockResult.verifyBlockResult(block); err != nil {
+		return nil, fmt.Errorf("unable to verify block execution result, %w", err)
+	}
+
+	return blockResult.Receipts, nil
+}
+
+// verifyBlockRoots verifies that the uncles and transactions roots match the block body
+func (b *Blockchain) verifyBlockRoots(block *types.Block) error {
 	// Make sure the Uncles root matches up
 	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
 		b.logger.Error(fmt.Sprintf(
@@ -698,7 +797,7 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, erro
 			block.Header.Sha3Uncles,
 		))
 
-		return nil, ErrInvalidSha3Uncles
+		return ErrInvalidSha3Uncles
 	}
 
 	// Make sure the transactions root matches up
@@ -709,21 +808,10 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, erro
 			block.Header.TxRoot,
 		))
 
-		return nil, ErrInvalidTxRoot
-	}
-
-	// Execute the transactions in the block and grab the result
-	blockResult, executeErr := b.executeBlockTransactions(block)
-	if executeErr != nil {
-		return nil, fmt.Errorf("unable to execute block transactions, %w", executeErr)
-	}
-
-	// Verify the local execution result with the proposed block data
-	if err := blockResult.verifyBlockResult(block); err != nil {
-		return nil, fmt.Errorf("unable to verify block execution result, %w", err)
+		return ErrInvalidTxRoot
 	}
 
-	return blockResult.Receipts, nil
+	return nil
 }
 
 // verifyBlockResult verifies that the block transaction execution result
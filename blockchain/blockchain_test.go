@@ -14,12 +14,14 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
 )
 
 func TestGenesis(t *testing.T) {
@@ -766,6 +768,84 @@ func Test_recoverFromFieldsInBlock(t *testing.T) {
 	})
 }
 
+func Test_verifyBlockReceipts(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("2")
+	to := types.StringToAddress("3")
+
+	newBlock := func() (*types.Block, []*types.Receipt) {
+		transfer := &types.Transaction{Nonce: 0, From: sender, To: &to}
+		create := &types.Transaction{Nonce: 1, From: sender}
+
+		transfer.ComputeHash(1)
+		create.ComputeHash(1)
+
+		receipts := []*types.Receipt{
+			{CumulativeGasUsed: 21000},
+			{CumulativeGasUsed: 71000},
+		}
+
+		for _, receipt := range receipts {
+			receipt.SetStatus(types.ReceiptSuccess)
+		}
+
+		block := &types.Block{
+			Header: &types.Header{
+				GasUsed:      71000,
+				ReceiptsRoot: buildroot.CalculateReceiptsRoot(receipts),
+			},
+			Transactions: []*types.Transaction{transfer, create},
+		}
+
+		return block, receipts
+	}
+
+	chain := &Blockchain{}
+
+	t.Run("should fill in the context fields", func(t *testing.T) {
+		t.Parallel()
+
+		block, receipts := newBlock()
+
+		require.NoError(t, chain.verifyBlockReceipts(block, receipts))
+
+		assert.Equal(t, block.Transactions[0].Hash, receipts[0].TxHash)
+		assert.Equal(t, uint64(21000), receipts[0].GasUsed)
+		assert.Nil(t, receipts[0].ContractAddress)
+
+		assert.Equal(t, block.Transactions[1].Hash, receipts[1].TxHash)
+		assert.Equal(t, uint64(50000), receipts[1].GasUsed)
+		assert.Equal(t, crypto.CreateAddress(sender, 1).Ptr(), receipts[1].ContractAddress)
+	})
+
+	t.Run("should fail for missing receipts", func(t *testing.T) {
+		t.Parallel()
+
+		block, receipts := newBlock()
+
+		assert.ErrorIs(t, chain.verifyBlockReceipts(block, receipts[:1]), ErrInvalidReceiptsSize)
+	})
+
+	t.Run("should fail for tampered receipts", func(t *testing.T) {
+		t.Parallel()
+
+		block, receipts := newBlock()
+		receipts[0].Logs = []*types.Log{{Address: to}}
+
+		assert.ErrorIs(t, chain.verifyBlockReceipts(block, receipts), ErrInvalidReceiptsRoot)
+	})
+
+	t.Run("should fail for invalid gas used", func(t *testing.T) {
+		t.Parallel()
+
+		block, receipts := newBlock()
+		block.Header.GasUsed = 21000
+
+		assert.ErrorIs(t, chain.verifyBlockReceipts(block, receipts), ErrInvalidGasUsed)
+	})
+}
+
 func Test_recoverFromFieldsInTransactions(t *testing.T) {
 	t.Parallel()
 
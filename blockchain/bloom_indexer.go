@@ -0,0 +1,314 @@
+package blockchain
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/bloombits"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// BloomBitsSectionSize is the number of blocks in a section of the bloom bits index
+	BloomBitsSectionSize = 4096
+
+	bloomIndexerMetrics = "bloom_indexer"
+)
+
+// BloomIndexer builds the bloom bits index from the logs blooms of the canonical headers,
+// so the log queries can skip the blocks which cannot contain the matching logs.
+//
+// The chain is split in sections of sectionSize blocks, and only the complete sections
+// are indexed. The bit vectors of a section are keyed by the hash of its last header,
+// so the vectors of the sections reorganized out of the canonical chain are never used
+type BloomIndexer struct {
+	logger      hclog.Logger
+	blockchain  *Blockchain
+	sectionSize uint64
+
+	// sections is the number of the indexed sections
+	sections atomic.Uint64
+	// lock serializes the progress updates between the indexing and the reorgs
+	lock sync.Mutex
+
+	updateCh chan struct{}
+	closeCh  chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewBloomIndexer creates a bloom bits indexer of the blockchain
+func NewBloomIndexer(logger hclog.Logger, blockchain *Blockchain, sectionSize uint64) (*BloomIndexer, error) {
+	if _, err := bloombits.NewGenerator(sectionSize); err != nil {
+		return nil, err
+	}
+
+	i := &BloomIndexer{
+		logger:      logger.Named("bloom_indexer"),
+		blockchain:  blockchain,
+		sectionSize: sectionSize,
+		updateCh:    make(chan struct{}, 1),
+		closeCh:     make(chan struct{}),
+	}
+
+	if sections, ok := blockchain.db.ReadBloomBitsSections(); ok {
+		i.sections.Store(sections)
+	}
+
+	return i, nil
+}
+
+// Start starts indexing the sections in the background and keeps the index up to date with the chain
+func (i *BloomIndexer) Start() {
+	sub := i.blockchain.SubscribeEvents()
+
+	i.wg.Add(2)
+
+	go func() {
+		defer i.wg.Done()
+		defer i.blockchain.UnsubscribeEvents(sub)
+
+		eventCh := sub.GetEventCh()
+
+		for {
+			select {
+			case <-i.closeCh:
+				return
+			case ev := <-eventCh:
+				if ev == nil {
+					continue
+				}
+
+				if ev.Type == EventReorg {
+					i.rollback(ev.OldChain)
+				}
+
+				i.notify()
+			}
+		}
+	}()
+
+	go func() {
+		defer i.wg.Done()
+
+		for {
+			i.indexSections()
+
+			select {
+			case <-i.closeCh:
+				return
+			case <-i.updateCh:
+			}
+		}
+	}()
+}
+
+// Close stops the indexer
+func (i *BloomIndexer) Close() {
+	close(i.closeCh)
+	i.wg.Wait()
+}
+
+// SectionSize returns the number of blocks in a section
+func (i *BloomIndexer) SectionSize() uint64 {
+	return i.sectionSize
+}
+
+// Sections returns the number of the indexed sections
+func (i *BloomIndexer) Sections() uint64 {
+	return i.sections.Load()
+}
+
+// GetBloomBits returns the bit vector of the bloom bit for the indexed section
+func (i *BloomIndexer) GetBloomBits(bit uint, section uint64) ([]byte, bool) {
+	if section >= i.sections.Load() {
+		return nil, false
+	}
+
+	head, ok := i.blockchain.db.ReadCanonicalHash((section+1)*i.sectionSize - 1)
+	if !ok {
+		return nil, false
+	}
+
+	vector, ok := i.blockchain.db.ReadBloomBits(bit, section, head)
+	if !ok {
+		return nil, false
+	}
+
+	if len(vector) == 0 {
+		// the empty vectors are not stored
+		return make([]byte, i.sectionSize/8), true
+	}
+
+	return vector, true
+}
+
+// notify wakes up the indexing, if not already pending
+func (i *BloomIndexer) notify() {
+	select {
+	case i.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+// rollback invalidates the indexed sections containing the reorganized headers
+func (i *BloomIndexer) rollback(oldChain []*types.Header) {
+	if len(oldChain) == 0 {
+		return
+	}
+
+	lowest := oldChain[0].Number
+	for _, header := range oldChain {
+		if header.Number < lowest {
+			lowest = header.Number
+		}
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	section := lowest / i.sectionSize
+	if section >= i.sections.Load() {
+		return
+	}
+
+	if err := i.storeSections(section); err != nil {
+		i.logger.Error("failed to roll back bloom bits index", "section", section, "err", err)
+
+		return
+	}
+
+	i.logger.Info("bloom bits index rolled back", "sections", section)
+}
+
+// indexSections indexes all the complete sections of the canonical chain
+func (i *BloomIndexer) indexSections() {
+	for {
+		select {
+		case <-i.closeCh:
+			return
+		default:
+		}
+
+		section := i.sections.Load()
+		if (section+1)*i.sectionSize > i.blockchain.Header().Number+1 {
+			return
+		}
+
+		if err := i.indexSection(section); err != nil {
+			i.logger.Error("failed to index bloom bits section", "section", section, "err", err)
+
+			return
+		}
+	}
+}
+
+// indexSection builds and stores the bit vectors of the section
+func (i *BloomIndexer) indexSection(section uint64) error {
+	generator, err := bloombits.NewGenerator(i.sectionSize)
+	if err != nil {
+		return err
+	}
+
+	last := (section+1)*i.sectionSize - 1
+
+	lastHeader, ok := i.blockchain.GetHeaderByNumber(last)
+	if !ok {
+		return fmt.Errorf("header %d not found", last)
+	}
+
+	// walk the section backwards from its last header, so the headers are from the same chain
+	headers := make([]*types.Header, i.sectionSize)
+	headers[i.sectionSize-1] = lastHeader
+
+	for n := i.sectionSize - 1; n > 0; n-- {
+		parent, ok := i.blockchain.GetHeaderByHash(headers[n].ParentHash)
+		if !ok {
+			return fmt.Errorf("header %s not found", headers[n].ParentHash)
+		}
+
+		headers[n-1] = parent
+	}
+
+	for n, header := range headers {
+		if err := generator.AddBloom(uint64(n), header.LogsBloom); err != nil {
+			return err
+		}
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	// the section was rolled back or reorganized in the meantime, index it again
+	if i.sections.Load() != section {
+		return nil
+	}
+
+	if head, ok := i.blockchain.db.ReadCanonicalHash(last); !ok || head != lastHeader.Hash {
+		return nil
+	}
+
+	batchWriter := storage.NewBatchWriter(i.blockchain.db)
+
+	for bit := uint(0); bit < bloombits.BloomBitLength; bit++ {
+		vector, err := generator.Bitset(bit)
+		if err != nil {
+			return err
+		}
+
+		if isEmptyBitset(vector) {
+			vector = []byte{}
+		}
+
+		batchWriter.PutBloomBits(bit, section, lastHeader.Hash, vector)
+	}
+
+	batchWriter.PutBloomBitsSections(section + 1)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return err
+	}
+
+	i.sections.Store(section + 1)
+	i.updateMetrics()
+
+	i.logger.Debug("bloom bits section indexed", "section", section, "head", lastHeader.Hash)
+
+	return nil
+}
+
+// storeSections stores the number of the indexed sections
+func (i *BloomIndexer) storeSections(sections uint64) error {
+	batchWriter := storage.NewBatchWriter(i.blockchain.db)
+	batchWriter.PutBloomBitsSections(sections)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return err
+	}
+
+	i.sections.Store(sections)
+	i.updateMetrics()
+
+	return nil
+}
+
+func (i *BloomIndexer) updateMetrics() {
+	sections := i.sections.Load()
+
+	metrics.SetGauge([]string{bloomIndexerMetrics, "sections"}, float32(sections))
+	metrics.SetGauge([]string{bloomIndexerMetrics, "indexed_blocks"}, float32(sections*i.sectionSize))
+}
+
+func isEmptyBitset(vector []byte) bool {
+	for _, b := range vector {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/bloombits"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// withLogsBlooms sets the logs bloom of the given address to the headers with the given numbers,
+// and recomputes the hashes of the chain
+func withLogsBlooms(headers []*types.Header, addr types.Address, numbers ...uint64) []*types.Header {
+	for _, number := range numbers {
+		for _, header := range headers {
+			if header.Number == number {
+				header.LogsBloom = types.CreateBloom([]*types.Receipt{{Logs: []*types.Log{{Address: addr}}}})
+			}
+		}
+	}
+
+	for i, header := range headers {
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash
+		}
+
+		header.ComputeHash()
+	}
+
+	return headers
+}
+
+// matchingBlocks returns the numbers of the blocks of the section which may contain the logs of the address
+func matchingBlocks(t *testing.T, indexer *BloomIndexer, section uint64, addr types.Address) []uint64 {
+	t.Helper()
+
+	matcher := bloombits.NewMatcher(indexer.SectionSize(), [][][]byte{{addr.Bytes()}})
+
+	vector, err := matcher.Match(func(bit uint) ([]byte, error) {
+		vector, ok := indexer.GetBloomBits(bit, section)
+		require.True(t, ok)
+
+		return vector, nil
+	})
+	require.NoError(t, err)
+
+	numbers := []uint64{}
+
+	for n := uint64(0); n < indexer.SectionSize(); n++ {
+		if bloombits.IsSet(vector, n) {
+			numbers = append(numbers, section*indexer.SectionSize()+n)
+		}
+	}
+
+	return numbers
+}
+
+func TestBloomIndexer(t *testing.T) {
+	t.Parallel()
+
+	const sectionSize = 8
+
+	addr := types.StringToAddress("1")
+
+	headers := withLogsBlooms(NewTestHeaders(24), addr, 3, 12, 23)
+	b := NewTestBlockchain(t, headers)
+
+	indexer, err := NewBloomIndexer(hclog.NewNullLogger(), b, sectionSize)
+	require.NoError(t, err)
+
+	indexer.Start()
+	defer indexer.Close()
+
+	require.Eventually(t, func() bool {
+		return indexer.Sections() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []uint64{3}, matchingBlocks(t, indexer, 0, addr))
+	assert.Equal(t, []uint64{12}, matchingBlocks(t, indexer, 1, addr))
+	assert.Equal(t, []uint64{23}, matchingBlocks(t, indexer, 2, addr))
+
+	// only the complete sections are indexed
+	_, ok := indexer.GetBloomBits(0, 3)
+	assert.False(t, ok)
+
+	// reorg the last block of the indexed section with a longer chain
+	fork := withLogsBlooms(AppendNewTestheadersWithSeed(headers[:23], 2, 1), addr, 24)
+	require.NoError(t, b.WriteHeadersWithBodies(fork[23:]))
+
+	require.Eventually(t, func() bool {
+		_, ok := indexer.GetBloomBits(0, 2)
+
+		return ok && b.Header().Hash == fork[len(fork)-1].Hash
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, uint64(3), indexer.Sections())
+	assert.Equal(t, []uint64{3}, matchingBlocks(t, indexer, 0, addr))
+	assert.Equal(t, []uint64{12}, matchingBlocks(t, indexer, 1, addr))
+	assert.Empty(t, matchingBlocks(t, indexer, 2, addr))
+
+	// the progress is persisted
+	restarted, err := NewBloomIndexer(hclog.NewNullLogger(), b, sectionSize)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), restarted.Sections())
+}
+
+func TestBloomIndexer_InvalidSectionSize(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewBloomIndexer(hclog.NewNullLogger(), NewTestBlockchain(t, nil), 10)
+	require.ErrorIs(t, err, bloombits.ErrInvalidSectionSize)
+}
@@ -0,0 +1,74 @@
+package bloombits
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// BloomBitLength is the number of bits of the logs bloom filter
+const BloomBitLength = types.BloomByteLength * 8
+
+var (
+	ErrInvalidSectionSize = errors.New("section size must be a non-zero multiple of 8")
+	ErrUnexpectedBloom    = errors.New("unexpected bloom index")
+	ErrSectionIncomplete  = errors.New("section is not complete")
+	ErrInvalidBit         = errors.New("bloom bit out of range")
+)
+
+// Generator rotates the logs blooms of the blocks of a section into bit vectors,
+// one for every bit of the bloom filter. The n-th bit of the vector is set
+// if the n-th block of the section has the bloom bit set
+type Generator struct {
+	vectors     [BloomBitLength][]byte
+	sectionSize uint64
+	next        uint64
+}
+
+// NewGenerator creates a generator for the section of the given size
+func NewGenerator(sectionSize uint64) (*Generator, error) {
+	if sectionSize == 0 || sectionSize%8 != 0 {
+		return nil, ErrInvalidSectionSize
+	}
+
+	g := &Generator{sectionSize: sectionSize}
+
+	for i := range g.vectors {
+		g.vectors[i] = make([]byte, sectionSize/8)
+	}
+
+	return g, nil
+}
+
+// AddBloom adds the logs bloom of the block with the given index in the section.
+// The blooms must be added in order
+func (g *Generator) AddBloom(index uint64, bloom types.Bloom) error {
+	if index != g.next || index >= g.sectionSize {
+		return ErrUnexpectedBloom
+	}
+
+	byteIndex, bitMask := index/8, byte(1<<(7-index%8))
+
+	for bit := uint(0); bit < BloomBitLength; bit++ {
+		if bloom.IsBitSet(bit) {
+			g.vectors[bit][byteIndex] |= bitMask
+		}
+	}
+
+	g.next++
+
+	return nil
+}
+
+// Bitset returns the bit vector of the bloom bit, once all the blooms of the section are added
+func (g *Generator) Bitset(bit uint) ([]byte, error) {
+	if g.next != g.sectionSize {
+		return nil, ErrSectionIncomplete
+	}
+
+	if bit >= BloomBitLength {
+		return nil, ErrInvalidBit
+	}
+
+	return g.vectors[bit], nil
+}
@@ -0,0 +1,104 @@
+package bloombits
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var ErrInvalidBitset = errors.New("invalid bit vector length")
+
+// Matcher finds the blocks of a section which may contain the logs matching the filters.
+//
+// The filters are a conjunction of disjunctions: a block matches if for every filter
+// at least one of its values may be present in the logs bloom of the block.
+// An empty filter matches all the blocks
+type Matcher struct {
+	sectionSize uint64
+	filters     [][][3]uint
+}
+
+// NewMatcher creates a matcher for the sections of the given size
+func NewMatcher(sectionSize uint64, filters [][][]byte) *Matcher {
+	m := &Matcher{sectionSize: sectionSize}
+
+	for _, filter := range filters {
+		if len(filter) == 0 {
+			// wildcard
+			continue
+		}
+
+		bits := make([][3]uint, len(filter))
+		for i, value := range filter {
+			bits[i] = types.BloomLookup(value)
+		}
+
+		m.filters = append(m.filters, bits)
+	}
+
+	return m
+}
+
+// Match returns the bit vector of the blocks of the section matching the filters.
+// The retrieve function returns the bit vector of the bloom bit for the section
+func (m *Matcher) Match(retrieve func(bit uint) ([]byte, error)) ([]byte, error) {
+	result := make([]byte, m.sectionSize/8)
+	for i := range result {
+		result[i] = 0xff
+	}
+
+	cache := map[uint][]byte{}
+
+	get := func(bit uint) ([]byte, error) {
+		if vector, ok := cache[bit]; ok {
+			return vector, nil
+		}
+
+		vector, err := retrieve(bit)
+		if err != nil {
+			return nil, err
+		}
+
+		if uint64(len(vector)) != m.sectionSize/8 {
+			return nil, ErrInvalidBitset
+		}
+
+		cache[bit] = vector
+
+		return vector, nil
+	}
+
+	for _, filter := range m.filters {
+		matched := make([]byte, len(result))
+
+		for _, bits := range filter {
+			// all the bits of the value must be set
+			all := make([]byte, len(result))
+			copy(all, result)
+
+			for _, bit := range bits {
+				vector, err := get(bit)
+				if err != nil {
+					return nil, err
+				}
+
+				for i := range all {
+					all[i] &= vector[i]
+				}
+			}
+
+			for i := range matched {
+				matched[i] |= all[i]
+			}
+		}
+
+		result = matched
+	}
+
+	return result, nil
+}
+
+// IsSet checks if the n-th bit of the bit vector is set
+func IsSet(vector []byte, n uint64) bool {
+	return vector[n/8]&(1<<(7-n%8)) != 0
+}
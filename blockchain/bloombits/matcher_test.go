@@ -0,0 +1,144 @@
+package bloombits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestGenerator(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGenerator(12)
+	require.ErrorIs(t, err, ErrInvalidSectionSize)
+
+	generator, err := NewGenerator(16)
+	require.NoError(t, err)
+
+	// blooms must be added in order
+	require.ErrorIs(t, generator.AddBloom(1, types.Bloom{}), ErrUnexpectedBloom)
+
+	var bloom types.Bloom
+
+	bloom[types.BloomByteLength-1] = 0x1 // bit 0
+
+	for i := uint64(0); i < 15; i++ {
+		if i == 9 {
+			require.NoError(t, generator.AddBloom(i, bloom))
+		} else {
+			require.NoError(t, generator.AddBloom(i, types.Bloom{}))
+		}
+	}
+
+	_, err = generator.Bitset(0)
+	require.ErrorIs(t, err, ErrSectionIncomplete)
+
+	require.NoError(t, generator.AddBloom(15, types.Bloom{}))
+
+	vector, err := generator.Bitset(0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x0, 0x40}, vector)
+	assert.True(t, IsSet(vector, 9))
+
+	vector, err = generator.Bitset(1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x0, 0x0}, vector)
+
+	_, err = generator.Bitset(BloomBitLength)
+	require.ErrorIs(t, err, ErrInvalidBit)
+}
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	const sectionSize = 8
+
+	var (
+		addr1  = types.StringToAddress("1")
+		addr2  = types.StringToAddress("2")
+		topic1 = types.StringToHash("1")
+	)
+
+	logsBloom := func(logs ...*types.Log) types.Bloom {
+		return types.CreateBloom([]*types.Receipt{{Logs: logs}})
+	}
+
+	blooms := []types.Bloom{
+		logsBloom(&types.Log{Address: addr1}),
+		{},
+		logsBloom(&types.Log{Address: addr2, Topics: []types.Hash{topic1}}),
+		{},
+		logsBloom(&types.Log{Address: addr1, Topics: []types.Hash{topic1}}),
+		{},
+		{},
+		{},
+	}
+
+	generator, err := NewGenerator(sectionSize)
+	require.NoError(t, err)
+
+	for i, bloom := range blooms {
+		require.NoError(t, generator.AddBloom(uint64(i), bloom))
+	}
+
+	tests := []struct {
+		name     string
+		filters  [][][]byte
+		expected []uint64
+	}{
+		{
+			name:     "single address",
+			filters:  [][][]byte{{addr1.Bytes()}},
+			expected: []uint64{0, 4},
+		},
+		{
+			name:     "any of the addresses",
+			filters:  [][][]byte{{addr1.Bytes(), addr2.Bytes()}},
+			expected: []uint64{0, 2, 4},
+		},
+		{
+			name:     "address and topic",
+			filters:  [][][]byte{{addr1.Bytes()}, {topic1.Bytes()}},
+			expected: []uint64{4},
+		},
+		{
+			name:     "wildcard address",
+			filters:  [][][]byte{{}, {topic1.Bytes()}},
+			expected: []uint64{2, 4},
+		},
+		{
+			name:     "no filters",
+			filters:  nil,
+			expected: []uint64{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			vector, err := NewMatcher(sectionSize, tt.filters).Match(generator.Bitset)
+			require.NoError(t, err)
+
+			matched := []uint64{}
+
+			for n := uint64(0); n < sectionSize; n++ {
+				if IsSet(vector, n) {
+					matched = append(matched, n)
+				}
+			}
+
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	_, err = NewMatcher(sectionSize, [][][]byte{{addr1.Bytes()}}).Match(func(bit uint) ([]byte, error) {
+		return []byte{}, nil
+	})
+	require.ErrorIs(t, err, ErrInvalidBitset)
+}
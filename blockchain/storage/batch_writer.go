@@ -73,6 +73,14 @@ func (b *BatchWriter) PutForks(forks []types.Hash) {
 	b.putRlp(FORK, EMPTY, &ff)
 }
 
+func (b *BatchWriter) PutBloomBits(bit uint, section uint64, head types.Hash, bits []byte) {
+	b.putWithPrefix(BLOOM_BITS, bloomBitsKey(bit, section, head), bits)
+}
+
+func (b *BatchWriter) PutBloomBitsSections(sections uint64) {
+	b.putWithPrefix(BLOOM_BITS, SECTIONS, common.EncodeUint64ToBytes(sections))
+}
+
 func (b *BatchWriter) putRlp(p, k []byte, raw types.RLPMarshaler) {
 	var data []byte
 
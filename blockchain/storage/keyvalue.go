@@ -39,13 +39,17 @@ var (
 
 	// TX_LOOKUP_PREFIX is the prefix for transaction lookups
 	TX_LOOKUP_PREFIX = []byte("l")
+
+	// BLOOM_BITS is the prefix for the bloom bits index
+	BLOOM_BITS = []byte("B")
 )
 
 // Sub-prefixes
 var (
-	HASH   = []byte("hash")
-	NUMBER = []byte("number")
-	EMPTY  = []byte("empty")
+	HASH     = []byte("hash")
+	NUMBER   = []byte("number")
+	EMPTY    = []byte("empty")
+	SECTIONS = []byte("sections")
 )
 
 // KV is a key value storage interface.
@@ -191,6 +195,32 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// BLOOM BITS //
+
+// bloomBitsKey returns the key of the bloom bit vector of the section with the given head
+func bloomBitsKey(bit uint, section uint64, head types.Hash) []byte {
+	key := make([]byte, 0, 2+8+types.HashLength)
+	key = append(key, byte(bit>>8), byte(bit))
+	key = append(key, common.EncodeUint64ToBytes(section)...)
+
+	return append(key, head.Bytes()...)
+}
+
+// ReadBloomBits reads the bloom bit vector of the section with the given head
+func (s *KeyValueStorage) ReadBloomBits(bit uint, section uint64, head types.Hash) ([]byte, bool) {
+	return s.get(BLOOM_BITS, bloomBitsKey(bit, section, head))
+}
+
+// ReadBloomBitsSections reads the number of the sections in the bloom bits index
+func (s *KeyValueStorage) ReadBloomBitsSections() (uint64, bool) {
+	data, ok := s.get(BLOOM_BITS, SECTIONS)
+	if !ok || len(data) != 8 {
+		return 0, false
+	}
+
+	return common.EncodeBytesToUint64(data), true
+}
+
 var ErrNotFound = fmt.Errorf("not found")
 
 func (s *KeyValueStorage) readRLP(p, k []byte, raw types.RLPUnmarshaler) error {
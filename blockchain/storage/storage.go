@@ -26,6 +26,9 @@ type Storage interface {
 
 	ReadTxLookup(hash types.Hash) (types.Hash, bool)
 
+	ReadBloomBits(bit uint, section uint64, head types.Hash) ([]byte, bool)
+	ReadBloomBitsSections() (uint64, bool)
+
 	NewBatch() Batch
 
 	Close() error
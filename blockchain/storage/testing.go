@@ -49,6 +49,9 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("testReceipts", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("testBloomBits", func(t *testing.T) {
+		testBloomBits(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -448,6 +451,40 @@ func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	}
 }
 
+func testBloomBits(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	_, ok := s.ReadBloomBitsSections()
+	assert.False(t, ok)
+
+	batch := NewBatchWriter(s)
+
+	batch.PutBloomBits(1, 2, hash1, []byte{0x1, 0x2})
+	batch.PutBloomBits(2047, 2, hash1, []byte{})
+	batch.PutBloomBitsSections(3)
+
+	require.NoError(t, batch.WriteBatch())
+
+	bits, ok := s.ReadBloomBits(1, 2, hash1)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x1, 0x2}, bits)
+
+	bits, ok = s.ReadBloomBits(2047, 2, hash1)
+	assert.True(t, ok)
+	assert.Empty(t, bits)
+
+	// vectors of another section head are not found
+	_, ok = s.ReadBloomBits(1, 2, hash2)
+	assert.False(t, ok)
+
+	sections, ok := s.ReadBloomBitsSections()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(3), sections)
+}
+
 // Storage delegators
 
 type readCanonicalHashDelegate func(uint64) (types.Hash, bool)
@@ -460,6 +497,8 @@ type readBodyDelegate func(types.Hash) (*types.Body, error)
 type readSnapshotDelegate func(types.Hash) ([]byte, bool)
 type readReceiptsDelegate func(types.Hash) ([]*types.Receipt, error)
 type readTxLookupDelegate func(types.Hash) (types.Hash, bool)
+type readBloomBitsDelegate func(uint, uint64, types.Hash) ([]byte, bool)
+type readBloomBitsSectionsDelegate func() (uint64, bool)
 type closeDelegate func() error
 type newBatchDelegate func() Batch
 
@@ -473,6 +512,8 @@ type MockStorage struct {
 	readBodyFn            readBodyDelegate
 	readReceiptsFn        readReceiptsDelegate
 	readTxLookupFn        readTxLookupDelegate
+	readBloomBitsFn       readBloomBitsDelegate
+	readBloomSectionsFn   readBloomBitsSectionsDelegate
 	closeFn               closeDelegate
 	newBatchFn            newBatchDelegate
 }
@@ -589,6 +630,30 @@ func (m *MockStorage) HookReadTxLookup(fn readTxLookupDelegate) {
 	m.readTxLookupFn = fn
 }
 
+func (m *MockStorage) ReadBloomBits(bit uint, section uint64, head types.Hash) ([]byte, bool) {
+	if m.readBloomBitsFn != nil {
+		return m.readBloomBitsFn(bit, section, head)
+	}
+
+	return nil, false
+}
+
+func (m *MockStorage) HookReadBloomBits(fn readBloomBitsDelegate) {
+	m.readBloomBitsFn = fn
+}
+
+func (m *MockStorage) ReadBloomBitsSections() (uint64, bool) {
+	if m.readBloomSectionsFn != nil {
+		return m.readBloomSectionsFn()
+	}
+
+	return 0, false
+}
+
+func (m *MockStorage) HookReadBloomBitsSections(fn readBloomBitsSectionsDelegate) {
+	m.readBloomSectionsFn = fn
+}
+
 func (m *MockStorage) Close() error {
 	if m.closeFn != nil {
 		return m.closeFn()
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"errors"
+	"slices"
 	"sort"
 
 	"github.com/0xPolygon/polygon-edge/forkmanager"
@@ -83,6 +84,7 @@ const (
 	Constantinople      = "constantinople"
 	Petersburg          = "petersburg"
 	Istanbul            = "istanbul"
+	Berlin              = "berlin"
 	London              = "london"
 	EIP150              = "EIP150"
 	EIP158              = "EIP158"
@@ -90,6 +92,8 @@ const (
 	QuorumCalcAlignment = "quorumcalcalignment"
 	TxHashWithType      = "txHashWithType"
 	LondonFix           = "londonfix"
+	Shanghai            = "shanghai"
+	Cancun              = "cancun"
 )
 
 // Forks is map which contains all forks and their starting blocks from genesis
@@ -121,6 +125,7 @@ func (f *Forks) At(block uint64) ForksInTime {
 		Constantinople:      f.IsActive(Constantinople, block),
 		Petersburg:          f.IsActive(Petersburg, block),
 		Istanbul:            f.IsActive(Istanbul, block),
+		Berlin:              f.IsActive(Berlin, block),
 		London:              f.IsActive(London, block),
 		EIP150:              f.IsActive(EIP150, block),
 		EIP158:              f.IsActive(EIP158, block),
@@ -128,6 +133,8 @@ func (f *Forks) At(block uint64) ForksInTime {
 		QuorumCalcAlignment: f.IsActive(QuorumCalcAlignment, block),
 		TxHashWithType:      f.IsActive(TxHashWithType, block),
 		LondonFix:           f.IsActive(LondonFix, block),
+		Shanghai:            f.IsActive(Shanghai, block),
+		Cancun:              f.IsActive(Cancun, block),
 	}
 }
 
@@ -174,16 +181,20 @@ type ForksInTime struct {
 	Constantinople,
 	Petersburg,
 	Istanbul,
+	Berlin,
 	London,
 	EIP150,
 	EIP158,
 	EIP155,
 	QuorumCalcAlignment,
 	TxHashWithType,
-	LondonFix bool
+	LondonFix,
+	Shanghai,
+	Cancun bool
 }
 
-// AllForksEnabled should contain all supported forks by current edge version
+// AllForksEnabled should contain all supported forks by current edge version,
+// except the optional ones which are never enabled by default
 var AllForksEnabled = &Forks{
 	Homestead:           NewFork(0),
 	EIP150:              NewFork(0),
@@ -198,3 +209,16 @@ var AllForksEnabled = &Forks{
 	TxHashWithType:      NewFork(0),
 	LondonFix:           NewFork(0),
 }
+
+// OptionalForks are the supported forks which change the gas costs and the instruction set
+// of the existing chains, so they have to be scheduled explicitly in the genesis
+var OptionalForks = []string{Berlin, Shanghai, Cancun}
+
+// IsSupportedFork checks if the fork is supported by current edge version
+func IsSupportedFork(name string) bool {
+	if _, ok := (*AllForksEnabled)[name]; ok {
+		return true
+	}
+
+	return slices.Contains(OptionalForks, name)
+}
@@ -1,6 +1,9 @@
 package backup
 
 import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/archive"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/spf13/cobra"
 
@@ -44,6 +47,33 @@ func setFlags(cmd *cobra.Command) {
 		"",
 		"the end height of the chain in backup",
 	)
+
+	cmd.Flags().StringVar(
+		&params.compressionRaw,
+		compressionFlag,
+		string(archive.CompressionZstd),
+		fmt.Sprintf(
+			"the compression of the backup chunks (%s or %s)",
+			archive.CompressionZstd,
+			archive.CompressionGzip,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.chunkSize,
+		chunkSizeFlag,
+		archive.DefaultChunkSize,
+		"the maximum number of blocks in a backup chunk",
+	)
+
+	cmd.Flags().StringVar(
+		&params.incremental,
+		incrementalFlag,
+		"",
+		"the path to the previous backup to continue from, the backup starts after its latest block",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(fromFlag, incrementalFlag)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
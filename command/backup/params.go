@@ -11,9 +11,12 @@ import (
 )
 
 const (
-	outFlag  = "out"
-	fromFlag = "from"
-	toFlag   = "to"
+	outFlag         = "out"
+	fromFlag        = "from"
+	toFlag          = "to"
+	compressionFlag = "compression"
+	chunkSizeFlag   = "chunk-size"
+	incrementalFlag = "incremental"
 )
 
 var (
@@ -23,6 +26,7 @@ var (
 var (
 	errDecodeRange  = errors.New("unable to decode range value")
 	errInvalidRange = errors.New(`invalid "to" value; must be >= "from"`)
+	errChunkSize    = errors.New(`invalid "chunk-size" value; must be greater than 0`)
 )
 
 type backupParams struct {
@@ -34,6 +38,11 @@ type backupParams struct {
 	from uint64
 	to   *uint64
 
+	compressionRaw string
+	compression    archive.Compression
+	chunkSize      uint64
+	incremental    string
+
 	resFrom uint64
 	resTo   uint64
 }
@@ -59,6 +68,14 @@ func (p *backupParams) validateFlags() error {
 		p.to = &parsedTo
 	}
 
+	if p.compression, parseErr = archive.ParseCompression(p.compressionRaw); parseErr != nil {
+		return parseErr
+	}
+
+	if p.chunkSize == 0 {
+		return errChunkSize
+	}
+
 	return nil
 }
 
@@ -86,6 +103,11 @@ func (p *backupParams) createBackup(grpcAddress string) error {
 		p.from,
 		p.to,
 		p.out,
+		&archive.BackupConfig{
+			Compression: p.compression,
+			ChunkSize:   p.chunkSize,
+			ParentPath:  p.incremental,
+		},
 	)
 	if err != nil {
 		return err
@@ -224,6 +224,16 @@ func setFlags(cmd *cobra.Command) {
 			"trie root from the corresponding triedb",
 		)
 
+		cmd.Flags().StringVar(
+			&params.fromState,
+			fromStateFlag,
+			"",
+			"the state dump the genesis state is created from. "+
+				"The dump is embedded into the genesis file, and the node imports it into its trie on the first start",
+		)
+
+		cmd.MarkFlagsMutuallyExclusive(trieRootFlag, fromStateFlag)
+
 		// Hydra modification: we don't use a separate native erc20 token, thus, we don't need this flag
 		// cmd.Flags().StringVar(
 		// 	&params.nativeTokenConfigRaw,
@@ -1,6 +1,7 @@
 package genesis
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
 )
@@ -108,6 +110,8 @@ type genesisParams struct {
 	blockTimeDrift uint64
 
 	initialStateRoot string
+	fromState        string
+	initialStateDump *polybft.StateDumpConfig
 
 	// access lists
 	contractDeployerAllowListAdmin   []string
@@ -180,6 +184,10 @@ func (p *genesisParams) validateFlags() error {
 		if err := p.validateGovernanceAddress(); err != nil {
 			return err
 		}
+
+		if err := p.validateStateDump(); err != nil {
+			return err
+		}
 	}
 
 	// Check if the genesis file already exists
@@ -612,6 +620,33 @@ func (p *genesisParams) getResult() command.CommandResult {
 	}
 }
 
+// validateStateDump verifies the state dump the genesis is created from,
+// and uses its state root as the initial trie root. The dump is embedded into the genesis,
+// so the node imports it without any other file
+func (p *genesisParams) validateStateDump() error {
+	if p.fromState == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(p.fromState)
+	if err != nil {
+		return fmt.Errorf("failed to read the state dump: %w", err)
+	}
+
+	dump, err := itrie.VerifyStateDump(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to verify the state dump: %w", err)
+	}
+
+	p.initialStateRoot = dump.Root.String()
+	p.initialStateDump = &polybft.StateDumpConfig{
+		Data:     data,
+		Checksum: dump.Checksum,
+	}
+
+	return nil
+}
+
 func (p *genesisParams) validateGovernanceAddress() error {
 	if err := command.ValidateAddress("governance", p.governance); err != nil {
 		return err
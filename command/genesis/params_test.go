@@ -2,6 +2,9 @@ package genesis
 
 import (
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,6 +12,9 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -180,3 +186,47 @@ func Test_validatePremineInfo(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateStateDump(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+
+	_, root, err := itrie.NewState(storage).NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  types.StringToAddress("1"),
+			Balance:  big.NewInt(1000),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+	})
+	require.NoError(t, err)
+
+	dumpPath := filepath.Join(t.TempDir(), "state.dump")
+
+	fs, err := os.Create(dumpPath)
+	require.NoError(t, err)
+
+	dump, err := itrie.ExportState(types.BytesToHash(root), 10, storage, fs)
+	require.NoError(t, err)
+	require.NoError(t, fs.Close())
+
+	data, err := os.ReadFile(dumpPath)
+	require.NoError(t, err)
+
+	p := &genesisParams{fromState: dumpPath}
+	require.NoError(t, p.validateStateDump())
+	require.Equal(t, dump.Root.String(), p.initialStateRoot)
+
+	// the dump is embedded into the genesis
+	require.Equal(t, &polybft.StateDumpConfig{
+		Data:     data,
+		Checksum: dump.Checksum,
+	}, p.initialStateDump)
+
+	// the corrupted dump is rejected
+	require.NoError(t, os.WriteFile(dumpPath, []byte("corrupted"), 0600))
+
+	p = &genesisParams{fromState: dumpPath}
+	require.ErrorIs(t, p.validateStateDump(), itrie.ErrInvalidStateDump)
+}
@@ -28,6 +28,7 @@ const (
 	sprintSizeFlag = "sprint-size"
 	blockTimeFlag  = "block-time"
 	trieRootFlag   = "trieroot"
+	fromStateFlag  = "from-state"
 
 	blockTimeDriftFlag = "block-time-drift"
 
@@ -125,6 +126,7 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		EpochReward:              p.epochReward,
 		Governance:               types.StringToAddress(p.governance),
 		InitialTrieRoot:          types.StringToHash(p.initialStateRoot),
+		InitialStateDump:         p.initialStateDump,
 		NativeTokenConfig:        p.nativeTokenConfig,
 		MinValidatorSetSize:      p.minNumValidators,
 		MaxValidatorSetSize:      p.maxNumValidators,
@@ -12,9 +12,12 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/umbracle/ethgo/jsonrpc"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 )
 
+const validatorHealthFlag = "validator-health"
+
 func GetCommand() *cobra.Command {
 	monitorCmd := &cobra.Command{
 		Use:   "monitor",
@@ -23,6 +26,14 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(monitorCmd)
+	helper.RegisterJSONRPCFlag(monitorCmd)
+
+	monitorCmd.Flags().Bool(
+		validatorHealthFlag,
+		false,
+		"log the liveness statistics of the validators (hydra_validatorHealth) on each added block, "+
+			"instead of the block events",
+	)
 
 	return monitorCmd
 }
@@ -31,15 +42,34 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	var healthClient *jsonrpc.Client
+
+	if validatorHealth, _ := cmd.Flags().GetBool(validatorHealthFlag); validatorHealth {
+		client, err := jsonrpc.NewClient(helper.GetJSONRPCAddress(cmd))
+		if err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		defer client.Close()
+
+		healthClient = client
+	}
+
 	subscribeToEvents(
 		outputter,
 		helper.GetGRPCAddress(cmd),
+		healthClient,
 	)
 }
 
+// subscribeToEvents logs the block events, or the validator statistics
+// on each added block if the health client is set
 func subscribeToEvents(
 	outputter command.OutputFormatter,
 	grpcAddress string,
+	healthClient *jsonrpc.Client,
 ) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	defer cancelFn()
@@ -55,6 +85,7 @@ func subscribeToEvents(
 	runSubscribeLoop(
 		stream,
 		outputter,
+		healthClient,
 	)
 }
 
@@ -73,6 +104,7 @@ func getMonitorStream(
 func runSubscribeLoop(
 	stream proto.System_SubscribeClient,
 	outputter command.OutputFormatter,
+	healthClient *jsonrpc.Client,
 ) {
 	doneCh := make(chan struct{})
 
@@ -97,7 +129,26 @@ func runSubscribeLoop(
 				break
 			}
 
-			outputter.SetCommandResult(NewBlockEventResult(streamEvent))
+			if healthClient == nil {
+				outputter.SetCommandResult(NewBlockEventResult(streamEvent))
+				flushOutput()
+
+				continue
+			}
+
+			if len(streamEvent.Added) == 0 {
+				continue
+			}
+
+			health, err := getValidatorHealth(healthClient)
+			if err != nil {
+				outputter.SetError(fmt.Errorf("failed to get validator health: %w", err))
+				outputter.WriteOutput()
+
+				continue
+			}
+
+			outputter.SetCommandResult(health)
 			flushOutput()
 		}
 
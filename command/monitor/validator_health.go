@@ -0,0 +1,134 @@
+package monitor
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+)
+
+// validatorHealthReport is the hydra_validatorHealth response
+type validatorHealthReport struct {
+	BlockNumber ethgo.ArgUint64 `json:"blockNumber"`
+	Validators  []struct {
+		Address                 types.Address   `json:"address"`
+		SignedBlocks            ethgo.ArgUint64 `json:"signedBlocks"`
+		MissedBlocks            ethgo.ArgUint64 `json:"missedBlocks"`
+		ConsecutiveMissedBlocks ethgo.ArgUint64 `json:"consecutiveMissedBlocks"`
+		LastSignedBlock         ethgo.ArgUint64 `json:"lastSignedBlock"`
+		ProposedBlocks          ethgo.ArgUint64 `json:"proposedBlocks"`
+		MissedProposals         ethgo.ArgUint64 `json:"missedProposals"`
+		DoubleSigns             ethgo.ArgUint64 `json:"doubleSigns"`
+	} `json:"validators"`
+	DoubleSigns []struct {
+		Validator      types.Address   `json:"validator"`
+		Height         ethgo.ArgUint64 `json:"height"`
+		Round          ethgo.ArgUint64 `json:"round"`
+		ProposalHashes []types.Hash    `json:"proposalHashes"`
+	} `json:"doubleSigns"`
+}
+
+// getValidatorHealth queries the validator statistics collected by the node
+func getValidatorHealth(client *jsonrpc.Client) (*ValidatorHealthResult, error) {
+	var report *validatorHealthReport
+	if err := client.Call("hydra_validatorHealth", &report); err != nil {
+		return nil, err
+	}
+
+	res := &ValidatorHealthResult{
+		BlockNumber: uint64(report.BlockNumber),
+		Validators:  make([]*ValidatorHealth, len(report.Validators)),
+		DoubleSigns: make([]*DoubleSignEvidence, len(report.DoubleSigns)),
+	}
+
+	for i, v := range report.Validators {
+		res.Validators[i] = &ValidatorHealth{
+			Address:                 v.Address.String(),
+			SignedBlocks:            uint64(v.SignedBlocks),
+			MissedBlocks:            uint64(v.MissedBlocks),
+			ConsecutiveMissedBlocks: uint64(v.ConsecutiveMissedBlocks),
+			LastSignedBlock:         uint64(v.LastSignedBlock),
+			ProposedBlocks:          uint64(v.ProposedBlocks),
+			MissedProposals:         uint64(v.MissedProposals),
+			DoubleSigns:             uint64(v.DoubleSigns),
+		}
+	}
+
+	for i, e := range report.DoubleSigns {
+		hashes := make([]string, len(e.ProposalHashes))
+		for k, h := range e.ProposalHashes {
+			hashes[k] = h.String()
+		}
+
+		res.DoubleSigns[i] = &DoubleSignEvidence{
+			Validator:      e.Validator.String(),
+			Height:         uint64(e.Height),
+			Round:          uint64(e.Round),
+			ProposalHashes: hashes,
+		}
+	}
+
+	return res, nil
+}
+
+type ValidatorHealth struct {
+	Address                 string `json:"address"`
+	SignedBlocks            uint64 `json:"signed_blocks"`
+	MissedBlocks            uint64 `json:"missed_blocks"`
+	ConsecutiveMissedBlocks uint64 `json:"consecutive_missed_blocks"`
+	LastSignedBlock         uint64 `json:"last_signed_block"`
+	ProposedBlocks          uint64 `json:"proposed_blocks"`
+	MissedProposals         uint64 `json:"missed_proposals"`
+	DoubleSigns             uint64 `json:"double_signs"`
+}
+
+type DoubleSignEvidence struct {
+	Validator      string   `json:"validator"`
+	Height         uint64   `json:"height"`
+	Round          uint64   `json:"round"`
+	ProposalHashes []string `json:"proposal_hashes"`
+}
+
+type ValidatorHealthResult struct {
+	BlockNumber uint64                `json:"block_number"`
+	Validators  []*ValidatorHealth    `json:"validators"`
+	DoubleSigns []*DoubleSignEvidence `json:"double_signs"`
+}
+
+func (r *ValidatorHealthResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[VALIDATOR HEALTH AT BLOCK %d]\n", r.BlockNumber))
+
+	rows := make([]string, len(r.Validators)+1)
+	rows[0] = "Address|Signed|Missed|Consecutive Missed|Last Signed|Proposed|Missed Proposals|Double Signs"
+
+	for i, v := range r.Validators {
+		rows[i+1] = fmt.Sprintf("%s|%d|%d|%d|%d|%d|%d|%d",
+			v.Address, v.SignedBlocks, v.MissedBlocks, v.ConsecutiveMissedBlocks, v.LastSignedBlock,
+			v.ProposedBlocks, v.MissedProposals, v.DoubleSigns,
+		)
+	}
+
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	if len(r.DoubleSigns) > 0 {
+		buffer.WriteString("\n[DOUBLE SIGNS]\n")
+
+		rows = make([]string, len(r.DoubleSigns)+1)
+		rows[0] = "Validator|Height|Round|Proposal Hashes"
+
+		for i, e := range r.DoubleSigns {
+			rows[i+1] = fmt.Sprintf("%s|%d|%d|%v", e.Validator, e.Height, e.Round, e.ProposalHashes)
+		}
+
+		buffer.WriteString(helper.FormatList(rows))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
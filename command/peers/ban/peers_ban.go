@@ -0,0 +1,61 @@
+package ban
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	peersBanCmd := &cobra.Command{
+		Use:     "ban",
+		Short:   "Disconnects the specified peer and refuses its connections for the ban duration",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(peersBanCmd)
+	helper.SetRequiredFlags(peersBanCmd, params.getRequiredFlags())
+
+	return peersBanCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.peerID,
+		peerIDFlag,
+		"",
+		"libp2p node ID of the peer to ban",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.duration,
+		durationFlag,
+		0,
+		"the duration of the ban (the default ban duration of the node is used if not set)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.reason,
+		reasonFlag,
+		"",
+		"the reason of the ban",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.banPeer(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,26 @@
+package ban
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type PeersBanResult struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func (r *PeersBanResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER BAN]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+		fmt.Sprintf("Result|%s", r.Message),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
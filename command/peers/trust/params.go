@@ -0,0 +1,60 @@
+package trust
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &trustParams{}
+)
+
+const (
+	peerIDFlag = "peer-id"
+	revokeFlag = "revoke"
+)
+
+type trustParams struct {
+	peerID string
+	revoke bool
+
+	message string
+}
+
+func (p *trustParams) getRequiredFlags() []string {
+	return []string{
+		peerIDFlag,
+	}
+}
+
+func (p *trustParams) trustPeer(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	resp, err := systemClient.PeersTrust(
+		context.Background(),
+		&proto.PeersTrustRequest{
+			Id:     p.peerID,
+			Revoke: p.revoke,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.message = resp.Message
+
+	return nil
+}
+
+func (p *trustParams) getResult() command.CommandResult {
+	return &PeersTrustResult{
+		ID:      p.peerID,
+		Message: p.message,
+	}
+}
@@ -0,0 +1,49 @@
+package trust
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	peersTrustCmd := &cobra.Command{
+		Use:   "trust",
+		Short: "Marks the specified peer as trusted, allowing it to connect past the inbound peer limit",
+		Run:   runCommand,
+	}
+
+	setFlags(peersTrustCmd)
+	helper.SetRequiredFlags(peersTrustCmd, params.getRequiredFlags())
+
+	return peersTrustCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.peerID,
+		peerIDFlag,
+		"",
+		"libp2p node ID of the peer to trust",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.revoke,
+		revokeFlag,
+		false,
+		"revoke the trust of the peer",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.trustPeer(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
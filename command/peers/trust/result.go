@@ -0,0 +1,26 @@
+package trust
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type PeersTrustResult struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func (r *PeersTrustResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER TRUST]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("ID|%s", r.ID),
+		fmt.Sprintf("Result|%s", r.Message),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
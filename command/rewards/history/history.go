@@ -0,0 +1,72 @@
+package history
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use: "history",
+		Short: "Returns the uptime and the rewards distributed at the end of the epochs. " +
+			"The node must run with the reward history enabled",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(historyCmd)
+	helper.SetRequiredFlags(historyCmd, params.getRequiredFlags())
+
+	return historyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(
+		&params.fromEpoch,
+		fromEpochFlag,
+		0,
+		"the first epoch of the history",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.toEpoch,
+		toEpochFlag,
+		0,
+		"the last epoch of the history",
+	)
+
+	cmd.Flags().StringVar(
+		&params.address,
+		addressFlag,
+		"",
+		"the address of the validator, the uptime and the rewards are filtered to the address if it is set",
+	)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	history, err := params.getRewardHistory()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	result, err := params.getResult(history)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
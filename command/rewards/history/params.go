@@ -0,0 +1,202 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/jsonrpc"
+)
+
+var (
+	params = &historyParams{}
+
+	errInvalidEpochRange = errors.New("the from epoch can't be greater than the to epoch")
+)
+
+const (
+	fromEpochFlag = "from-epoch"
+	toEpochFlag   = "to-epoch"
+	addressFlag   = "address"
+)
+
+type historyParams struct {
+	jsonRPC   string
+	fromEpoch uint64
+	toEpoch   uint64
+	address   string
+}
+
+// accountReward is the reward of an account in the hydra_getRewardHistory response
+type accountReward struct {
+	Account types.Address `json:"account"`
+	Amount  string        `json:"amount"`
+}
+
+// epochRewards is an epoch in the hydra_getRewardHistory response
+type epochRewards struct {
+	Epoch      string `json:"epoch"`
+	StartBlock string `json:"startBlock"`
+	EndBlock   string `json:"endBlock"`
+	Uptime     []struct {
+		Validator    types.Address `json:"validator"`
+		SignedBlocks string        `json:"signedBlocks"`
+	} `json:"uptime"`
+	StakingRewards   []*accountReward `json:"stakingRewards"`
+	DelegatorRewards []*accountReward `json:"delegatorRewards"`
+	RewardWalletFund string           `json:"rewardWalletFund"`
+	DAOIncentive     string           `json:"daoIncentive"`
+	FeeHandlerIncome string           `json:"feeHandlerIncome"`
+}
+
+func (p *historyParams) getRequiredFlags() []string {
+	return []string{
+		fromEpochFlag,
+		toEpochFlag,
+	}
+}
+
+func (p *historyParams) validateFlags() error {
+	if _, err := helper.ParseJSONRPCAddress(p.jsonRPC); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	if p.fromEpoch > p.toEpoch {
+		return errInvalidEpochRange
+	}
+
+	if p.address != "" {
+		if err := types.IsValidAddress(p.address); err != nil {
+			return fmt.Errorf("invalid address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getRewardHistory queries the reward history of the epochs from the node
+func (p *historyParams) getRewardHistory() ([]*epochRewards, error) {
+	client, err := jsonrpc.NewClient(p.jsonRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close()
+
+	args := []interface{}{common.EncodeUint64(p.fromEpoch), common.EncodeUint64(p.toEpoch)}
+	if p.address != "" {
+		args = append(args, types.StringToAddress(p.address))
+	}
+
+	var history []*epochRewards
+	if err := client.Call("hydra_getRewardHistory", &history, args...); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (p *historyParams) getResult(history []*epochRewards) (*RewardHistoryResult, error) {
+	var (
+		totalStakingRewards   = big.NewInt(0)
+		totalDelegatorRewards = big.NewInt(0)
+	)
+
+	result := &RewardHistoryResult{
+		Address: p.address,
+		Epochs:  make([]*EpochRewardsResult, len(history)),
+	}
+
+	for i, h := range history {
+		epoch, err := newEpochRewardsResult(h)
+		if err != nil {
+			return nil, err
+		}
+
+		stakingRewards, _ := new(big.Int).SetString(epoch.StakingRewards, 10)
+		delegatorRewards, _ := new(big.Int).SetString(epoch.DelegatorRewards, 10)
+
+		totalStakingRewards.Add(totalStakingRewards, stakingRewards)
+		totalDelegatorRewards.Add(totalDelegatorRewards, delegatorRewards)
+
+		result.Epochs[i] = epoch
+	}
+
+	result.TotalStakingRewards = totalStakingRewards.String()
+	result.TotalDelegatorRewards = totalDelegatorRewards.String()
+
+	return result, nil
+}
+
+// newEpochRewardsResult sums up the uptime and the rewards of the epoch
+func newEpochRewardsResult(h *epochRewards) (*EpochRewardsResult, error) {
+	var (
+		res = &EpochRewardsResult{}
+		err error
+	)
+
+	if res.Epoch, err = common.ParseUint64orHex(&h.Epoch); err != nil {
+		return nil, err
+	}
+
+	if res.StartBlock, err = common.ParseUint64orHex(&h.StartBlock); err != nil {
+		return nil, err
+	}
+
+	if res.EndBlock, err = common.ParseUint64orHex(&h.EndBlock); err != nil {
+		return nil, err
+	}
+
+	for _, u := range h.Uptime {
+		signedBlocks, err := common.ParseUint64orHex(&u.SignedBlocks)
+		if err != nil {
+			return nil, err
+		}
+
+		res.SignedBlocks += signedBlocks
+	}
+
+	sumRewards := func(rewards []*accountReward) (string, error) {
+		sum := big.NewInt(0)
+
+		for _, r := range rewards {
+			amount, err := common.ParseUint256orHex(&r.Amount)
+			if err != nil {
+				return "", err
+			}
+
+			sum.Add(sum, amount)
+		}
+
+		return sum.String(), nil
+	}
+
+	if res.StakingRewards, err = sumRewards(h.StakingRewards); err != nil {
+		return nil, err
+	}
+
+	if res.DelegatorRewards, err = sumRewards(h.DelegatorRewards); err != nil {
+		return nil, err
+	}
+
+	for _, v := range []struct {
+		src string
+		dst *string
+	}{
+		{h.RewardWalletFund, &res.RewardWalletFund},
+		{h.DAOIncentive, &res.DAOIncentive},
+		{h.FeeHandlerIncome, &res.FeeHandlerIncome},
+	} {
+		amount, err := common.ParseUint256orHex(&v.src)
+		if err != nil {
+			return nil, err
+		}
+
+		*v.dst = amount.String()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,55 @@
+package history
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getResult(t *testing.T) {
+	t.Parallel()
+
+	// the response of hydra_getRewardHistory filtered to a validator
+	response := `[
+		{
+			"epoch": "0x2", "startBlock": "0xb", "endBlock": "0x14",
+			"uptime": [{"validator": "0x0000000000000000000000000000000000000001", "signedBlocks": "0x9"}],
+			"stakingRewards": [{"account": "0x0000000000000000000000000000000000000001", "amount": "0x64"}],
+			"delegatorRewards": [],
+			"rewardWalletFund": "0x0", "daoIncentive": "0x0", "feeHandlerIncome": "0x-a"
+		},
+		{
+			"epoch": "0x3", "startBlock": "0x15", "endBlock": "0x1e",
+			"uptime": [{"validator": "0x0000000000000000000000000000000000000001", "signedBlocks": "0xa"}],
+			"stakingRewards": [{"account": "0x0000000000000000000000000000000000000001", "amount": "0x6e"}],
+			"delegatorRewards": [{"account": "0x0000000000000000000000000000000000000001", "amount": "0x14"}],
+			"rewardWalletFund": "0x3e8", "daoIncentive": "0x28", "feeHandlerIncome": "0x1e"
+		}
+	]`
+
+	var history []*epochRewards
+
+	require.NoError(t, json.Unmarshal([]byte(response), &history))
+
+	p := &historyParams{address: "0x0000000000000000000000000000000000000001"}
+
+	result, err := p.getResult(history)
+	require.NoError(t, err)
+
+	require.Equal(t, p.address, result.Address)
+	require.Equal(t, "210", result.TotalStakingRewards)
+	require.Equal(t, "20", result.TotalDelegatorRewards)
+	require.Equal(t, []*EpochRewardsResult{
+		{
+			Epoch: 2, StartBlock: 11, EndBlock: 20, SignedBlocks: 9,
+			StakingRewards: "100", DelegatorRewards: "0",
+			RewardWalletFund: "0", DAOIncentive: "0", FeeHandlerIncome: "-10",
+		},
+		{
+			Epoch: 3, StartBlock: 21, EndBlock: 30, SignedBlocks: 10,
+			StakingRewards: "110", DelegatorRewards: "20",
+			RewardWalletFund: "1000", DAOIncentive: "40", FeeHandlerIncome: "30",
+		},
+	}, result.Epochs)
+}
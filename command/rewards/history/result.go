@@ -0,0 +1,64 @@
+package history
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type EpochRewardsResult struct {
+	Epoch            uint64 `json:"epoch"`
+	StartBlock       uint64 `json:"start_block"`
+	EndBlock         uint64 `json:"end_block"`
+	SignedBlocks     uint64 `json:"signed_blocks"`
+	StakingRewards   string `json:"staking_rewards"`
+	DelegatorRewards string `json:"delegator_rewards"`
+	RewardWalletFund string `json:"reward_wallet_fund"`
+	DAOIncentive     string `json:"dao_incentive"`
+	FeeHandlerIncome string `json:"fee_handler_income"`
+}
+
+type RewardHistoryResult struct {
+	Address               string                `json:"address,omitempty"`
+	Epochs                []*EpochRewardsResult `json:"epochs"`
+	TotalStakingRewards   string                `json:"total_staking_rewards"`
+	TotalDelegatorRewards string                `json:"total_delegator_rewards"`
+}
+
+func (r *RewardHistoryResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[REWARD HISTORY]\n")
+
+	if r.Address != "" {
+		buffer.WriteString(fmt.Sprintf("Address: %s\n", r.Address))
+	}
+
+	if len(r.Epochs) == 0 {
+		buffer.WriteString("No indexed epochs found\n")
+
+		return buffer.String()
+	}
+
+	rows := make([]string, len(r.Epochs)+1)
+	rows[0] = "Epoch|Blocks|Signed Blocks|Staking Rewards|Delegator Rewards|Reward Wallet Fund|" +
+		"DAO Incentive|Fee Handler Income"
+
+	for i, e := range r.Epochs {
+		rows[i+1] = fmt.Sprintf("%d|%d-%d|%d|%s|%s|%s|%s|%s",
+			e.Epoch, e.StartBlock, e.EndBlock, e.SignedBlocks, e.StakingRewards, e.DelegatorRewards,
+			e.RewardWalletFund, e.DAOIncentive, e.FeeHandlerIncome,
+		)
+	}
+
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Total Staking Rewards|%s", r.TotalStakingRewards),
+		fmt.Sprintf("Total Delegator Rewards|%s", r.TotalDelegatorRewards),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,27 @@
+package rewards
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/rewards/history"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	rewardsCmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "Top level command for querying the epoch rewards. Only accepts subcommands.",
+	}
+
+	helper.RegisterJSONRPCFlag(rewardsCmd)
+
+	registerSubcommands(rewardsCmd)
+
+	return rewardsCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// rewards history
+		history.GetCommand(),
+	)
+}
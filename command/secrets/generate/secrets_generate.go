@@ -50,12 +50,13 @@ func setFlags(cmd *cobra.Command) {
 		typeFlag,
 		string(secrets.HashicorpVault),
 		fmt.Sprintf(
-			"the type of the secrets manager. Available types: %s, %s, %s, %s and %s",
+			"the type of the secrets manager. Available types: %s, %s, %s, %s, %s and %s",
 			secrets.Local,
 			secrets.EncryptedLocal,
 			secrets.HashicorpVault,
 			secrets.AWSSSM,
 			secrets.GCPSSM,
+			secrets.RemoteSigner,
 		),
 	)
 
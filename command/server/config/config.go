@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/network"
+	priceoracle "github.com/0xPolygon/polygon-edge/price-oracle"
 	"github.com/hashicorp/hcl"
 	"gopkg.in/yaml.v3"
 )
@@ -40,6 +41,18 @@ type Config struct {
 	WebSocketReadLimit      uint64 `json:"web_socket_read_limit" yaml:"web_socket_read_limit"`
 
 	MetricsInterval time.Duration `json:"metrics_interval" yaml:"metrics_interval"`
+
+	PruneStateRetainBlocks uint64 `json:"prune_state_retain_blocks" yaml:"prune_state_retain_blocks"`
+	PruneStateInterval     uint64 `json:"prune_state_interval" yaml:"prune_state_interval"`
+
+	StateSync bool `json:"state_sync" yaml:"state_sync"`
+
+	RewardHistory bool `json:"reward_history" yaml:"reward_history"`
+
+	MonitorWebhook               string `json:"monitor_webhook" yaml:"monitor_webhook"`
+	MonitorMissedBlocksThreshold uint64 `json:"monitor_missed_blocks_threshold" yaml:"monitor_missed_blocks_threshold"`
+
+	PriceFeed *priceoracle.PriceFeedConfig `json:"price_feed,omitempty" yaml:"price_feed,omitempty"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -56,6 +69,15 @@ type Network struct {
 	MaxPeers         int64  `json:"max_peers,omitempty" yaml:"max_peers,omitempty"`
 	MaxOutboundPeers int64  `json:"max_outbound_peers,omitempty" yaml:"max_outbound_peers,omitempty"`
 	MaxInboundPeers  int64  `json:"max_inbound_peers,omitempty" yaml:"max_inbound_peers,omitempty"`
+
+	GossipWorkers      int           `json:"gossip_workers" yaml:"gossip_workers"`
+	NoPeerScoring      bool          `json:"no_peer_scoring" yaml:"no_peer_scoring"`
+	MaxInvalidMessages uint64        `json:"max_invalid_gossip_messages" yaml:"max_invalid_gossip_messages"`
+	PeerBanDuration    time.Duration `json:"peer_ban_duration" yaml:"peer_ban_duration"`
+
+	StaticPeers  []string `json:"static_peers,omitempty" yaml:"static_peers,omitempty"`
+	TrustedPeers []string `json:"trusted_peers,omitempty" yaml:"trusted_peers,omitempty"`
+	PrivateMode  bool     `json:"private_mode" yaml:"private_mode"`
 }
 
 // TxPool defines the TxPool configuration params
@@ -63,6 +85,7 @@ type TxPool struct {
 	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
 	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
 	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	Journal            bool   `json:"journal" yaml:"journal"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -97,6 +120,13 @@ const (
 	// DefaultMetricsInterval specifies the time interval after which Prometheus metrics will be generated.
 	// A value of 0 means the metrics are disabled.
 	DefaultMetricsInterval time.Duration = time.Second * 8
+
+	// DefaultPruneStateInterval specifies the number of blocks between two state pruning runs
+	DefaultPruneStateInterval uint64 = 1000
+
+	// DefaultMonitorMissedBlocksThreshold specifies the number of the consecutive blocks missed by a validator
+	// which triggers the validator monitor alert
+	DefaultMonitorMissedBlocksThreshold uint64 = 10
 )
 
 // DefaultConfig returns the default server configuration
@@ -116,6 +146,10 @@ func DefaultConfig() *Config {
 				defaultNetworkConfig.Addr.IP,
 				defaultNetworkConfig.Addr.Port,
 			),
+			GossipWorkers:      defaultNetworkConfig.GossipWorkers,
+			NoPeerScoring:      defaultNetworkConfig.PeerScore == nil,
+			MaxInvalidMessages: defaultNetworkConfig.MaxInvalidMessages,
+			PeerBanDuration:    defaultNetworkConfig.PeerBanDuration,
 		},
 		Telemetry:  &Telemetry{},
 		ShouldSeal: true,
@@ -123,20 +157,27 @@ func DefaultConfig() *Config {
 			PriceLimit:         0,
 			MaxSlots:           32768,
 			MaxAccountEnqueued: 2048,
+			Journal:            false,
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
 		Headers: &Headers{
 			AccessControlAllowOrigins: []string{"*"},
 		},
-		LogFilePath:              "",
-		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
-		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
-		Relayer:                  false,
-		NumBlockConfirmations:    DefaultNumBlockConfirmations,
-		ConcurrentRequestsDebug:  DefaultConcurrentRequestsDebug,
-		WebSocketReadLimit:       DefaultWebSocketReadLimit,
-		MetricsInterval:          DefaultMetricsInterval,
+		LogFilePath:                  "",
+		JSONRPCBatchRequestLimit:     DefaultJSONRPCBatchRequestLimit,
+		JSONRPCBlockRangeLimit:       DefaultJSONRPCBlockRangeLimit,
+		Relayer:                      false,
+		NumBlockConfirmations:        DefaultNumBlockConfirmations,
+		ConcurrentRequestsDebug:      DefaultConcurrentRequestsDebug,
+		WebSocketReadLimit:           DefaultWebSocketReadLimit,
+		MetricsInterval:              DefaultMetricsInterval,
+		PruneStateRetainBlocks:       0,
+		PruneStateInterval:           DefaultPruneStateInterval,
+		StateSync:                    false,
+		RewardHistory:                false,
+		MonitorWebhook:               "",
+		MonitorMissedBlocksThreshold: DefaultMonitorMissedBlocksThreshold,
 	}
 }
 
@@ -168,6 +209,9 @@ func ReadConfigFile(path string) (*Config, error) {
 	config.Network.MaxPeers = -1
 	config.Network.MaxInboundPeers = -1
 	config.Network.MaxOutboundPeers = -1
+	config.Network.GossipWorkers = network.DefaultGossipWorkers
+	config.Network.MaxInvalidMessages = network.DefaultMaxInvalidMessages
+	config.Network.PeerBanDuration = network.DefaultPeerBanDuration
 
 	if err := unmarshalFunc(data, config); err != nil {
 		return nil, err
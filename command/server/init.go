@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/url"
 
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 
@@ -20,6 +21,8 @@ import (
 
 var (
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errInvalidPruneInterval   = errors.New("state pruning interval must be greater than zero")
+	errNonLocalMonitorWebhook = errors.New("validator monitor webhook must be a local http(s) url")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -49,6 +52,14 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initStatePruning(); err != nil {
+		return err
+	}
+
+	if err := p.initMonitorWebhook(); err != nil {
+		return err
+	}
+
 	if p.isDevMode {
 		p.initDevMode()
 	}
@@ -69,6 +80,38 @@ func (p *serverParams) initDataDirLocation() error {
 	return nil
 }
 
+func (p *serverParams) initStatePruning() error {
+	if p.rawConfig.PruneStateRetainBlocks > 0 && p.rawConfig.PruneStateInterval == 0 {
+		return errInvalidPruneInterval
+	}
+
+	return nil
+}
+
+// initMonitorWebhook checks that the validator monitor alerts are sent to the local machine only
+func (p *serverParams) initMonitorWebhook() error {
+	if p.rawConfig.MonitorWebhook == "" {
+		return nil
+	}
+
+	webhook, err := url.Parse(p.rawConfig.MonitorWebhook)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errNonLocalMonitorWebhook, err)
+	}
+
+	if webhook.Scheme != "http" && webhook.Scheme != "https" {
+		return errNonLocalMonitorWebhook
+	}
+
+	if host := webhook.Hostname(); host != "localhost" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			return errNonLocalMonitorWebhook
+		}
+	}
+
+	return nil
+}
+
 func (p *serverParams) initLogFileLocation() {
 	if p.isLogFileLocationSet() {
 		p.logFileLocation = p.rawConfig.LogFilePath
@@ -30,6 +30,7 @@ const (
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	txPoolJournalFlag            = "txpool-journal"
 	blockGasTargetFlag           = "block-gas-target"
 	restoreFlag                  = "restore"
 	devIntervalFlag              = "dev-interval"
@@ -44,6 +45,25 @@ const (
 	webSocketReadLimitFlag      = "websocket-read-limit"
 
 	metricsIntervalFlag = "metrics-interval"
+
+	pruneStateRetainBlocksFlag = "prune-state-retain-blocks"
+	pruneStateIntervalFlag     = "prune-state-interval"
+
+	stateSyncFlag = "state-sync"
+
+	rewardHistoryFlag = "reward-history"
+
+	monitorWebhookFlag               = "monitor-webhook"
+	monitorMissedBlocksThresholdFlag = "monitor-missed-blocks-threshold"
+
+	gossipWorkersFlag      = "gossip-workers"
+	noPeerScoringFlag      = "no-peer-scoring"
+	maxInvalidMessagesFlag = "max-invalid-gossip-messages"
+	peerBanDurationFlag    = "peer-ban-duration"
+
+	staticPeersFlag  = "static-peers"
+	trustedPeersFlag = "trusted-peers"
+	privateModeFlag  = "private-mode"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -148,6 +168,15 @@ func (p *serverParams) setJSONLogFormat(jsonLogFormat bool) {
 	p.rawConfig.JSONLogFormat = jsonLogFormat
 }
 
+// peerScoreConfig returns the GossipSub peer scoring parameters, or nil if the peer scoring is disabled
+func (p *serverParams) peerScoreConfig() *network.PeerScoreConfig {
+	if p.rawConfig.Network.NoPeerScoring {
+		return nil
+	}
+
+	return network.DefaultPeerScoreConfig()
+}
+
 func (p *serverParams) generateConfig() *server.Config {
 	return &server.Config{
 		Chain: p.genesisConfig,
@@ -165,21 +194,29 @@ func (p *serverParams) generateConfig() *server.Config {
 			PrometheusAddr: p.prometheusAddress,
 		},
 		Network: &network.Config{
-			NoDiscover:       p.rawConfig.Network.NoDiscover,
-			Addr:             p.libp2pAddress,
-			NatAddr:          p.natAddress,
-			DNS:              p.dnsAddress,
-			DataDir:          p.rawConfig.DataDir,
-			MaxPeers:         p.rawConfig.Network.MaxPeers,
-			MaxInboundPeers:  p.rawConfig.Network.MaxInboundPeers,
-			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
-			Chain:            p.genesisConfig,
+			NoDiscover:         p.rawConfig.Network.NoDiscover,
+			Addr:               p.libp2pAddress,
+			NatAddr:            p.natAddress,
+			DNS:                p.dnsAddress,
+			DataDir:            p.rawConfig.DataDir,
+			MaxPeers:           p.rawConfig.Network.MaxPeers,
+			MaxInboundPeers:    p.rawConfig.Network.MaxInboundPeers,
+			MaxOutboundPeers:   p.rawConfig.Network.MaxOutboundPeers,
+			Chain:              p.genesisConfig,
+			GossipWorkers:      p.rawConfig.Network.GossipWorkers,
+			PeerScore:          p.peerScoreConfig(),
+			MaxInvalidMessages: p.rawConfig.Network.MaxInvalidMessages,
+			PeerBanDuration:    p.rawConfig.Network.PeerBanDuration,
+			StaticPeers:        p.rawConfig.Network.StaticPeers,
+			TrustedPeers:       p.rawConfig.Network.TrustedPeers,
+			PrivateMode:        p.rawConfig.Network.PrivateMode,
 		},
 		DataDir:            p.rawConfig.DataDir,
 		Seal:               p.rawConfig.ShouldSeal,
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
+		TxPoolJournal:      p.rawConfig.TxPool.Journal,
 		SecretsManager:     p.secretsConfig,
 		RestoreFile:        p.getRestoreFilePath(),
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
@@ -190,5 +227,16 @@ func (p *serverParams) generateConfig() *server.Config {
 		Relayer:               false,
 		NumBlockConfirmations: p.rawConfig.NumBlockConfirmations,
 		MetricsInterval:       p.rawConfig.MetricsInterval,
+		PriceFeed:             p.rawConfig.PriceFeed,
+
+		PruneStateRetainBlocks: p.rawConfig.PruneStateRetainBlocks,
+		PruneStateInterval:     p.rawConfig.PruneStateInterval,
+
+		StateSync: p.rawConfig.StateSync,
+
+		RewardHistory: p.rawConfig.RewardHistory,
+
+		MonitorWebhook:               p.rawConfig.MonitorWebhook,
+		MonitorMissedBlocksThreshold: p.rawConfig.MonitorMissedBlocksThreshold,
 	}
 }
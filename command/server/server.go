@@ -190,6 +190,13 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxPool.Journal,
+		txPoolJournalFlag,
+		defaultConfig.TxPool.Journal,
+		"persist the locally submitted transactions in a journal, so they survive node restarts",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.rawConfig.CorsAllowedOrigins,
 		corsOriginFlag,
@@ -247,6 +254,105 @@ func setFlags(cmd *cobra.Command) {
 		"the interval (in seconds) at which special metrics are generated. a value of zero means the metrics are disabled",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.PruneStateRetainBlocks,
+		pruneStateRetainBlocksFlag,
+		defaultConfig.PruneStateRetainBlocks,
+		"number of latest blocks whose state is kept, older state is pruned. a value of zero disables pruning (archive node)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.PruneStateInterval,
+		pruneStateIntervalFlag,
+		defaultConfig.PruneStateInterval,
+		"number of blocks between two state pruning runs",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.StateSync,
+		stateSyncFlag,
+		defaultConfig.StateSync,
+		"download the state of a recent block from the peers instead of executing all the blocks "+
+			"when the node is new and far behind the network",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.RewardHistory,
+		rewardHistoryFlag,
+		defaultConfig.RewardHistory,
+		"index the uptime and the rewards distributed at the end of each epoch, "+
+			"served by hydra_getRewardHistory",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.MonitorWebhook,
+		monitorWebhookFlag,
+		defaultConfig.MonitorWebhook,
+		"the local url (localhost or loopback address) which receives the validator monitor alerts "+
+			"(missed blocks, missed proposals and double signs) as JSON POST requests",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.MonitorMissedBlocksThreshold,
+		monitorMissedBlocksThresholdFlag,
+		defaultConfig.MonitorMissedBlocksThreshold,
+		"the number of the consecutive blocks missed by a validator which triggers the validator monitor alert "+
+			"(0 disables the alert)",
+	)
+
+	cmd.Flags().IntVar(
+		&params.rawConfig.Network.GossipWorkers,
+		gossipWorkersFlag,
+		defaultConfig.Network.GossipWorkers,
+		"the number of workers handling the messages of each gossip topic",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.NoPeerScoring,
+		noPeerScoringFlag,
+		defaultConfig.Network.NoPeerScoring,
+		"disable the GossipSub scoring of the peers delivering invalid messages",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Network.MaxInvalidMessages,
+		maxInvalidMessagesFlag,
+		defaultConfig.Network.MaxInvalidMessages,
+		"the number of invalid gossip messages a peer can deliver within a minute before it is banned "+
+			"(0 disables the ban)",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.rawConfig.Network.PeerBanDuration,
+		peerBanDurationFlag,
+		defaultConfig.Network.PeerBanDuration,
+		"the duration of the ban of a peer delivering too many invalid gossip messages",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.StaticPeers,
+		staticPeersFlag,
+		defaultConfig.Network.StaticPeers,
+		"the libp2p addresses of the static peers, which are always redialed and "+
+			"are not limited by the maximum number of outbound peers",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.TrustedPeers,
+		trustedPeersFlag,
+		defaultConfig.Network.TrustedPeers,
+		"the libp2p node IDs of the trusted peers, which are not limited by the maximum number of inbound peers "+
+			"(trusted through the configuration only, the trust is not persisted)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.PrivateMode,
+		privateModeFlag,
+		defaultConfig.Network.PrivateMode,
+		"run the node behind its static (sentry) peers: the discovery is disabled, only the static and trusted "+
+			"peers can connect, and the node address is not advertised by its peers",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
@@ -87,6 +87,8 @@ func setFlags(cmd *cobra.Command) {
 
 	helper.RegisterJSONRPCFlag(cmd)
 
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountConfigFlag, polybftsecrets.AccountDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(command.CommissionFlag, applyFlag, claimFlag)
 }
@@ -101,6 +103,29 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	if params.offline.IsOffline() {
+		input, err := generateCommissionInput(params.offline.SenderAddress())
+		if err != nil {
+			return err
+		}
+
+		txn := sidechain.CreateTransaction(
+			params.offline.SenderAddress(),
+			(*ethgo.Address)(&delegationManager),
+			input,
+			nil,
+		)
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon commission", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
 	secretsManager, err := polybftsecrets.GetSecretsManager(
 		params.accountDir,
 		params.accountConfig,
@@ -123,15 +148,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	var input []byte
-	if params.apply { //nolint:gocritic
-		input, err = generateApplyPendingCommissionFn()
-	} else if params.claim {
-		input, err = generateClaimCommissionFn(validatorAccount)
-	} else {
-		input, err = generateSetPendingCommissionFn()
-	}
-
+	input, err := generateCommissionInput(validatorAccount.Ecdsa.Address())
 	if err != nil {
 		return err
 	}
@@ -217,6 +234,17 @@ func sendCommissionTx(sender txrelayer.TxRelayer, account *wallet.Account, input
 	return receipt, err
 }
 
+// generateCommissionInput encodes the commission function selected by the flags
+func generateCommissionInput(sender ethgo.Address) ([]byte, error) {
+	if params.apply {
+		return generateApplyPendingCommissionFn()
+	} else if params.claim {
+		return generateClaimCommissionFn(sender)
+	}
+
+	return generateSetPendingCommissionFn()
+}
+
 func generateSetPendingCommissionFn() ([]byte, error) {
 	setPendingCommisionFn := &contractsapi.SetPendingCommissionHydraDelegationFn{
 		NewCommission: new(big.Int).SetUint64(params.commission),
@@ -241,9 +269,9 @@ func generateApplyPendingCommissionFn() ([]byte, error) {
 	return encoded, err
 }
 
-func generateClaimCommissionFn(account *wallet.Account) ([]byte, error) {
+func generateClaimCommissionFn(sender ethgo.Address) ([]byte, error) {
 	claimCommisionFn := &contractsapi.ClaimCommissionHydraDelegationFn{
-		To: (types.Address)(account.Ecdsa.Address()),
+		To: (types.Address)(sender),
 	}
 
 	encoded, err := claimCommisionFn.EncodeAbi()
@@ -21,6 +21,7 @@ type setCommissionParams struct {
 	claim              bool
 	jsonRPC            string
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 }
 
 type setCommissionResult struct {
@@ -31,7 +32,7 @@ type setCommissionResult struct {
 }
 
 func (scp *setCommissionParams) validateFlags() error {
-	if err := sidechainHelper.ValidateSecretFlags(scp.accountDir, scp.accountConfig); err != nil {
+	if err := scp.offline.ValidateSecretFlags(scp.accountDir, scp.accountConfig); err != nil {
 		return err
 	}
 
@@ -0,0 +1,343 @@
+package sidechain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+)
+
+const (
+	UnsignedOutFlag = "unsigned-out"
+	SenderFlag      = "sender"
+	NonceFlag       = "nonce"
+	GasFlag         = "gas"
+	GasPriceFlag    = "gas-price"
+	ChainIDFlag     = "chain-id"
+
+	// offlineGasIncreasePercentage is the increase of the estimated gas limit and the gas price,
+	// which leaves a margin for the time between building and broadcasting the transaction
+	offlineGasIncreasePercentage = 100
+)
+
+var errMissingSender = errors.New("the sender address is required to build the unsigned transaction")
+
+// UnsignedTransaction is the transaction built in the offline mode, which is signed by the tx sign command
+type UnsignedTransaction struct {
+	// Description is the command which built the transaction
+	Description string         `json:"description"`
+	ChainID     uint64         `json:"chainId"`
+	From        ethgo.Address  `json:"from"`
+	To          *ethgo.Address `json:"to"`
+	Nonce       uint64         `json:"nonce"`
+	Gas         uint64         `json:"gas"`
+	GasPrice    uint64         `json:"gasPrice"`
+	Value       string         `json:"value"`
+	Input       string         `json:"input"`
+}
+
+// ToTransaction converts the unsigned transaction to a legacy transaction
+func (u *UnsignedTransaction) ToTransaction() (*ethgo.Transaction, error) {
+	value, err := common.ParseUint256orHex(&u.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %w", err)
+	}
+
+	input, err := hex.DecodeHex(u.Input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input: %w", err)
+	}
+
+	return &ethgo.Transaction{
+		Type:     ethgo.TransactionLegacy,
+		ChainID:  new(big.Int).SetUint64(u.ChainID),
+		From:     u.From,
+		To:       u.To,
+		Nonce:    u.Nonce,
+		Gas:      u.Gas,
+		GasPrice: u.GasPrice,
+		Value:    value,
+		Input:    input,
+	}, nil
+}
+
+// ReadUnsignedTransaction reads the unsigned transaction from the file
+func ReadUnsignedTransaction(path string) (*UnsignedTransaction, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read unsigned transaction: %w", err)
+	}
+
+	var unsignedTx *UnsignedTransaction
+	if err := json.Unmarshal(raw, &unsignedTx); err != nil {
+		return nil, fmt.Errorf("failed to decode unsigned transaction: %w", err)
+	}
+
+	if unsignedTx.ChainID == 0 || unsignedTx.Gas == 0 {
+		return nil, errors.New("the unsigned transaction has no chain id or gas limit")
+	}
+
+	return unsignedTx, nil
+}
+
+// OfflineParams are the parameters of the offline mode, in which the command writes the unsigned transaction
+// to a file instead of loading the account and sending the transaction
+type OfflineParams struct {
+	UnsignedOut string
+	Sender      string
+	Nonce       string
+	Gas         uint64
+	GasPrice    uint64
+	ChainID     uint64
+}
+
+// RegisterOfflineFlags registers the flags of the offline mode
+func RegisterOfflineFlags(cmd *cobra.Command, p *OfflineParams) {
+	cmd.Flags().StringVar(
+		&p.UnsignedOut,
+		UnsignedOutFlag,
+		"",
+		"the file to write the unsigned transaction to, instead of signing and sending it. "+
+			"The account secrets are not used, the transaction is signed by the tx sign command",
+	)
+
+	cmd.Flags().StringVar(
+		&p.Sender,
+		SenderFlag,
+		"",
+		"the address of the account which signs the transaction (used with --"+UnsignedOutFlag+")",
+	)
+
+	cmd.Flags().StringVar(
+		&p.Nonce,
+		NonceFlag,
+		"",
+		"the nonce of the unsigned transaction, fetched from the node if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&p.Gas,
+		GasFlag,
+		0,
+		"the gas limit of the unsigned transaction, estimated by the node if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&p.GasPrice,
+		GasPriceFlag,
+		0,
+		"the gas price of the unsigned transaction, fetched from the node if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&p.ChainID,
+		ChainIDFlag,
+		0,
+		"the chain id of the unsigned transaction, fetched from the node if not set",
+	)
+}
+
+// IsOffline returns true if the unsigned transaction is written to a file
+func (p *OfflineParams) IsOffline() bool {
+	return p.UnsignedOut != ""
+}
+
+// ValidateSecretFlags validates the offline flags in the offline mode, otherwise the secret flags
+func (p *OfflineParams) ValidateSecretFlags(dataDir, config string) error {
+	if !p.IsOffline() {
+		return ValidateSecretFlags(dataDir, config)
+	}
+
+	if p.Sender == "" {
+		return errMissingSender
+	}
+
+	if err := types.IsValidAddress(p.Sender); err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	if p.Nonce != "" {
+		if _, err := common.ParseUint64orHex(&p.Nonce); err != nil {
+			return fmt.Errorf("invalid nonce '%s': %w", p.Nonce, err)
+		}
+	}
+
+	return nil
+}
+
+// SenderAddress returns the address of the account which signs the unsigned transaction
+func (p *OfflineParams) SenderAddress() ethgo.Address {
+	return ethgo.Address(types.StringToAddress(p.Sender))
+}
+
+// ResolveChainID returns the chain id of the unsigned transaction, which is fetched from the node
+// if it is not set by the flag. The fetched chain id is kept, so it is not fetched again
+func (p *OfflineParams) ResolveChainID(jsonRPC string) (uint64, error) {
+	if p.ChainID != 0 {
+		return p.ChainID, nil
+	}
+
+	client, err := jsonrpc.NewClient(jsonRPC)
+	if err != nil {
+		return 0, err
+	}
+
+	defer client.Close()
+
+	chainID, err := client.Eth().ChainID()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chain id: %w", err)
+	}
+
+	p.ChainID = chainID.Uint64()
+
+	return p.ChainID, nil
+}
+
+// WriteUnsignedTransaction completes the transaction with the nonce, the gas and the chain id
+// (the ones which are not set by the flags are fetched from the node) and writes it to the unsigned transaction file
+func (p *OfflineParams) WriteUnsignedTransaction(
+	jsonRPC, description string,
+	txn *ethgo.Transaction,
+) (command.CommandResult, error) {
+	unsignedTx, err := p.buildUnsignedTransaction(jsonRPC, description, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.MarshalIndent(unsignedTx, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.SaveFileSafe(p.UnsignedOut, raw, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write unsigned transaction: %w", err)
+	}
+
+	return &UnsignedTransactionResult{
+		File:        p.UnsignedOut,
+		Transaction: unsignedTx,
+	}, nil
+}
+
+func (p *OfflineParams) buildUnsignedTransaction(
+	jsonRPC, description string,
+	txn *ethgo.Transaction,
+) (*UnsignedTransaction, error) {
+	value := txn.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	unsignedTx := &UnsignedTransaction{
+		Description: description,
+		ChainID:     p.ChainID,
+		From:        p.SenderAddress(),
+		To:          txn.To,
+		Gas:         p.Gas,
+		GasPrice:    p.GasPrice,
+		Value:       value.String(),
+		Input:       hex.EncodeToHex(txn.Input),
+	}
+
+	if p.Nonce != "" {
+		nonce, err := common.ParseUint64orHex(&p.Nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		unsignedTx.Nonce = nonce
+	}
+
+	// the node is queried only for the values which are not set by the flags
+	if p.Nonce != "" && p.Gas != 0 && p.GasPrice != 0 && p.ChainID != 0 {
+		return unsignedTx, nil
+	}
+
+	client, err := jsonrpc.NewClient(jsonRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close()
+
+	if p.Nonce == "" {
+		if unsignedTx.Nonce, err = client.Eth().GetNonce(unsignedTx.From, ethgo.Pending); err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+	}
+
+	if p.ChainID == 0 {
+		chainID, err := client.Eth().ChainID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain id: %w", err)
+		}
+
+		unsignedTx.ChainID = chainID.Uint64()
+	}
+
+	if p.GasPrice == 0 {
+		gasPrice, err := client.Eth().GasPrice()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %w", err)
+		}
+
+		unsignedTx.GasPrice = gasPrice + (gasPrice * offlineGasIncreasePercentage / 100)
+	}
+
+	if p.Gas == 0 {
+		estimateTx := CreateTransaction(unsignedTx.From, txn.To, txn.Input, value)
+
+		gasLimit, err := client.Eth().EstimateGas(txrelayer.ConvertTxnToCallMsg(estimateTx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+
+		unsignedTx.Gas = gasLimit + (gasLimit * offlineGasIncreasePercentage / 100)
+	}
+
+	return unsignedTx, nil
+}
+
+// UnsignedTransactionResult is the result of the commands run in the offline mode
+type UnsignedTransactionResult struct {
+	File        string               `json:"file"`
+	Transaction *UnsignedTransaction `json:"transaction"`
+}
+
+func (r *UnsignedTransactionResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	to := "-"
+	if r.Transaction.To != nil {
+		to = r.Transaction.To.String()
+	}
+
+	buffer.WriteString("\n[UNSIGNED TRANSACTION]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.File),
+		fmt.Sprintf("Description|%s", r.Transaction.Description),
+		fmt.Sprintf("Chain ID|%d", r.Transaction.ChainID),
+		fmt.Sprintf("From|%s", r.Transaction.From),
+		fmt.Sprintf("To|%s", to),
+		fmt.Sprintf("Nonce|%d", r.Transaction.Nonce),
+		fmt.Sprintf("Gas|%d", r.Transaction.Gas),
+		fmt.Sprintf("Gas Price|%d", r.Transaction.GasPrice),
+		fmt.Sprintf("Value|%s", r.Transaction.Value),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,76 @@
+package sidechain
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+func TestOfflineParams_ValidateSecretFlags(t *testing.T) {
+	t.Parallel()
+
+	p := &OfflineParams{UnsignedOut: "tx.json"}
+	require.ErrorIs(t, p.ValidateSecretFlags("", ""), errMissingSender)
+
+	p.Sender = "0x123"
+	require.Error(t, p.ValidateSecretFlags("", ""))
+
+	p.Sender = "0x211881Bb4893dd733825A2D97e48bFc38cc70a0c"
+	p.Nonce = "abc"
+	require.Error(t, p.ValidateSecretFlags("", ""))
+
+	// the secrets are not needed in the offline mode
+	p.Nonce = "0x5"
+	require.NoError(t, p.ValidateSecretFlags("", ""))
+}
+
+func TestOfflineParams_WriteUnsignedTransaction(t *testing.T) {
+	t.Parallel()
+
+	key, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	p := &OfflineParams{
+		UnsignedOut: filepath.Join(t.TempDir(), "tx.json"),
+		Sender:      key.Address().String(),
+		Nonce:       "7",
+		Gas:         100000,
+		GasPrice:    2000,
+		ChainID:     8844,
+	}
+
+	to := ethgo.Address(contracts.HydraStakingContract)
+	txn := CreateTransaction(ethgo.ZeroAddress, &to, []byte{0x1, 0x2}, big.NewInt(1000))
+
+	// all the values are set by the flags, so the node is not queried
+	_, err = p.WriteUnsignedTransaction("http://127.0.0.1:1", "hydragon stake", txn)
+	require.NoError(t, err)
+
+	unsignedTx, err := ReadUnsignedTransaction(p.UnsignedOut)
+	require.NoError(t, err)
+	require.Equal(t, "hydragon stake", unsignedTx.Description)
+	require.Equal(t, key.Address(), unsignedTx.From)
+
+	decoded, err := unsignedTx.ToTransaction()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), decoded.Nonce)
+	require.Equal(t, uint64(100000), decoded.Gas)
+	require.Equal(t, uint64(2000), decoded.GasPrice)
+	require.Equal(t, &to, decoded.To)
+	require.Equal(t, big.NewInt(1000), decoded.Value)
+	require.Equal(t, []byte{0x1, 0x2}, decoded.Input)
+
+	// the signed transaction is recovered to the sender
+	signer := wallet.NewEIP155Signer(unsignedTx.ChainID)
+	signed, err := signer.SignTx(decoded, key)
+	require.NoError(t, err)
+
+	from, err := signer.RecoverSender(signed)
+	require.NoError(t, err)
+	require.Equal(t, key.Address(), from)
+}
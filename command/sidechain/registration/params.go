@@ -23,6 +23,7 @@ type registerParams struct {
 	stake              string
 	commission         uint64
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 }
 
 func (rp *registerParams) getRequiredFlags() []string {
@@ -36,6 +37,13 @@ func (rp *registerParams) validateFlags() error {
 		return err
 	}
 
+	// the secrets are still needed in the offline mode, because the BLS key is a part of the registration
+	if rp.offline.IsOffline() {
+		if err := rp.offline.ValidateSecretFlags(rp.accountDir, rp.accountConfig); err != nil {
+			return err
+		}
+	}
+
 	if _, err := helper.ParseJSONRPCAddress(rp.jsonRPC); err != nil {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
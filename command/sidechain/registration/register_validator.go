@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	polybftsigner "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/common"
@@ -85,8 +86,10 @@ func setFlags(cmd *cobra.Command) {
 	)
 
 	helper.RegisterJSONRPCFlag(cmd)
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
 
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountConfigFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(stakeFlag, sidechain.UnsignedOutFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -108,30 +111,63 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	txRelayer, err := txrelayer.NewTxRelayer(
-		txrelayer.WithIPAddress(params.jsonRPC),
-		txrelayer.WithReceiptTimeout(150*time.Millisecond),
-	)
+	sRaw, err := secretsManager.GetSecret(secrets.ValidatorBLSSignature)
 	if err != nil {
 		return err
 	}
 
-	newValidatorAccount, err := wallet.NewAccountFromSecret(secretsManager)
+	sb, err := hex.DecodeString(string(sRaw))
 	if err != nil {
 		return err
 	}
 
-	sRaw, err := secretsManager.GetSecret(secrets.ValidatorBLSSignature)
+	blsSignature, err := bls.UnmarshalSignature(sb)
 	if err != nil {
 		return err
 	}
 
-	sb, err := hex.DecodeString(string(sRaw))
+	// only the BLS key of the validator is needed to build the unsigned registration transaction
+	if params.offline.IsOffline() {
+		blsKey, err := wallet.GetBlsFromSecret(secretsManager)
+		if err != nil {
+			return err
+		}
+
+		chainID, err := params.offline.ResolveChainID(params.jsonRPC)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRegistrationSender(
+			params.offline.SenderAddress(), blsKey.PublicKey(), blsSignature, chainID,
+		); err != nil {
+			return err
+		}
+
+		txn, err := createRegisterTransaction(params.offline.SenderAddress(), blsKey.PublicKey(), blsSignature)
+		if err != nil {
+			return err
+		}
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon register-validator", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(
+		txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond),
+	)
 	if err != nil {
 		return err
 	}
 
-	blsSignature, err := bls.UnmarshalSignature(sb)
+	newValidatorAccount, err := wallet.NewAccountFromSecret(secretsManager)
 	if err != nil {
 		return err
 	}
@@ -194,6 +230,37 @@ func registerValidator(
 	account *wallet.Account,
 	signature *bls.Signature,
 ) (*ethgo.Receipt, error) {
+	txn, err := createRegisterTransaction(account.Ecdsa.Address(), account.Bls.PublicKey(), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return sender.SendTransaction(txn, account.Ecdsa)
+}
+
+// checkRegistrationSender checks if the BLS signature of the validator is made for the sender of the
+// registration transaction, otherwise the transaction signed offline is rejected by the hydra chain contract
+func checkRegistrationSender(
+	sender ethgo.Address,
+	pubkey *bls.PublicKey,
+	signature *bls.Signature,
+	chainID uint64,
+) error {
+	if !polybftsigner.VerifyKOSKSignature(
+		signature, pubkey, types.Address(sender), int64(chainID), polybftsigner.DomainHydraChain, //nolint:gosec
+	) {
+		return fmt.Errorf("the BLS signature of the validator is not made for the sender %s on the chain %d",
+			sender, chainID)
+	}
+
+	return nil
+}
+
+func createRegisterTransaction(
+	sender ethgo.Address,
+	pubkey *bls.PublicKey,
+	signature *bls.Signature,
+) (*ethgo.Transaction, error) {
 	sigMarshal, err := signature.ToBigInt()
 	if err != nil {
 		return nil, fmt.Errorf("register validator failed: %w", err)
@@ -201,7 +268,7 @@ func registerValidator(
 
 	registerFn := &contractsapi.RegisterHydraChainFn{
 		Signature:         sigMarshal,
-		Pubkey:            account.Bls.PublicKey().ToBigInt(),
+		Pubkey:            pubkey.ToBigInt(),
 		InitialCommission: new(big.Int).SetUint64(params.commission),
 	}
 
@@ -210,14 +277,12 @@ func registerValidator(
 		return nil, fmt.Errorf("register validator failed: %w", err)
 	}
 
-	txn := sidechain.CreateTransaction(
-		account.Ecdsa.Address(),
+	return sidechain.CreateTransaction(
+		sender,
 		(*ethgo.Address)(&hydraChain),
 		encoded,
 		nil,
-	)
-
-	return sender.SendTransaction(txn, account.Ecdsa)
+	), nil
 }
 
 func stake(sender txrelayer.TxRelayer, account *wallet.Account) (*ethgo.Receipt, error) {
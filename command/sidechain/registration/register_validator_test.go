@@ -0,0 +1,34 @@
+package registration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	polybftsigner "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestCheckRegistrationSender(t *testing.T) {
+	t.Parallel()
+
+	blsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	validatorAddr := types.StringToAddress("0x1")
+
+	signature, err := polybftsigner.MakeKOSKSignature(blsKey, validatorAddr, 8844, polybftsigner.DomainHydraChain)
+	require.NoError(t, err)
+
+	require.NoError(t, checkRegistrationSender(ethgo.Address(validatorAddr), blsKey.PublicKey(), signature, 8844))
+
+	// the transaction of another sender is rejected by the contract
+	require.ErrorContains(t,
+		checkRegistrationSender(ethgo.Address(types.StringToAddress("0x2")), blsKey.PublicKey(), signature, 8844),
+		"the BLS signature of the validator is not made for the sender")
+
+	// the signature is made for another chain
+	require.Error(t, checkRegistrationSender(ethgo.Address(validatorAddr), blsKey.PublicKey(), signature, 1))
+}
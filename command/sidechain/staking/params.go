@@ -22,6 +22,7 @@ type stakeParams struct {
 	vestingPeriod      uint64
 	delegateAddress    string
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 }
 
 func (sp *stakeParams) getRequiredFlags() []string {
@@ -43,7 +44,7 @@ func (sp *stakeParams) validateFlags() error {
 		)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(sp.accountDir, sp.accountConfig)
+	return sp.offline.ValidateSecretFlags(sp.accountDir, sp.accountConfig)
 }
 
 type stakeResult struct {
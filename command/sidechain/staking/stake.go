@@ -10,7 +10,6 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
@@ -93,6 +92,7 @@ func setFlags(cmd *cobra.Command) {
 	)
 
 	helper.RegisterJSONRPCFlag(cmd)
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
 
 	cmd.MarkFlagsMutuallyExclusive(sidechain.SelfFlag, delegateAddressFlag)
 	cmd.MarkFlagsMutuallyExclusive(vestingPeriodFlag, delegateAddressFlag)
@@ -109,6 +109,22 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	if params.offline.IsOffline() {
+		txn, err := createStakeTransaction(params.offline.SenderAddress())
+		if err != nil {
+			return err
+		}
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon stake", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
 	validatorAccount, err := sidechain.GetAccount(
 		params.accountDir,
 		params.accountConfig,
@@ -126,7 +142,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	txn, err := createStakeTransaction(validatorAccount)
+	txn, err := createStakeTransaction(validatorAccount.Ecdsa.Address())
 	if err != nil {
 		return err
 	}
@@ -198,7 +214,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-func createStakeTransaction(validatorAccount *wallet.Account) (*ethgo.Transaction, error) {
+func createStakeTransaction(sender ethgo.Address) (*ethgo.Transaction, error) {
 	var (
 		encoded      []byte
 		contractAddr *ethgo.Address
@@ -237,7 +253,7 @@ func createStakeTransaction(validatorAccount *wallet.Account) (*ethgo.Transactio
 	}
 
 	txn := sidechain.CreateTransaction(
-		validatorAccount.Ecdsa.Address(),
+		sender,
 		contractAddr,
 		encoded,
 		parsedValue,
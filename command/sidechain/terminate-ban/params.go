@@ -13,6 +13,7 @@ type terminateBanParams struct {
 	accountConfig      string
 	jsonRPC            string
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 }
 
 func (tbp *terminateBanParams) validateFlags() error {
@@ -20,7 +21,7 @@ func (tbp *terminateBanParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(tbp.accountDir, tbp.accountConfig)
+	return tbp.offline.ValidateSecretFlags(tbp.accountDir, tbp.accountConfig)
 }
 
 type terminateBanResult struct {
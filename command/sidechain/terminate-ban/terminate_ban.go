@@ -58,6 +58,8 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
 }
 
@@ -71,6 +73,29 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	encoded, err := terminateBanFn.Encode([]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	if params.offline.IsOffline() {
+		txn := sidechain.CreateTransaction(
+			params.offline.SenderAddress(),
+			(*ethgo.Address)(&contracts.HydraChainContract),
+			encoded,
+			nil,
+		)
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon terminate-ban", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
 	validatorAccount, err := sidechain.GetAccount(
 		params.accountDir,
 		params.accountConfig,
@@ -86,11 +111,6 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	encoded, err := terminateBanFn.Encode([]interface{}{})
-	if err != nil {
-		return err
-	}
-
 	txn := sidechain.CreateTransaction(
 		validatorAccount.Ecdsa.Address(),
 		(*ethgo.Address)(&contracts.HydraChainContract),
@@ -19,6 +19,7 @@ type unstakeParams struct {
 	jsonRPC            string
 	amount             string
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 
 	amountValue *big.Int
 }
@@ -36,7 +37,7 @@ func (v *unstakeParams) validateFlags() (err error) {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(v.accountDir, v.accountConfig)
+	return v.offline.ValidateSecretFlags(v.accountDir, v.accountConfig)
 }
 
 type unstakeResult struct {
@@ -65,6 +65,8 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
 }
 
@@ -78,20 +80,38 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	validatorAccount, err := sidechain.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	encoded, err := unstakeFn.Encode([]interface{}{
+		params.amountValue,
+	})
 	if err != nil {
 		return err
 	}
 
-	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
-		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if params.offline.IsOffline() {
+		txn := sidechain.CreateTransaction(
+			params.offline.SenderAddress(),
+			(*ethgo.Address)(&contracts.HydraStakingContract),
+			encoded,
+			nil,
+		)
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon unstake", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
+	validatorAccount, err := sidechain.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
 	if err != nil {
 		return err
 	}
 
-	encoded, err := unstakeFn.Encode([]interface{}{
-		params.amountValue,
-	})
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ type whitelistParams struct {
 	jsonRPC             string
 	newValidatorAddress string
 	insecureLocalStore  bool
+	offline             sidechainHelper.OfflineParams
 }
 
 func (ep *whitelistParams) validateFlags() error {
@@ -25,7 +26,7 @@ func (ep *whitelistParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(ep.accountDir, ep.accountConfig)
+	return ep.offline.ValidateSecretFlags(ep.accountDir, ep.accountConfig)
 }
 
 type enlistResult struct {
@@ -65,6 +65,8 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
 	helper.RegisterJSONRPCFlag(cmd)
 }
@@ -79,6 +81,31 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	encoded, err := whitelistFn.Encode([]interface{}{
+		[]types.Address{types.StringToAddress(params.newValidatorAddress)},
+	})
+	if err != nil {
+		return fmt.Errorf("enlist validator failed: %w", err)
+	}
+
+	if params.offline.IsOffline() {
+		txn := sidechain.CreateTransaction(
+			params.offline.SenderAddress(),
+			(*ethgo.Address)(&contracts.HydraChainContract),
+			encoded,
+			nil,
+		)
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon whitelist-validator", txn)
+		if err != nil {
+			return fmt.Errorf("enlist validator failed: %w", err)
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
 	governanceAccount, err := sidechain.GetAccount(
 		params.accountDir,
 		params.accountConfig,
@@ -94,13 +121,6 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("enlist validator failed: %w", err)
 	}
 
-	encoded, err := whitelistFn.Encode([]interface{}{
-		[]types.Address{types.StringToAddress(params.newValidatorAddress)},
-	})
-	if err != nil {
-		return fmt.Errorf("enlist validator failed: %w", err)
-	}
-
 	txn := sidechain.CreateTransaction(
 		governanceAccount.Ecdsa.Address(),
 		(*ethgo.Address)(&contracts.HydraChainContract),
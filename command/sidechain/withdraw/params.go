@@ -18,6 +18,7 @@ type withdrawParams struct {
 	jsonRPC            string
 	penalizedFunds     bool
 	insecureLocalStore bool
+	offline            sidechainHelper.OfflineParams
 }
 
 func (w *withdrawParams) validateFlags() error {
@@ -25,7 +26,7 @@ func (w *withdrawParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(w.accountDir, w.accountConfig)
+	return w.offline.ValidateSecretFlags(w.accountDir, w.accountConfig)
 }
 
 type withdrawResult struct {
@@ -66,6 +66,8 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text",
 	)
 
+	sidechain.RegisterOfflineFlags(cmd, &params.offline)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
 }
 
@@ -79,6 +81,22 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	if params.offline.IsOffline() {
+		txn, err := createWithdrawTransaction(params.offline.SenderAddress())
+		if err != nil {
+			return err
+		}
+
+		result, err := params.offline.WriteUnsignedTransaction(params.jsonRPC, "hydragon withdraw", txn)
+		if err != nil {
+			return err
+		}
+
+		outputter.WriteCommandResult(result)
+
+		return nil
+	}
+
 	validatorAccount, err := sidechain.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
 	if err != nil {
 		return err
@@ -90,30 +108,11 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	var encoded []byte
-
-	if params.penalizedFunds {
-		var initiatePenalizedFundsWithdrawal = &contractsapi.InitiatePenalizedFundsWithdrawalHydraStakingFn{}
-		encoded, err = initiatePenalizedFundsWithdrawal.EncodeAbi()
-	} else {
-		var withdrawFn = &contractsapi.WithdrawHydraStakingFn{
-			To: (types.Address)(validatorAccount.Ecdsa.Address()),
-		}
-
-		encoded, err = withdrawFn.EncodeAbi()
-	}
-
+	txn, err := createWithdrawTransaction(validatorAccount.Ecdsa.Address())
 	if err != nil {
 		return err
 	}
 
-	txn := sidechain.CreateTransaction(
-		validatorAccount.Ecdsa.Address(),
-		(*ethgo.Address)(&contracts.HydraStakingContract),
-		encoded,
-		nil,
-	)
-
 	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
 	if err != nil {
 		return err
@@ -175,3 +174,32 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+func createWithdrawTransaction(sender ethgo.Address) (*ethgo.Transaction, error) {
+	var (
+		encoded []byte
+		err     error
+	)
+
+	if params.penalizedFunds {
+		var initiatePenalizedFundsWithdrawal = &contractsapi.InitiatePenalizedFundsWithdrawalHydraStakingFn{}
+		encoded, err = initiatePenalizedFundsWithdrawal.EncodeAbi()
+	} else {
+		var withdrawFn = &contractsapi.WithdrawHydraStakingFn{
+			To: (types.Address)(sender),
+		}
+
+		encoded, err = withdrawFn.EncodeAbi()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sidechain.CreateTransaction(
+		sender,
+		(*ethgo.Address)(&contracts.HydraStakingContract),
+		encoded,
+		nil,
+	), nil
+}
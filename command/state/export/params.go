@@ -0,0 +1,154 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	dataDirFlag = "data-dir"
+	blockFlag   = "block"
+	outFlag     = "out"
+
+	latestBlock = "latest"
+)
+
+var (
+	params = &exportParams{}
+)
+
+var (
+	errDecodeBlock = errors.New("unable to decode block number value")
+)
+
+type exportParams struct {
+	dataDir  string
+	blockRaw string
+	out      string
+
+	block *uint64
+	dump  *itrie.StateDump
+}
+
+func (p *exportParams) validateFlags() error {
+	if p.blockRaw == latestBlock {
+		return nil
+	}
+
+	block, err := common.ParseUint64orHex(&p.blockRaw)
+	if err != nil {
+		return errDecodeBlock
+	}
+
+	p.block = &block
+
+	return nil
+}
+
+func (p *exportParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+		outFlag,
+	}
+}
+
+func (p *exportParams) exportState() error {
+	header, err := p.getHeader()
+	if err != nil {
+		return err
+	}
+
+	trieDB, err := goleveldb.OpenFile(filepath.Join(p.dataDir, "trie"), &opt.Options{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open the trie database: %w", err)
+	}
+
+	defer trieDB.Close()
+
+	// always create new file, throw error if the file exists
+	fs, err := os.OpenFile(p.out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	dump, err := itrie.ExportState(header.StateRoot, header.Number, itrie.NewKV(trieDB), fs)
+	if err == nil {
+		err = fs.Close()
+	} else {
+		_ = fs.Close()
+	}
+
+	if err != nil {
+		_ = os.Remove(p.out)
+
+		return fmt.Errorf("failed to export the state at block %d: %w", header.Number, err)
+	}
+
+	p.dump = dump
+
+	return nil
+}
+
+// getHeader reads the header of the exported block from the blockchain database
+func (p *exportParams) getHeader() (*types.Header, error) {
+	chainStorage, err := leveldb.NewLevelDBStorageWithOpt(
+		filepath.Join(p.dataDir, "blockchain"),
+		hclog.NewNullLogger(),
+		&opt.Options{ReadOnly: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the blockchain database: %w", err)
+	}
+
+	defer chainStorage.Close()
+
+	hash, err := readBlockHash(chainStorage, p.block)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainStorage.ReadHeader(hash)
+}
+
+// readBlockHash returns the hash of the canonical block with the given number, or the head block
+func readBlockHash(chainStorage storage.Storage, block *uint64) (types.Hash, error) {
+	if block == nil {
+		hash, ok := chainStorage.ReadHeadHash()
+		if !ok {
+			return types.ZeroHash, errors.New("the blockchain database has no head block")
+		}
+
+		return hash, nil
+	}
+
+	hash, ok := chainStorage.ReadCanonicalHash(*block)
+	if !ok {
+		return types.ZeroHash, fmt.Errorf("block %d not found", *block)
+	}
+
+	return hash, nil
+}
+
+func (p *exportParams) getResult() command.CommandResult {
+	return &StateExportResult{
+		File:         p.out,
+		Block:        p.dump.Block,
+		StateRoot:    p.dump.Root.String(),
+		Accounts:     p.dump.Accounts,
+		StorageSlots: p.dump.StorageSlots,
+		Codes:        p.dump.Codes,
+		Checksum:     p.dump.Checksum.String(),
+	}
+}
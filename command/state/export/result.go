@@ -0,0 +1,37 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type StateExportResult struct {
+	File         string `json:"file"`
+	Block        uint64 `json:"block"`
+	StateRoot    string `json:"stateRoot"`
+	Accounts     uint64 `json:"accounts"`
+	StorageSlots uint64 `json:"storageSlots"`
+	Codes        uint64 `json:"codes"`
+	Checksum     string `json:"checksum"`
+}
+
+func (r *StateExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[STATE EXPORT]\n")
+	buffer.WriteString("Exported state dump successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.File),
+		fmt.Sprintf("Block|%d", r.Block),
+		fmt.Sprintf("State Root|%s", r.StateRoot),
+		fmt.Sprintf("Accounts|%d", r.Accounts),
+		fmt.Sprintf("Storage Slots|%d", r.StorageSlots),
+		fmt.Sprintf("Codes|%d", r.Codes),
+		fmt.Sprintf("Checksum|%s", r.Checksum),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,70 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	inFlag      = "in"
+	dataDirFlag = "data-dir"
+)
+
+var (
+	params = &importParams{}
+)
+
+type importParams struct {
+	in      string
+	dataDir string
+
+	dump *itrie.StateDump
+}
+
+func (p *importParams) getRequiredFlags() []string {
+	return []string{
+		inFlag,
+		dataDirFlag,
+	}
+}
+
+func (p *importParams) importState() error {
+	fs, err := os.Open(p.in)
+	if err != nil {
+		return err
+	}
+
+	defer fs.Close()
+
+	trie, err := itrie.NewLevelDBStorage(filepath.Join(p.dataDir, "trie"), hclog.NewNullLogger())
+	if err != nil {
+		return fmt.Errorf("failed to open the trie database: %w", err)
+	}
+
+	defer trie.Close()
+
+	dump, err := itrie.ImportState(fs, trie, nil)
+	if err != nil {
+		return fmt.Errorf("failed to import the state dump: %w", err)
+	}
+
+	p.dump = dump
+
+	return nil
+}
+
+func (p *importParams) getResult() command.CommandResult {
+	return &StateImportResult{
+		DataDir:      p.dataDir,
+		Block:        p.dump.Block,
+		StateRoot:    p.dump.Root.String(),
+		Accounts:     p.dump.Accounts,
+		StorageSlots: p.dump.StorageSlots,
+		Codes:        p.dump.Codes,
+	}
+}
@@ -0,0 +1,35 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type StateImportResult struct {
+	DataDir      string `json:"dataDir"`
+	Block        uint64 `json:"block"`
+	StateRoot    string `json:"stateRoot"`
+	Accounts     uint64 `json:"accounts"`
+	StorageSlots uint64 `json:"storageSlots"`
+	Codes        uint64 `json:"codes"`
+}
+
+func (r *StateImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[STATE IMPORT]\n")
+	buffer.WriteString("Imported state dump successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Data Dir|%s", r.DataDir),
+		fmt.Sprintf("Block|%d", r.Block),
+		fmt.Sprintf("State Root|%s", r.StateRoot),
+		fmt.Sprintf("Accounts|%d", r.Accounts),
+		fmt.Sprintf("Storage Slots|%d", r.StorageSlots),
+		fmt.Sprintf("Codes|%d", r.Codes),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,27 @@
+package state
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/state/export"
+	"github.com/0xPolygon/polygon-edge/command/state/importer"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	stateCmd := &cobra.Command{
+		Use:   "state",
+		Short: "Top level command for exporting and importing the state snapshots. Only accepts subcommands.",
+	}
+
+	registerSubcommands(stateCmd)
+
+	return stateCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// state export
+		export.GetCommand(),
+		// state import
+		importer.GetCommand(),
+	)
+}
@@ -0,0 +1,88 @@
+package broadcast
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/cobra"
+)
+
+var params broadcastParams
+
+func GetCommand() *cobra.Command {
+	broadcastCmd := &cobra.Command{
+		Use:     "broadcast",
+		Short:   "Sends the transaction signed by the tx sign command and waits for its receipt",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(broadcastCmd)
+	helper.SetRequiredFlags(broadcastCmd, params.getRequiredFlags())
+
+	return broadcastCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.in,
+		inFlag,
+		"",
+		"the signed transaction file",
+	)
+
+	helper.RegisterJSONRPCFlag(cmd)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	raw, err := os.ReadFile(params.in)
+	if err != nil {
+		return fmt.Errorf("failed to read the signed transaction: %w", err)
+	}
+
+	data, err := hex.DecodeHex(strings.TrimSpace(string(raw)))
+	if err != nil {
+		return fmt.Errorf("failed to decode the signed transaction: %w", err)
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if err != nil {
+		return err
+	}
+
+	receipt, err := txRelayer.SendRawTransaction(data)
+	if err != nil {
+		return err
+	}
+
+	status := "success"
+	if receipt.Status == uint64(types.ReceiptFailed) {
+		status = "failed"
+	}
+
+	outputter.WriteCommandResult(&broadcastResult{
+		Hash:        receipt.TransactionHash.String(),
+		BlockNumber: receipt.BlockNumber,
+		GasUsed:     receipt.GasUsed,
+		Status:      status,
+	})
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package broadcast
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+const (
+	inFlag = "in"
+)
+
+type broadcastParams struct {
+	jsonRPC string
+	in      string
+}
+
+func (p *broadcastParams) getRequiredFlags() []string {
+	return []string{
+		inFlag,
+	}
+}
+
+func (p *broadcastParams) validateFlags() error {
+	if _, err := helper.ParseJSONRPCAddress(p.jsonRPC); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	return nil
+}
+
+type broadcastResult struct {
+	Hash        string `json:"hash"`
+	BlockNumber uint64 `json:"block_number"`
+	GasUsed     uint64 `json:"gas_used"`
+	Status      string `json:"status"`
+}
+
+func (r *broadcastResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TRANSACTION BROADCAST]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("Block Number|%d", r.BlockNumber),
+		fmt.Sprintf("Gas Used|%d", r.GasUsed),
+		fmt.Sprintf("Status|%s", r.Status),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
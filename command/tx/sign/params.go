@@ -0,0 +1,63 @@
+package sign
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/sidechain"
+)
+
+const (
+	inFlag  = "in"
+	outFlag = "out"
+)
+
+type signParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+	in                 string
+	out                string
+}
+
+func (p *signParams) getRequiredFlags() []string {
+	return []string{
+		inFlag,
+		outFlag,
+	}
+}
+
+func (p *signParams) validateFlags() error {
+	return sidechain.ValidateSecretFlags(p.accountDir, p.accountConfig)
+}
+
+type signResult struct {
+	File        string `json:"file"`
+	Description string `json:"description"`
+	Hash        string `json:"hash"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Nonce       uint64 `json:"nonce"`
+	ChainID     uint64 `json:"chain_id"`
+	Value       string `json:"value"`
+}
+
+func (r *signResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SIGNED TRANSACTION]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.File),
+		fmt.Sprintf("Description|%s", r.Description),
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("From|%s", r.From),
+		fmt.Sprintf("To|%s", r.To),
+		fmt.Sprintf("Nonce|%d", r.Nonce),
+		fmt.Sprintf("Chain ID|%d", r.ChainID),
+		fmt.Sprintf("Value|%s", r.Value),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
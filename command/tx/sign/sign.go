@@ -0,0 +1,140 @@
+package sign
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/command/sidechain"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+var params signParams
+
+func GetCommand() *cobra.Command {
+	signCmd := &cobra.Command{
+		Use: "sign",
+		Short: "Signs the unsigned transaction written by the --unsigned-out flag of the hydragon commands. " +
+			"It doesn't connect to the node, so it can be run on an offline host",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(signCmd)
+	helper.SetRequiredFlags(signCmd, params.getRequiredFlags())
+
+	return signCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		sidechain.InsecureLocalStoreFlag,
+		false,
+		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
+	)
+
+	cmd.Flags().StringVar(
+		&params.in,
+		inFlag,
+		"",
+		"the unsigned transaction file",
+	)
+
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		"",
+		"the file to write the signed transaction to (hex encoded), which is sent by the tx broadcast command",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	unsignedTx, err := sidechain.ReadUnsignedTransaction(params.in)
+	if err != nil {
+		return err
+	}
+
+	account, err := sidechain.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	if err != nil {
+		return err
+	}
+
+	if account.Ecdsa.Address() != unsignedTx.From {
+		return fmt.Errorf("the transaction is built for the account %s, but the secrets belong to the account %s",
+			unsignedTx.From, account.Ecdsa.Address())
+	}
+
+	txn, err := unsignedTx.ToTransaction()
+	if err != nil {
+		return err
+	}
+
+	signer := wallet.NewEIP155Signer(unsignedTx.ChainID)
+	if txn, err = signer.SignTx(txn, account.Ecdsa); err != nil {
+		return fmt.Errorf("failed to sign the transaction: %w", err)
+	}
+
+	data, err := txn.MarshalRLPTo(nil)
+	if err != nil {
+		return err
+	}
+
+	if err := common.SaveFileSafe(params.out, []byte(hex.EncodeToHex(data)), 0600); err != nil {
+		return fmt.Errorf("failed to write the signed transaction: %w", err)
+	}
+
+	hash, err := txn.GetHash()
+	if err != nil {
+		return err
+	}
+
+	outputter.WriteCommandResult(&signResult{
+		File:        params.out,
+		Description: unsignedTx.Description,
+		Hash:        hash.String(),
+		From:        unsignedTx.From.String(),
+		To:          addressOrEmpty(unsignedTx.To),
+		Nonce:       unsignedTx.Nonce,
+		ChainID:     unsignedTx.ChainID,
+		Value:       unsignedTx.Value,
+	})
+
+	return nil
+}
+
+func addressOrEmpty(addr *ethgo.Address) string {
+	if addr == nil {
+		return "-"
+	}
+
+	return addr.String()
+}
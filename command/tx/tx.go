@@ -0,0 +1,28 @@
+package tx
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/tx/broadcast"
+	"github.com/0xPolygon/polygon-edge/command/tx/sign"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use: "tx",
+		Short: "Top level command for signing and broadcasting the transactions built with the --unsigned-out flag. " +
+			"Only accepts subcommands.",
+	}
+
+	registerSubcommands(txCmd)
+
+	return txCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// tx sign
+		sign.GetCommand(),
+		// tx broadcast
+		broadcast.GetCommand(),
+	)
+}
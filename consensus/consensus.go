@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -86,6 +87,17 @@ type Params struct {
 
 	NumBlockConfirmations uint64
 	MetricsInterval       time.Duration
+
+	// StateStorage is the storage of the state trie, served to the syncing peers
+	StateStorage itrie.Storage
+	// StateSync enables downloading the state of a recent block instead of executing all the blocks
+	StateSync bool
+	// RewardHistory enables the indexing of the rewards distributed at the end of each epoch
+	RewardHistory bool
+	// MonitorWebhook is the url which receives the validator monitor alerts
+	MonitorWebhook string
+	// MonitorMissedBlocksThreshold is the number of the consecutive missed blocks which triggers the alert
+	MonitorMissedBlocksThreshold uint64
 }
 
 // Factory is the factory function to create a discovery consensus
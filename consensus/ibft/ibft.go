@@ -149,6 +149,8 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 			params.Network,
 			params.Blockchain,
 			time.Duration(params.BlockTime)*3*time.Second,
+			params.StateStorage,
+			params.StateSync,
 		),
 		secretsManager: params.SecretsManager,
 		Grpc:           params.Grpc,
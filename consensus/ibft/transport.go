@@ -28,7 +28,7 @@ func (i *backendIBFT) Multicast(msg *proto.Message) {
 // setupTransport sets up the gossip transport protocol
 func (i *backendIBFT) setupTransport() error {
 	// Define a new topic
-	topic, err := i.network.NewTopic(ibftProto, &proto.Message{})
+	topic, err := i.network.NewTopic(ibftProto, &proto.Message{}, nil)
 	if err != nil {
 		return err
 	}
@@ -12,7 +12,6 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
@@ -82,6 +81,10 @@ type runtimeConfig struct {
 	txPool                txPoolInterface
 	numBlockConfirmations uint64
 	consensusConfig       *consensus.Config
+	// rewardHistory enables the indexing of the rewards distributed at the end of each epoch
+	rewardHistory bool
+	// validatorMonitor is the configuration of the validator liveness monitor
+	validatorMonitor validatorMonitorConfig
 }
 
 // consensusRuntime is a struct that provides consensus runtime features like epoch, state and event management
@@ -130,6 +133,12 @@ type consensusRuntime struct {
 	// rewardWalletCalculator is the object which handles the calculation of the required HYDRA
 	// that needs to be sent to the reward in order to have enough funds
 	rewardWalletCalculator RewardWalletCalculator
+
+	// rewardHistoryIndexer records the rewards distributed at the end of each epoch
+	rewardHistoryIndexer RewardHistoryIndexer
+
+	// validatorMonitor tracks the liveness of the validators and the conflicting commit seals
+	validatorMonitor *validatorMonitor
 }
 
 // newConsensusRuntime creates and starts a new consensus runtime instance with event tracking
@@ -180,6 +189,10 @@ func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRunti
 		return nil, err
 	}
 
+	runtime.initRewardHistoryIndexer(log)
+
+	runtime.validatorMonitor = newValidatorMonitor(log.Named("validator-monitor"), config.validatorMonitor)
+
 	// we need to call restart epoch on runtime to initialize epoch state
 	runtime.epoch, err = runtime.restartEpoch(runtime.lastBuiltBlock, dbTx)
 	if err != nil {
@@ -197,6 +210,10 @@ func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRunti
 func (c *consensusRuntime) close() {
 	c.stateSyncRelayer.Close()
 	c.stateSyncManager.Close()
+
+	if c.validatorMonitor != nil {
+		c.validatorMonitor.close()
+	}
 }
 
 // initStateSyncManager initializes state sync manager
@@ -250,6 +267,22 @@ func (c *consensusRuntime) initStakeManager(logger hcf.Logger, dbTx *bolt.Tx) er
 	return err
 }
 
+// initRewardHistoryIndexer initializes reward history indexer
+// if the reward history is not enabled, then a dummy indexer will be used
+func (c *consensusRuntime) initRewardHistoryIndexer(logger hcf.Logger) {
+	if !c.config.rewardHistory {
+		c.rewardHistoryIndexer = &dummyRewardHistoryIndexer{}
+
+		return
+	}
+
+	c.rewardHistoryIndexer = newRewardHistoryIndexer(
+		logger.Named("reward-history"),
+		c.state,
+		c.config.blockchain,
+	)
+}
+
 // getGuardedData returns last build block, proposer snapshot and current epochMetadata in a thread-safe manner.
 func (c *consensusRuntime) getGuardedData() (guardedDataDTO, error) {
 	c.lock.RLock()
@@ -347,6 +380,13 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		return
 	}
 
+	// the proposer snapshot is taken before it is updated to the next block
+	proposerSnapshot, _ := c.proposerCalculator.GetSnapshot()
+
+	if err := c.validatorMonitor.PostBlock(fullBlock.Block, epoch.Validators, proposerSnapshot); err != nil {
+		c.logger.Error("failed to update validator monitor", "err", err)
+	}
+
 	// update proposer priorities
 	if err := c.proposerCalculator.PostBlock(postBlock); err != nil {
 		c.logger.Error("Could not update proposer calculator", "err", err)
@@ -366,7 +406,16 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		c.logger.Error("post block callback failed in state sync relayer", "err", err)
 	}
 
-	if isEndOfEpoch {
+	// the reward history is optional, so it doesn't stop the block finalization
+	if err := c.rewardHistoryIndexer.PostBlock(postBlock); err != nil {
+		c.logger.Error("post block callback failed in reward history indexer", "err", err)
+	}
+
+	// the blocks written without state by the state sync are not inserted into the runtime,
+	// so the epoch is restarted from the state of the synced (pivot) block which follows them
+	isAfterStateSync := c.lastBuiltBlock != nil && fullBlock.Block.Number() > c.lastBuiltBlock.Number+1
+
+	if isEndOfEpoch || isAfterStateSync {
 		if epoch, err = c.restartEpoch(fullBlock.Block.Header, dbTx); err != nil {
 			c.logger.Error("failed to restart epoch after block inserted", "error", err)
 
@@ -1057,7 +1106,7 @@ func (c *consensusRuntime) BuildCommitMessage(
 	proposalHash []byte,
 	view *proto.View,
 ) *proto.Message {
-	committedSeal, err := c.config.Key.SignWithDomain(proposalHash, signer.DomainCheckpointManager)
+	committedSeal, err := c.config.Key.SignCommittedSeal(proposalHash, view)
 	if err != nil {
 		c.logger.Error("Cannot create committed seal message.", "error", err)
 
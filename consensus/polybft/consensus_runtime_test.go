@@ -308,12 +308,14 @@ func TestConsensusRuntime_OnBlockInserted_EndOfEpoch(t *testing.T) {
 			Number:            currentEpochNumber,
 			FirstBlockInEpoch: header.Number - epochSize + 1,
 		},
-		lastBuiltBlock:    &types.Header{Number: header.Number - 1},
-		stateSyncManager:  &dummyStateSyncManager{},
-		checkpointManager: &dummyCheckpointManager{},
-		stakeManager:      &dummyStakeManager{},
-		eventProvider:     NewEventProvider(blockchainMock),
-		stateSyncRelayer:  &dummyStateSyncRelayer{},
+		lastBuiltBlock:       &types.Header{Number: header.Number - 1},
+		stateSyncManager:     &dummyStateSyncManager{},
+		checkpointManager:    &dummyCheckpointManager{},
+		stakeManager:         &dummyStakeManager{},
+		eventProvider:        NewEventProvider(blockchainMock),
+		stateSyncRelayer:     &dummyStateSyncRelayer{},
+		rewardHistoryIndexer: &dummyRewardHistoryIndexer{},
+		validatorMonitor:     newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{}),
 	}
 	runtime.OnBlockInserted(&types.FullBlock{Block: builtBlock})
 
@@ -324,6 +326,87 @@ func TestConsensusRuntime_OnBlockInserted_EndOfEpoch(t *testing.T) {
 	systemStateMock.AssertExpectations(t)
 }
 
+func TestConsensusRuntime_OnBlockInserted_AfterStateSync(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize       = uint64(10)
+		validatorsCount = 7
+		// the state synced (pivot) block is in the middle of the epoch
+		pivot = 2*epochSize + 5
+	)
+
+	validatorSet := validator.NewTestValidators(t, validatorsCount).GetPublicIdentities()
+	header, headerMap := createTestBlocks(t, pivot, epochSize, validatorSet)
+	builtBlock := consensus.BuildBlock(consensus.BuildBlockParams{
+		Header: header,
+	})
+
+	syncedEpochNumber := getEpochNumber(t, pivot, epochSize)
+	systemStateMock := new(systemStateMock)
+	systemStateMock.On("GetEpoch").Return(syncedEpochNumber).Once()
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetStateProviderForBlock", mock.Anything).
+		Return(new(stateProviderMock), nil).
+		Once()
+	blockchainMock.On("GetSystemState", mock.Anything, mock.Anything).Return(systemStateMock)
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headerMap.getHeader)
+
+	polybftBackendMock := new(polybftBackendMock)
+	polybftBackendMock.On("GetValidatorsWithTx", mock.Anything, mock.Anything, mock.Anything).
+		Return(validatorSet)
+
+	txPool := new(txPoolMock)
+	txPool.On("ResetWithHeaders", mock.Anything).Once()
+
+	// the runtime was started before the state sync, so its epoch is stale
+	const lastBuiltBlock = uint64(5)
+
+	snapshot := NewProposerSnapshot(lastBuiltBlock+1, validatorSet)
+	config := &runtimeConfig{
+		PolyBFTConfig: &PolyBFTConfig{
+			EpochSize: epochSize,
+		},
+		blockchain:     blockchainMock,
+		polybftBackend: polybftBackendMock,
+		txPool:         txPool,
+		State:          newTestState(t),
+	}
+	require.NoError(t, config.State.insertLastProcessedEventsBlock(builtBlock.Number()-1, nil))
+
+	runtime := &consensusRuntime{
+		proposerCalculator: NewProposerCalculatorFromSnapshot(
+			snapshot,
+			config,
+			hclog.NewNullLogger(),
+		),
+		logger: hclog.NewNullLogger(),
+		state:  config.State,
+		config: config,
+		epoch: &epochMetadata{
+			Number:            1,
+			FirstBlockInEpoch: 1,
+		},
+		lastBuiltBlock:       &types.Header{Number: lastBuiltBlock},
+		stateSyncManager:     &dummyStateSyncManager{},
+		checkpointManager:    &dummyCheckpointManager{},
+		stakeManager:         &dummyStakeManager{},
+		eventProvider:        NewEventProvider(blockchainMock),
+		stateSyncRelayer:     &dummyStateSyncRelayer{},
+		rewardHistoryIndexer: &dummyRewardHistoryIndexer{},
+		validatorMonitor:     newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{}),
+	}
+	runtime.OnBlockInserted(&types.FullBlock{Block: builtBlock})
+
+	// the epoch is restarted from the state of the synced block
+	require.Equal(t, syncedEpochNumber, runtime.epoch.Number)
+	require.Equal(t, 2*epochSize+1, runtime.epoch.FirstBlockInEpoch)
+	require.Equal(t, pivot, runtime.lastBuiltBlock.Number)
+
+	systemStateMock.AssertExpectations(t)
+}
+
 func TestConsensusRuntime_OnBlockInserted_MiddleOfEpoch(t *testing.T) {
 	t.Parallel()
 
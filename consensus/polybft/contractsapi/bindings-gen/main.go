@@ -61,6 +61,8 @@ func main() {
 				"RemovedFromWhitelist",
 				"ValidatorBanned",
 				"PowerExponentUpdated",
+				"VaultFunded",
+				"VaultFundsDistributed",
 			},
 		},
 		{
@@ -329,6 +329,54 @@ func (p *PowerExponentUpdatedEvent) Decode(input []byte) error {
 	return HydraChain.Abi.Events["PowerExponentUpdated"].Inputs.DecodeStruct(input, &p)
 }
 
+type VaultFundedEvent struct {
+	Amount *big.Int `abi:"amount"`
+}
+
+func (*VaultFundedEvent) Sig() ethgo.Hash {
+	return HydraChain.Abi.Events["VaultFunded"].ID()
+}
+
+func (v *VaultFundedEvent) Encode() ([]byte, error) {
+	return HydraChain.Abi.Events["VaultFunded"].Inputs.Encode(v)
+}
+
+func (v *VaultFundedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !HydraChain.Abi.Events["VaultFunded"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(HydraChain.Abi.Events["VaultFunded"], log, v)
+}
+
+func (v *VaultFundedEvent) Decode(input []byte) error {
+	return HydraChain.Abi.Events["VaultFunded"].Inputs.DecodeStruct(input, &v)
+}
+
+type VaultFundsDistributedEvent struct {
+	Amount *big.Int `abi:"amount"`
+}
+
+func (*VaultFundsDistributedEvent) Sig() ethgo.Hash {
+	return HydraChain.Abi.Events["VaultFundsDistributed"].ID()
+}
+
+func (v *VaultFundsDistributedEvent) Encode() ([]byte, error) {
+	return HydraChain.Abi.Events["VaultFundsDistributed"].Inputs.Encode(v)
+}
+
+func (v *VaultFundsDistributedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !HydraChain.Abi.Events["VaultFundsDistributed"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(HydraChain.Abi.Events["VaultFundsDistributed"], log, v)
+}
+
+func (v *VaultFundsDistributedEvent) Decode(input []byte) error {
+	return HydraChain.Abi.Events["VaultFundsDistributed"].Inputs.DecodeStruct(input, &v)
+}
+
 type StakerInit struct {
 	Addr  types.Address `abi:"addr"`
 	Stake *big.Int      `abi:"stake"`
@@ -460,14 +460,13 @@ func ForkManagerFactory(forks *chain.Forks) error {
 func (p *Polybft) Initialize() error {
 	p.logger.Info("initializing polybft...")
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
+	// read account and set key (the keys may be held by the remote signer)
+	key, err := wallet.NewKeyFromSecret(p.config.SecretsManager)
 	if err != nil {
 		return fmt.Errorf("failed to read account data. Error: %w", err)
 	}
 
-	// set key
-	p.key = wallet.NewKey(account)
+	p.key = key
 
 	// create and set syncer
 	p.syncer = syncer.NewSyncer(
@@ -475,6 +474,8 @@ func (p *Polybft) Initialize() error {
 		p.config.Network,
 		p.config.Blockchain,
 		time.Duration(p.config.BlockTime)*3*time.Second, //nolint:gosec
+		p.config.StateStorage,
+		p.config.StateSync,
 	)
 
 	// set blockchain backend
@@ -583,6 +584,11 @@ func (p *Polybft) initRuntime() error {
 		txPool:                p.txPool,
 		numBlockConfirmations: p.config.NumBlockConfirmations,
 		consensusConfig:       p.config.Config,
+		rewardHistory:         p.config.RewardHistory,
+		validatorMonitor: validatorMonitorConfig{
+			webhook:                    p.config.MonitorWebhook,
+			missedBlocksAlertThreshold: p.config.MonitorMissedBlocksThreshold,
+		},
 	}
 
 	runtime, err := newConsensusRuntime(p.logger, runtimeConfig)
@@ -808,6 +814,63 @@ func (p *Polybft) GetValidatorsWithTx(blockNumber uint64, parents []*types.Heade
 	return p.validatorsCache.GetSnapshot(blockNumber, parents, dbTx)
 }
 
+// GetStakingState returns the staking state of the chain at the given block
+func (p *Polybft) GetStakingState(header *types.Header) (*StakingState, error) {
+	provider, err := p.blockchain.GetStateProviderForBlock(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStakingState(provider), nil
+}
+
+// GetEpochValidators returns the validator set of the given epoch, as seen at the given block.
+// The validator set of an epoch is the one which signs its first block
+func (p *Polybft) GetEpochValidators(header *types.Header, epoch uint64) (validator.AccountSet, error) {
+	stakingState, err := p.GetStakingState(header)
+	if err != nil {
+		return nil, err
+	}
+
+	currentEpoch, err := stakingState.GetCurrentEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	if epoch == 0 || epoch > currentEpoch {
+		return nil, fmt.Errorf("epoch %d is not started yet, the current epoch is %d", epoch, currentEpoch)
+	}
+
+	// the first epoch starts with the first block after the genesis
+	startBlock := uint64(1)
+
+	if epoch > 1 {
+		endBlock, err := stakingState.GetEpochEndBlock(epoch - 1)
+		if err != nil {
+			return nil, err
+		}
+
+		startBlock = endBlock + 1
+	}
+
+	return p.GetValidators(startBlock-1, nil)
+}
+
+// GetRewardHistory returns the indexed rewards of the epochs in the given range (inclusive)
+func (p *Polybft) GetRewardHistory(fromEpoch, toEpoch uint64) ([]*EpochRewards, error) {
+	if !p.config.RewardHistory {
+		return nil, errors.New("reward history is not enabled on this node")
+	}
+
+	return p.state.RewardHistoryStore.getEpochRewards(fromEpoch, toEpoch)
+}
+
+// GetValidatorHealth returns the liveness statistics of the validators collected since the node started,
+// the latest conflicting commit seals and the last processed block
+func (p *Polybft) GetValidatorHealth() ([]*ValidatorHealth, []*DoubleSignEvidence, uint64) {
+	return p.runtime.validatorMonitor.GetHealth()
+}
+
 // ProcessHeaders updates the snapshot based on the verified headers
 func (p *Polybft) ProcessHeaders(_ []*types.Header) error {
 	// Not required
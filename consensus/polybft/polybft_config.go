@@ -61,6 +61,9 @@ type PolyBFTConfig struct {
 
 	InitialTrieRoot types.Hash `json:"initialTrieRoot"`
 
+	// InitialStateDump is the state dump the initial trie is imported from
+	InitialStateDump *StateDumpConfig `json:"initialStateDump,omitempty"`
+
 	// SupernetID indicates ID of given supernet generated by stake manager contract
 	SupernetID int64 `json:"supernetID"`
 
@@ -85,6 +88,16 @@ type PolyBFTConfig struct {
 	InitialPrices [310]*big.Int `json:"initialPrices"`
 }
 
+// StateDumpConfig is the state dump embedded into the genesis,
+// which is imported into the trie before the genesis is written
+type StateDumpConfig struct {
+	// Data is the state dump, encoded as base64 in the genesis file
+	Data []byte `json:"data"`
+
+	// Checksum is the checksum stored in the end of the state dump
+	Checksum types.Hash `json:"checksum"`
+}
+
 // LoadPolyBFTConfig loads chain config from provided path and unmarshals PolyBFTConfig
 func LoadPolyBFTConfig(chainConfigFile string) (PolyBFTConfig, error) {
 	chainCfg, err := chain.ImportFromFile(chainConfigFile)
@@ -0,0 +1,265 @@
+package polybft
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// ValidatorUptime is the number of the blocks signed by the validator in the epoch
+type ValidatorUptime struct {
+	Validator    types.Address `json:"validator"`
+	SignedBlocks uint64        `json:"signedBlocks"`
+}
+
+// AccountReward is the reward distributed to the account
+type AccountReward struct {
+	Account types.Address `json:"account"`
+	Amount  *big.Int      `json:"amount"`
+}
+
+// EpochRewards is the record of the uptime and the rewards distributed at the end of an epoch
+type EpochRewards struct {
+	Epoch      uint64 `json:"epoch"`
+	StartBlock uint64 `json:"startBlock"`
+	EndBlock   uint64 `json:"endBlock"`
+	// Uptime is the number of the sealed blocks per validator, sent with the commit epoch transaction
+	Uptime []*ValidatorUptime `json:"uptime"`
+	// StakingRewards are the rewards of the validators (StakingRewardDistributed events)
+	StakingRewards []*AccountReward `json:"stakingRewards"`
+	// DelegatorRewards are the rewards of the delegators of the validators (DelegatorRewardDistributed events)
+	DelegatorRewards []*AccountReward `json:"delegatorRewards"`
+	// RewardWalletFund is the amount sent to the RewardWallet to fund the rewards
+	RewardWalletFund *big.Int `json:"rewardWalletFund"`
+	// VaultFunded is the amount added to the DAO incentive vault in the epoch
+	VaultFunded *big.Int `json:"vaultFunded"`
+	// DAOIncentive is the amount distributed from the DAO incentive vault
+	DAOIncentive *big.Int `json:"daoIncentive"`
+	// FeeHandlerBalance is the balance of the FeeHandler contract at the end of the epoch
+	FeeHandlerBalance *big.Int `json:"feeHandlerBalance"`
+	// FeeHandlerIncome is the change of the FeeHandler balance during the epoch,
+	// it is negative if the fees were relocated
+	FeeHandlerIncome *big.Int `json:"feeHandlerIncome"`
+}
+
+// RewardHistoryIndexer records the rewards distributed at the end of each epoch
+type RewardHistoryIndexer interface {
+	PostBlock(req *PostBlockRequest) error
+}
+
+var _ RewardHistoryIndexer = (*dummyRewardHistoryIndexer)(nil)
+
+// dummyRewardHistoryIndexer is used when the reward history is not enabled
+type dummyRewardHistoryIndexer struct{}
+
+func (d *dummyRewardHistoryIndexer) PostBlock(req *PostBlockRequest) error { return nil }
+
+var _ RewardHistoryIndexer = (*rewardHistoryIndexer)(nil)
+
+// rewardHistoryIndexer decodes the state transactions and the logs of the epoch ending blocks
+// and saves the rewards in the RewardHistoryStore
+type rewardHistoryIndexer struct {
+	logger     hclog.Logger
+	state      *State
+	blockchain BlockchainBackend
+}
+
+// newRewardHistoryIndexer returns new instance of rewardHistoryIndexer
+func newRewardHistoryIndexer(
+	logger hclog.Logger,
+	state *State,
+	blockchain BlockchainBackend,
+) *rewardHistoryIndexer {
+	return &rewardHistoryIndexer{
+		logger:     logger,
+		state:      state,
+		blockchain: blockchain,
+	}
+}
+
+// PostBlock saves the rewards of the epoch if the block is the epoch ending block
+func (r *rewardHistoryIndexer) PostBlock(req *PostBlockRequest) error {
+	if !req.IsEpochEndingBlock {
+		return nil
+	}
+
+	rewards, err := r.getEpochRewards(req.FullBlock)
+	if err != nil {
+		return err
+	}
+
+	if rewards == nil {
+		r.logger.Debug("no commit epoch transaction in the epoch ending block",
+			"block", req.FullBlock.Block.Number())
+
+		return nil
+	}
+
+	if err := r.state.RewardHistoryStore.insertEpochRewards(rewards, req.DBTx); err != nil {
+		return fmt.Errorf("failed to save the rewards of the epoch %d: %w", rewards.Epoch, err)
+	}
+
+	r.logger.Debug("epoch rewards indexed", "epoch", rewards.Epoch, "block", req.FullBlock.Block.Number())
+
+	return nil
+}
+
+// getEpochRewards decodes the rewards from the epoch ending block, nil if the block doesn't commit an epoch
+func (r *rewardHistoryIndexer) getEpochRewards(fullBlock *types.FullBlock) (*EpochRewards, error) {
+	var (
+		commitEpochFn = new(contractsapi.CommitEpochHydraChainFn)
+		rewards       *EpochRewards
+	)
+
+	rewardWalletFund := big.NewInt(0)
+
+	for _, tx := range fullBlock.Block.Transactions {
+		if tx.Type != types.StateTx || tx.To == nil {
+			continue
+		}
+
+		switch {
+		case *tx.To == contracts.HydraChainContract && bytes.HasPrefix(tx.Input, commitEpochFn.Sig()):
+			if err := commitEpochFn.DecodeAbi(tx.Input); err != nil {
+				return nil, fmt.Errorf("failed to decode commit epoch transaction: %w", err)
+			}
+
+			rewards = &EpochRewards{
+				Epoch:            commitEpochFn.ID.Uint64(),
+				StartBlock:       commitEpochFn.Epoch.StartBlock.Uint64(),
+				EndBlock:         commitEpochFn.Epoch.EndBlock.Uint64(),
+				Uptime:           make([]*ValidatorUptime, len(commitEpochFn.Uptime)),
+				StakingRewards:   []*AccountReward{},
+				DelegatorRewards: []*AccountReward{},
+				VaultFunded:      big.NewInt(0),
+				DAOIncentive:     big.NewInt(0),
+			}
+
+			for i, uptime := range commitEpochFn.Uptime {
+				rewards.Uptime[i] = &ValidatorUptime{
+					Validator:    uptime.Validator,
+					SignedBlocks: uptime.SignedBlocks.Uint64(),
+				}
+			}
+		case *tx.To == contracts.RewardWalletContract && tx.Value != nil:
+			rewardWalletFund.Add(rewardWalletFund, tx.Value)
+		}
+	}
+
+	if rewards == nil {
+		return nil, nil
+	}
+
+	rewards.RewardWalletFund = rewardWalletFund
+
+	if err := rewards.addLogs(fullBlock.Receipts); err != nil {
+		return nil, err
+	}
+
+	if err := r.addFeeHandlerBalance(rewards, fullBlock.Block.Header); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// addFeeHandlerBalance sets the balance of the FeeHandler contract at the end of the epoch
+// and its change since the end of the previous epoch
+func (r *rewardHistoryIndexer) addFeeHandlerBalance(rewards *EpochRewards, header *types.Header) error {
+	balance, err := r.blockchain.GetAccountBalance(header, contracts.FeeHandlerContract)
+	if err != nil {
+		return fmt.Errorf("failed to get the FeeHandler balance at block %d: %w", header.Number, err)
+	}
+
+	rewards.FeeHandlerBalance = balance
+	rewards.FeeHandlerIncome = new(big.Int).Set(balance)
+
+	if rewards.StartBlock == 0 {
+		return nil
+	}
+
+	prevHeader, found := r.blockchain.GetHeaderByNumber(rewards.StartBlock - 1)
+	if !found {
+		return fmt.Errorf("failed to get the header of block %d", rewards.StartBlock-1)
+	}
+
+	prevBalance, err := r.blockchain.GetAccountBalance(prevHeader, contracts.FeeHandlerContract)
+	if err != nil {
+		return fmt.Errorf("failed to get the FeeHandler balance at block %d: %w", prevHeader.Number, err)
+	}
+
+	rewards.FeeHandlerIncome.Sub(rewards.FeeHandlerIncome, prevBalance)
+
+	return nil
+}
+
+// addLogs adds the rewards emitted by the reward distribution transactions
+func (e *EpochRewards) addLogs(receipts []*types.Receipt) error {
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			// the events are decoded in new instances, because the rewards keep the decoded amounts
+			var (
+				stakingRewardEvent   contractsapi.StakingRewardDistributedEvent
+				delegatorRewardEvent contractsapi.DelegatorRewardDistributedEvent
+				vaultFundedEvent     contractsapi.VaultFundedEvent
+				vaultDistributeEvent contractsapi.VaultFundsDistributedEvent
+			)
+
+			ethLog := convertLog(log)
+
+			switch log.Address {
+			case contracts.HydraStakingContract:
+				doesMatch, err := stakingRewardEvent.ParseLog(ethLog)
+				if err != nil {
+					return err
+				}
+
+				if doesMatch {
+					e.StakingRewards = append(e.StakingRewards, &AccountReward{
+						Account: stakingRewardEvent.Account,
+						Amount:  stakingRewardEvent.Amount,
+					})
+				}
+			case contracts.HydraDelegationContract:
+				doesMatch, err := delegatorRewardEvent.ParseLog(ethLog)
+				if err != nil {
+					return err
+				}
+
+				if doesMatch {
+					e.DelegatorRewards = append(e.DelegatorRewards, &AccountReward{
+						Account: delegatorRewardEvent.Staker,
+						Amount:  delegatorRewardEvent.Amount,
+					})
+				}
+			case contracts.HydraChainContract:
+				doesMatch, err := vaultFundedEvent.ParseLog(ethLog)
+				if err != nil {
+					return err
+				}
+
+				if doesMatch {
+					e.VaultFunded.Add(e.VaultFunded, vaultFundedEvent.Amount)
+
+					continue
+				}
+
+				doesMatch, err = vaultDistributeEvent.ParseLog(ethLog)
+				if err != nil {
+					return err
+				}
+
+				if doesMatch {
+					e.DAOIncentive.Add(e.DAOIncentive, vaultDistributeEvent.Amount)
+				}
+			}
+		}
+	}
+
+	return nil
+}
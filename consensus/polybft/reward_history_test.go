@@ -0,0 +1,180 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardHistoryIndexer_PostBlock(t *testing.T) {
+	t.Parallel()
+
+	var (
+		validator1 = types.StringToAddress("1")
+		validator2 = types.StringToAddress("2")
+	)
+
+	rewardLog := func(contract types.Address, sig types.Hash, account *types.Address, amount int64) *types.Log {
+		topics := []types.Hash{sig}
+		if account != nil {
+			topics = append(topics, types.BytesToHash(account.Bytes()))
+		}
+
+		return &types.Log{
+			Address: contract,
+			Topics:  topics,
+			Data:    common.PadLeftOrTrim(big.NewInt(amount).Bytes(), types.HashLength),
+		}
+	}
+
+	commitEpochInput, err := (&contractsapi.CommitEpochHydraChainFn{
+		ID: big.NewInt(3),
+		Epoch: &contractsapi.Epoch{
+			StartBlock: big.NewInt(21),
+			EndBlock:   big.NewInt(30),
+		},
+		EpochSize: big.NewInt(10),
+		Uptime: []*contractsapi.Uptime{
+			{Validator: validator2, SignedBlocks: big.NewInt(10)},
+			{Validator: validator1, SignedBlocks: big.NewInt(8)},
+		},
+	}).EncodeAbi()
+	require.NoError(t, err)
+
+	header := &types.Header{Number: 30}
+	prevHeader := &types.Header{Number: 20}
+
+	fullBlock := &types.FullBlock{
+		Block: &types.Block{
+			Header: header,
+			Transactions: []*types.Transaction{
+				createStateTransactionWithData(30, contracts.HydraChainContract, commitEpochInput, nil),
+				createStateTransactionWithData(30, contracts.RewardWalletContract, nil, big.NewInt(1000)),
+			},
+		},
+		Receipts: []*types.Receipt{
+			{},
+			{
+				Logs: []*types.Log{
+					rewardLog(contracts.HydraStakingContract,
+						types.Hash(new(contractsapi.StakingRewardDistributedEvent).Sig()), &validator1, 50),
+					rewardLog(contracts.HydraStakingContract,
+						types.Hash(new(contractsapi.StakingRewardDistributedEvent).Sig()), &validator2, 70),
+					rewardLog(contracts.HydraDelegationContract,
+						types.Hash(new(contractsapi.DelegatorRewardDistributedEvent).Sig()), &validator1, 20),
+				},
+			},
+			{
+				Logs: []*types.Log{
+					rewardLog(contracts.HydraChainContract,
+						types.Hash(new(contractsapi.VaultFundedEvent).Sig()), nil, 15),
+					rewardLog(contracts.HydraChainContract,
+						types.Hash(new(contractsapi.VaultFundsDistributedEvent).Sig()), nil, 40),
+				},
+			},
+		},
+	}
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetHeaderByNumber", uint64(20)).Return(prevHeader, true)
+	blockchainMock.On("GetAccountBalance", header, contracts.FeeHandlerContract).Return(big.NewInt(500), nil)
+	blockchainMock.On("GetAccountBalance", prevHeader, contracts.FeeHandlerContract).Return(big.NewInt(200), nil)
+
+	state := newTestState(t)
+	indexer := newRewardHistoryIndexer(hclog.NewNullLogger(), state, blockchainMock)
+
+	// the blocks which don't end the epoch are skipped
+	require.NoError(t, indexer.PostBlock(&PostBlockRequest{FullBlock: fullBlock}))
+
+	rewards, err := state.RewardHistoryStore.getEpochRewards(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, rewards)
+
+	require.NoError(t, indexer.PostBlock(&PostBlockRequest{FullBlock: fullBlock, IsEpochEndingBlock: true}))
+
+	rewards, err = state.RewardHistoryStore.getEpochRewards(3, 3)
+	require.NoError(t, err)
+	require.Len(t, rewards, 1)
+
+	epochRewards := rewards[0]
+	require.Equal(t, uint64(3), epochRewards.Epoch)
+	require.Equal(t, uint64(21), epochRewards.StartBlock)
+	require.Equal(t, uint64(30), epochRewards.EndBlock)
+	require.Equal(t, []*ValidatorUptime{
+		{Validator: validator2, SignedBlocks: 10},
+		{Validator: validator1, SignedBlocks: 8},
+	}, epochRewards.Uptime)
+	require.Equal(t, []*AccountReward{
+		{Account: validator1, Amount: big.NewInt(50)},
+		{Account: validator2, Amount: big.NewInt(70)},
+	}, epochRewards.StakingRewards)
+	require.Equal(t, []*AccountReward{
+		{Account: validator1, Amount: big.NewInt(20)},
+	}, epochRewards.DelegatorRewards)
+	require.Equal(t, big.NewInt(1000), epochRewards.RewardWalletFund)
+	require.Equal(t, big.NewInt(15), epochRewards.VaultFunded)
+	require.Equal(t, big.NewInt(40), epochRewards.DAOIncentive)
+	require.Equal(t, big.NewInt(500), epochRewards.FeeHandlerBalance)
+	require.Equal(t, big.NewInt(300), epochRewards.FeeHandlerIncome)
+
+	blockchainMock.AssertExpectations(t)
+}
+
+func TestRewardHistoryIndexer_PostBlock_NoCommitEpoch(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	indexer := newRewardHistoryIndexer(hclog.NewNullLogger(), state, new(blockchainMock))
+
+	require.NoError(t, indexer.PostBlock(&PostBlockRequest{
+		FullBlock:          &types.FullBlock{Block: &types.Block{Header: &types.Header{Number: 10}}},
+		IsEpochEndingBlock: true,
+	}))
+
+	rewards, err := state.RewardHistoryStore.getEpochRewards(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, rewards)
+}
+
+func TestRewardHistoryStore_GetEpochRewards(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	for _, epoch := range []uint64{1, 2, 4, 5} {
+		require.NoError(t, state.RewardHistoryStore.insertEpochRewards(&EpochRewards{
+			Epoch:            epoch,
+			RewardWalletFund: big.NewInt(int64(epoch)),
+		}, nil))
+	}
+
+	cases := []struct {
+		from, to uint64
+		epochs   []uint64
+	}{
+		{0, 10, []uint64{1, 2, 4, 5}},
+		{2, 4, []uint64{2, 4}},
+		{3, 3, nil},
+		{5, 5, []uint64{5}},
+		{6, 10, nil},
+	}
+
+	for _, c := range cases {
+		rewards, err := state.RewardHistoryStore.getEpochRewards(c.from, c.to)
+		require.NoError(t, err)
+
+		var epochs []uint64
+		for _, r := range rewards {
+			epochs = append(epochs, r.Epoch)
+			require.Equal(t, big.NewInt(int64(r.Epoch)), r.RewardWalletFund)
+		}
+
+		require.Equal(t, c.epochs, epochs)
+	}
+}
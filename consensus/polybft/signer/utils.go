@@ -37,6 +37,27 @@ var (
 // MakeKOSKSignature creates KOSK signature which prevents rogue attack
 func MakeKOSKSignature(
 	privateKey *bls.PrivateKey, address types.Address, chainID int64, domain []byte) (*bls.Signature, error) {
+	message, err := koskMessage(address, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.Sign(message, domain)
+}
+
+// VerifyKOSKSignature checks if the KOSK signature is made by the BLS key for the given address and chain
+func VerifyKOSKSignature(
+	signature *bls.Signature, publicKey *bls.PublicKey, address types.Address, chainID int64, domain []byte) bool {
+	message, err := koskMessage(address, chainID)
+	if err != nil {
+		return false
+	}
+
+	return signature.Verify(publicKey, message, domain)
+}
+
+// koskMessage returns the message signed by the KOSK signature
+func koskMessage(address types.Address, chainID int64) ([]byte, error) {
 	message, err := abi.Encode(
 		[]interface{}{address, big.NewInt(chainID)},
 		abi.MustNewType("tuple(address, uint256)"))
@@ -45,5 +66,5 @@ func MakeKOSKSignature(
 	}
 
 	// abi.Encode adds 12 zero bytes before actual address bytes
-	return privateKey.Sign(message[12:], domain)
+	return message[12:], nil
 }
@@ -37,3 +37,19 @@ func Test_MakeKOSKSignature(t *testing.T) {
 
 	assert.NotEqual(t, expected, hex.EncodeToString(signatureBytes))
 }
+
+func Test_VerifyKOSKSignature(t *testing.T) {
+	t.Parallel()
+
+	pk, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	address := types.StringToAddress("0x1")
+
+	signature, err := MakeKOSKSignature(pk, address, 10, DomainHydraChain)
+	require.NoError(t, err)
+
+	require.True(t, VerifyKOSKSignature(signature, pk.PublicKey(), address, 10, DomainHydraChain))
+	require.False(t, VerifyKOSKSignature(signature, pk.PublicKey(), types.StringToAddress("0x2"), 10, DomainHydraChain))
+	require.False(t, VerifyKOSKSignature(signature, pk.PublicKey(), address, 100, DomainHydraChain))
+}
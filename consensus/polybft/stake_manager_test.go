@@ -831,6 +831,12 @@ func (d *dummyStakeTxRelayer) SendTransactionLocal(txn *ethgo.Transaction) (*eth
 	return args.Get(0).(*ethgo.Receipt), args.Error(1) //nolint:forcetypeassert
 }
 
+func (d *dummyStakeTxRelayer) SendRawTransaction(data []byte) (*ethgo.Receipt, error) {
+	args := d.Called(data)
+
+	return args.Get(0).(*ethgo.Receipt), args.Error(1) //nolint:forcetypeassert
+}
+
 func (d *dummyStakeTxRelayer) Client() *jsonrpc.Client {
 	return nil
 }
@@ -0,0 +1,332 @@
+package polybft
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+)
+
+// StakingValidator is the staking state of a validator, provided by the HydraChain,
+// HydraStaking and HydraDelegation smart contracts
+type StakingValidator struct {
+	Address             types.Address
+	BlsKey              *bls.PublicKey
+	Stake               *big.Int
+	DelegatedStake      *big.Int
+	TotalStake          *big.Int
+	Commission          *big.Int
+	WithdrawableRewards *big.Int
+	VotingPower         *big.Int
+	Status              uint8
+	IsActive            bool
+	IsBanned            bool
+	IsBanInitiated      bool
+}
+
+// Delegation is the delegation of a delegator to a validator, provided by the HydraDelegation smart contract
+type Delegation struct {
+	Validator types.Address
+	Amount    *big.Int
+	Reward    *big.Int
+	// IsVesting is true if the delegation is a vesting position, which is not matured yet
+	IsVesting  bool
+	VestingEnd uint64
+}
+
+// PendingRewards are the rewards of an account which are not claimed yet
+type PendingRewards struct {
+	Staking     *big.Int
+	Commission  *big.Int
+	Delegations []*Delegation
+}
+
+// APR is the annual percentage rate of the staking rewards, provided by the APRCalculator smart contract.
+// The values are the numerators of the fractions with the common denominator
+type APR struct {
+	Base        *big.Int
+	Max         *big.Int
+	RSIBonus    *big.Int
+	MacroFactor *big.Int
+	Denominator *big.Int
+}
+
+// StakingState queries the staking and delegation data from the system contracts in the chain
+type StakingState struct {
+	hydraChainContract      *contract.Contract
+	hydraStakingContract    *contract.Contract
+	hydraDelegationContract *contract.Contract
+	aprCalculatorContract   *contract.Contract
+}
+
+// NewStakingState initializes new instance of StakingState which queries the system contracts of the given provider
+func NewStakingState(provider contract.Provider) *StakingState {
+	return &StakingState{
+		hydraChainContract: contract.NewContract(
+			ethgo.Address(contracts.HydraChainContract),
+			contractsapi.HydraChain.Abi, contract.WithProvider(provider),
+		),
+		hydraStakingContract: contract.NewContract(
+			ethgo.Address(contracts.HydraStakingContract),
+			contractsapi.HydraStaking.Abi, contract.WithProvider(provider),
+		),
+		hydraDelegationContract: contract.NewContract(
+			ethgo.Address(contracts.HydraDelegationContract),
+			contractsapi.HydraDelegation.Abi, contract.WithProvider(provider),
+		),
+		aprCalculatorContract: contract.NewContract(
+			ethgo.Address(contracts.APRCalculatorContract),
+			contractsapi.APRCalculator.Abi, contract.WithProvider(provider),
+		),
+	}
+}
+
+// GetCurrentEpoch retrieves current epoch number from the HydraChain smart contract
+func (s *StakingState) GetCurrentEpoch() (uint64, error) {
+	epoch, err := callBigInt(s.hydraChainContract, "currentEpochId")
+	if err != nil {
+		return 0, err
+	}
+
+	return epoch.Uint64(), nil
+}
+
+// GetEpochEndBlock retrieves the last block of the committed epoch from the HydraChain smart contract
+func (s *StakingState) GetEpochEndBlock(epoch uint64) (uint64, error) {
+	endBlock, err := callBigInt(s.hydraChainContract, "epochEndBlocks", new(big.Int).SetUint64(epoch))
+	if err != nil {
+		return 0, err
+	}
+
+	return endBlock.Uint64(), nil
+}
+
+// GetValidator retrieves the staking state of the validator, nil if the validator is not registered
+func (s *StakingState) GetValidator(addr types.Address) (*StakingValidator, error) {
+	isRegistered, err := callBool(s.hydraChainContract, "isValidatorRegistered", addr)
+	if err != nil || !isRegistered {
+		return nil, err
+	}
+
+	rawOutput, err := s.hydraChainContract.Call("getValidator", ethgo.Latest, addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getValidator function: %w", err)
+	}
+
+	res := &StakingValidator{Address: addr}
+
+	rawKey, ok := rawOutput["blsKey"].([4]*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode blskey")
+	}
+
+	if res.BlsKey, err = bls.UnmarshalPublicKeyFromBigInt(rawKey); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal BLS public key: %w", err)
+	}
+
+	for name, dst := range map[string]**big.Int{
+		"stake":               &res.Stake,
+		"totalStake":          &res.TotalStake,
+		"commission":          &res.Commission,
+		"withdrawableRewards": &res.WithdrawableRewards,
+		"votingPower":         &res.VotingPower,
+	} {
+		if *dst, ok = rawOutput[name].(*big.Int); !ok {
+			return nil, fmt.Errorf("failed to decode %s", name)
+		}
+	}
+
+	if res.Status, ok = rawOutput["status"].(uint8); !ok {
+		return nil, fmt.Errorf("failed to decode status")
+	}
+
+	if res.IsBanInitiated, ok = rawOutput["isBanInitiated"].(bool); !ok {
+		return nil, fmt.Errorf("failed to decode isBanInitiated")
+	}
+
+	if res.DelegatedStake, err = callBigInt(s.hydraDelegationContract, "totalDelegationOf", addr); err != nil {
+		return nil, err
+	}
+
+	if res.IsActive, err = callBool(s.hydraChainContract, "isValidatorActive", addr); err != nil {
+		return nil, err
+	}
+
+	if res.IsBanned, err = callBool(s.hydraChainContract, "isValidatorBanned", addr); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetDelegations retrieves the delegations of the delegator to the registered validators
+func (s *StakingState) GetDelegations(delegator types.Address) ([]*Delegation, error) {
+	validators, err := s.getValidatorAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	delegations := []*Delegation{}
+
+	for _, validator := range validators {
+		amount, err := callBigInt(s.hydraDelegationContract, "delegationOf", validator, delegator)
+		if err != nil {
+			return nil, err
+		}
+
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		delegation := &Delegation{
+			Validator: validator,
+			Amount:    amount,
+		}
+
+		if delegation.Reward, err = callBigInt(s.hydraDelegationContract,
+			"getDelegatorReward", validator, delegator); err != nil {
+			return nil, err
+		}
+
+		if delegation.IsVesting, err = callBool(s.hydraDelegationContract,
+			"isActiveDelegatePosition", validator, delegator); err != nil {
+			return nil, err
+		}
+
+		position, err := s.hydraDelegationContract.Call("vestedDelegationPositions", ethgo.Latest, validator, delegator)
+		if err != nil {
+			return nil, err
+		}
+
+		end, ok := position["end"].(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("failed to decode vested delegation position")
+		}
+
+		delegation.VestingEnd = end.Uint64()
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations, nil
+}
+
+// GetPendingRewards retrieves the staking rewards, the commissions and the delegation rewards
+// of the account, which are not claimed yet
+func (s *StakingState) GetPendingRewards(addr types.Address) (*PendingRewards, error) {
+	staking, err := callBigInt(s.hydraStakingContract, "unclaimedRewards", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	commission, err := callBigInt(s.hydraDelegationContract, "distributedCommissions", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := s.GetDelegations(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	rewarded := make([]*Delegation, 0, len(delegations))
+
+	for _, delegation := range delegations {
+		if delegation.Reward.Sign() > 0 {
+			rewarded = append(rewarded, delegation)
+		}
+	}
+
+	return &PendingRewards{
+		Staking:     staking,
+		Commission:  commission,
+		Delegations: rewarded,
+	}, nil
+}
+
+// GetAPR retrieves the current APR of the staking rewards from the APRCalculator smart contract
+func (s *StakingState) GetAPR() (*APR, error) {
+	res := &APR{}
+
+	for method, dst := range map[string]**big.Int{
+		"getBaseAPR":     &res.Base,
+		"getRSIBonus":    &res.RSIBonus,
+		"getMacroFactor": &res.MacroFactor,
+		"DENOMINATOR":    &res.Denominator,
+	} {
+		value, err := callBigInt(s.aprCalculatorContract, method)
+		if err != nil {
+			return nil, err
+		}
+
+		*dst = value
+	}
+
+	rawOutput, err := s.aprCalculatorContract.Call("getMaxAPR", ethgo.Latest)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAPR, ok := rawOutput["nominator"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode max APR")
+	}
+
+	res.Max = maxAPR
+
+	return res, nil
+}
+
+// getValidatorAddresses retrieves the addresses of the registered validators from the HydraChain smart contract
+func (s *StakingState) getValidatorAddresses() ([]types.Address, error) {
+	rawOutput, err := s.hydraChainContract.Call("getValidators", ethgo.Latest)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, ok := rawOutput["0"].([]ethgo.Address)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode validators")
+	}
+
+	res := make([]types.Address, len(addrs))
+	for i, addr := range addrs {
+		res[i] = types.Address(addr)
+	}
+
+	return res, nil
+}
+
+// callBigInt calls the view function of the contract, which returns a single integer
+func callBigInt(c *contract.Contract, method string, args ...interface{}) (*big.Int, error) {
+	rawOutput, err := c.Call(method, ethgo.Latest, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s function: %w", method, err)
+	}
+
+	value, ok := rawOutput["0"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode the result of %s function", method)
+	}
+
+	return value, nil
+}
+
+// callBool calls the view function of the contract, which returns a single boolean
+func callBool(c *contract.Contract, method string, args ...interface{}) (bool, error) {
+	rawOutput, err := c.Call(method, ethgo.Latest, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to call %s function: %w", method, err)
+	}
+
+	value, ok := rawOutput["0"].(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to decode the result of %s function", method)
+	}
+
+	return value, nil
+}
@@ -42,6 +42,7 @@ type State struct {
 	EpochStore            *EpochStore
 	ProposerSnapshotStore *ProposerSnapshotStore
 	StakeStore            *StakeStore
+	RewardHistoryStore    *RewardHistoryStore
 }
 
 // newState creates new instance of State
@@ -58,6 +59,7 @@ func newState(path string, _ hclog.Logger, closeCh chan struct{}) (*State, error
 		EpochStore:            &EpochStore{db: db},
 		ProposerSnapshotStore: &ProposerSnapshotStore{db: db},
 		StakeStore:            &StakeStore{db: db},
+		RewardHistoryStore:    &RewardHistoryStore{db: db},
 	}
 
 	if err = s.initStorages(); err != nil {
@@ -87,6 +89,10 @@ func (s *State) initStorages() error {
 			return err
 		}
 
+		if err := s.RewardHistoryStore.initialize(tx); err != nil {
+			return err
+		}
+
 		_, err := tx.CreateBucketIfNotExists(edgeEventsLastProcessedBlockBucket)
 		if err != nil {
 			return fmt.Errorf("failed to create bucket=%s: %w", string(edgeEventsLastProcessedBlockBucket), err)
@@ -0,0 +1,83 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// bucket to store the rewards distributed at the end of each epoch
+	rewardHistoryBucket = []byte("rewardHistory")
+)
+
+/*
+Bolt DB schema:
+
+rewardHistory/
+|--> epochNumber -> *EpochRewards (json marshalled)
+*/
+
+type RewardHistoryStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *RewardHistoryStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(rewardHistoryBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(rewardHistoryBucket), err)
+	}
+
+	return nil
+}
+
+// insertEpochRewards inserts the rewards of the epoch to its bucket (or updates them if they exist)
+// If the passed tx is already open (not nil), it will use it to insert the rewards
+// If the passed tx is not open (it is nil), it will open a new transaction on db and insert the rewards
+func (s *RewardHistoryStore) insertEpochRewards(rewards *EpochRewards, dbTx *bolt.Tx) error {
+	insertFn := func(tx *bolt.Tx) error {
+		raw, err := json.Marshal(rewards)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(rewardHistoryBucket).Put(common.EncodeUint64ToBytes(rewards.Epoch), raw)
+	}
+
+	if dbTx == nil {
+		return s.db.Update(func(tx *bolt.Tx) error {
+			return insertFn(tx)
+		})
+	}
+
+	return insertFn(dbTx)
+}
+
+// getEpochRewards returns the rewards of the epochs in the given range (inclusive).
+// The epochs which are not indexed are skipped
+func (s *RewardHistoryStore) getEpochRewards(fromEpoch, toEpoch uint64) ([]*EpochRewards, error) {
+	var rewards []*EpochRewards
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(rewardHistoryBucket).Cursor()
+
+		for k, v := c.Seek(common.EncodeUint64ToBytes(fromEpoch)); k != nil; k, v = c.Next() {
+			if common.EncodeBytesToUint64(k) > toEpoch {
+				break
+			}
+
+			var epochRewards *EpochRewards
+			if err := json.Unmarshal(v, &epochRewards); err != nil {
+				return err
+			}
+
+			rewards = append(rewards, epochRewards)
+		}
+
+		return nil
+	})
+
+	return rewards, err
+}
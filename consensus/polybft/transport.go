@@ -1,12 +1,16 @@
 package polybft
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
 	polybftProto "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/types"
 	ibftProto "github.com/Hydra-Chain/go-ibft/messages/proto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 // BridgeTransport is an abstraction of network layer for a bridge
@@ -17,10 +21,6 @@ type BridgeTransport interface {
 // subscribeToIbftTopic subscribes to ibft topic
 func (p *Polybft) subscribeToIbftTopic() error {
 	return p.consensusTopic.Subscribe(func(obj interface{}, _ peer.ID) {
-		if !p.runtime.IsActiveValidator() {
-			return
-		}
-
 		msg, ok := obj.(*ibftProto.Message)
 		if !ok {
 			p.logger.Error("consensus engine: invalid type assertion for message request")
@@ -28,6 +28,14 @@ func (p *Polybft) subscribeToIbftTopic() error {
 			return
 		}
 
+		// all the nodes check the commit messages for the conflicting commit seals,
+		// the signatures are recovered only when the commit messages conflict
+		p.runtime.validatorMonitor.AddMessage(msg)
+
+		if !p.runtime.IsActiveValidator() {
+			return
+		}
+
 		p.ibft.AddMessage(msg)
 
 		p.logger.Debug(
@@ -40,16 +48,45 @@ func (p *Polybft) subscribeToIbftTopic() error {
 	})
 }
 
+// validateIbftMessage checks the consensus message before it is relayed to the other peers.
+// The message must have a view and be signed by its sender. The validator set is checked by the consensus engine
+func validateIbftMessage(obj protobuf.Message, _ peer.ID) error {
+	msg, ok := obj.(*ibftProto.Message)
+	if !ok {
+		return errors.New("invalid type assertion for message request")
+	}
+
+	if msg.View == nil {
+		return errors.New("consensus message has no view")
+	}
+
+	msgNoSig, err := msg.PayloadNoSig()
+	if err != nil {
+		return err
+	}
+
+	signerAddress, err := wallet.RecoverAddressFromSignature(msg.Signature, msgNoSig)
+	if err != nil {
+		return fmt.Errorf("failed to recover address from signature: %w", err)
+	}
+
+	if !bytes.Equal(msg.From, signerAddress.Bytes()) {
+		return fmt.Errorf("signer address %s doesn't match From field", signerAddress.String())
+	}
+
+	return nil
+}
+
 // createTopics create all topics for a PolyBft instance
 func (p *Polybft) createTopics() (err error) {
 	if p.consensusConfig.IsBridgeEnabled() {
-		p.bridgeTopic, err = p.config.Network.NewTopic(bridgeProto, &polybftProto.TransportMessage{})
+		p.bridgeTopic, err = p.config.Network.NewTopic(bridgeProto, &polybftProto.TransportMessage{}, nil)
 		if err != nil {
 			return fmt.Errorf("failed to create bridge topic: %w", err)
 		}
 	}
 
-	p.consensusTopic, err = p.config.Network.NewTopic(pbftProto, &ibftProto.Message{})
+	p.consensusTopic, err = p.config.Network.NewTopic(pbftProto, &ibftProto.Message{}, validateIbftMessage)
 	if err != nil {
 		return fmt.Errorf("failed to create consensus topic: %w", err)
 	}
@@ -0,0 +1,417 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// commitHistoryHeights is the number of the latest heights whose commit messages are kept
+	// to detect the conflicting commit seals
+	commitHistoryHeights = 16
+	// maxDoubleSignEvidences is the maximum number of the latest double sign evidences kept in memory
+	maxDoubleSignEvidences = 100
+	// alertQueueSize is the size of the queue of the alerts which are sent to the webhook
+	alertQueueSize = 100
+	// alertTimeout is the timeout of the webhook request
+	alertTimeout = 5 * time.Second
+)
+
+// alert types sent to the webhook
+const (
+	alertMissedBlocks   = "missed_blocks"
+	alertMissedProposal = "missed_proposal"
+	alertDoubleSign     = "double_sign"
+)
+
+// ValidatorHealth is the liveness statistics of a validator, collected since the node started
+type ValidatorHealth struct {
+	Address types.Address `json:"address"`
+	// SignedBlocks is the number of the blocks committed with the seal of the validator
+	SignedBlocks uint64 `json:"signedBlocks"`
+	// MissedBlocks is the number of the blocks committed without the seal of the validator
+	MissedBlocks uint64 `json:"missedBlocks"`
+	// ConsecutiveMissedBlocks is the number of the last blocks committed without the seal of the validator
+	ConsecutiveMissedBlocks uint64 `json:"consecutiveMissedBlocks"`
+	// LastSignedBlock is the last block committed with the seal of the validator
+	LastSignedBlock uint64 `json:"lastSignedBlock"`
+	// ProposedBlocks is the number of the committed blocks proposed by the validator
+	ProposedBlocks uint64 `json:"proposedBlocks"`
+	// MissedProposals is the number of the rounds in which the validator was the proposer,
+	// but the block was committed in a later round
+	MissedProposals uint64 `json:"missedProposals"`
+	// DoubleSigns is the number of the conflicting commit seals of the validator
+	DoubleSigns uint64 `json:"doubleSigns"`
+}
+
+// DoubleSignEvidence are the two different proposals committed by the validator for the same height and round
+type DoubleSignEvidence struct {
+	Validator      types.Address `json:"validator"`
+	Height         uint64        `json:"height"`
+	Round          uint64        `json:"round"`
+	ProposalHashes []types.Hash  `json:"proposalHashes"`
+}
+
+// validatorAlert is the payload sent to the webhook
+type validatorAlert struct {
+	Type      string        `json:"type"`
+	Validator types.Address `json:"validator"`
+	Height    uint64        `json:"height"`
+	Round     uint64        `json:"round,omitempty"`
+	Message   string        `json:"message"`
+}
+
+// commitKey identifies the commit message of a validator
+type commitKey struct {
+	validator types.Address
+	height    uint64
+	round     uint64
+}
+
+// commitRecord is the first commit message of a validator received for the height and round
+type commitRecord struct {
+	proposalHash types.Hash
+	msg          *proto.Message
+}
+
+// validatorMonitorConfig is the configuration of the validator monitor
+type validatorMonitorConfig struct {
+	// webhook is the url which receives the alerts (the alerts are not sent if it is empty)
+	webhook string
+	// missedBlocksAlertThreshold is the number of the consecutive missed blocks which triggers the alert
+	// (the alert is not sent if it is zero)
+	missedBlocksAlertThreshold uint64
+}
+
+// validatorMonitor tracks the liveness of the validators from the committed blocks
+// and detects the conflicting commit seals from the consensus messages
+type validatorMonitor struct {
+	logger hclog.Logger
+	config validatorMonitorConfig
+
+	lock        sync.RWMutex
+	lastBlock   uint64
+	validators  map[types.Address]*ValidatorHealth
+	commits     map[commitKey]*commitRecord
+	doubleSigns []*DoubleSignEvidence
+
+	alertCh chan *validatorAlert
+	closeCh chan struct{}
+}
+
+// newValidatorMonitor returns new instance of validatorMonitor,
+// which sends the alerts to the webhook if it is configured
+func newValidatorMonitor(logger hclog.Logger, config validatorMonitorConfig) *validatorMonitor {
+	m := &validatorMonitor{
+		logger:     logger,
+		config:     config,
+		validators: map[types.Address]*ValidatorHealth{},
+		commits:    map[commitKey]*commitRecord{},
+		closeCh:    make(chan struct{}),
+	}
+
+	if config.webhook != "" {
+		m.alertCh = make(chan *validatorAlert, alertQueueSize)
+
+		go m.runAlertLoop()
+	}
+
+	return m
+}
+
+// close stops sending the alerts
+func (m *validatorMonitor) close() {
+	close(m.closeCh)
+}
+
+// PostBlock updates the statistics of the validators from the committed block.
+// The validators are the validator set of the block and the proposer snapshot is the one prepared for the block
+func (m *validatorMonitor) PostBlock(
+	block *types.Block,
+	validators validator.AccountSet,
+	proposerSnapshot *ProposerSnapshot,
+) error {
+	extra, err := GetIbftExtra(block.Header.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	if extra.Committed == nil {
+		return nil
+	}
+
+	signers, err := validators.GetFilteredValidators(extra.Committed.Bitmap)
+	if err != nil {
+		return err
+	}
+
+	signed := signers.GetAddressesAsSet()
+	height := block.Number()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.lastBlock = height
+
+	for _, v := range validators {
+		health := m.getOrCreate(v.Address)
+
+		if _, ok := signed[v.Address]; ok {
+			health.SignedBlocks++
+			health.ConsecutiveMissedBlocks = 0
+			health.LastSignedBlock = height
+		} else {
+			health.MissedBlocks++
+			health.ConsecutiveMissedBlocks++
+
+			if m.config.missedBlocksAlertThreshold != 0 &&
+				health.ConsecutiveMissedBlocks == m.config.missedBlocksAlertThreshold {
+				m.alert(&validatorAlert{
+					Type:      alertMissedBlocks,
+					Validator: v.Address,
+					Height:    height,
+					Message:   fmt.Sprintf("the validator missed the last %d blocks", health.ConsecutiveMissedBlocks),
+				})
+			}
+		}
+	}
+
+	m.getOrCreate(types.BytesToAddress(block.Header.Miner)).ProposedBlocks++
+
+	// the proposers of the previous rounds failed to get their proposals committed
+	if proposerSnapshot != nil && proposerSnapshot.Height == height && extra.Checkpoint.BlockRound > 0 {
+		proposers := make([]types.Address, 0, extra.Checkpoint.BlockRound)
+		names := make([]string, 0, extra.Checkpoint.BlockRound)
+
+		for round := uint64(0); round < extra.Checkpoint.BlockRound; round++ {
+			proposer, err := proposerSnapshot.CalcProposer(round, height)
+			if err != nil {
+				return err
+			}
+
+			m.getOrCreate(proposer).MissedProposals++
+
+			proposers = append(proposers, proposer)
+			names = append(names, proposer.String())
+		}
+
+		// a single alert is sent for the height, on behalf of the proposer of its first round
+		m.alert(&validatorAlert{
+			Type:      alertMissedProposal,
+			Validator: proposers[0],
+			Height:    height,
+			Round:     extra.Checkpoint.BlockRound,
+			Message: fmt.Sprintf("the block was committed in round %d, the proposals of %s were not committed",
+				extra.Checkpoint.BlockRound, strings.Join(names, ", ")),
+		})
+	}
+
+	// the commit messages of the old heights are not needed anymore
+	for key := range m.commits {
+		if key.height+commitHistoryHeights <= height {
+			delete(m.commits, key)
+		}
+	}
+
+	m.updateMetrics()
+
+	return nil
+}
+
+// AddMessage checks the commit message for the conflicting commit seals.
+// The messages which are not sent by a known validator, and the messages of the heights out of
+// the commit history around the last block, are ignored. The signatures are checked
+// only when the commit messages conflict, so the messages are not verified once more on every node
+func (m *validatorMonitor) AddMessage(msg *proto.Message) {
+	if msg.GetType() != proto.MessageType_COMMIT || msg.GetView() == nil || msg.GetCommitData() == nil {
+		return
+	}
+
+	signer := types.BytesToAddress(msg.From)
+	key := commitKey{
+		validator: signer,
+		height:    msg.GetView().Height,
+		round:     msg.GetView().Round,
+	}
+	proposalHash := types.BytesToHash(msg.GetCommitData().ProposalHash)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	health, ok := m.validators[signer]
+	if !ok || key.height+commitHistoryHeights <= m.lastBlock || key.height > m.lastBlock+commitHistoryHeights {
+		return
+	}
+
+	prev, ok := m.commits[key]
+	if !ok {
+		m.commits[key] = &commitRecord{proposalHash: proposalHash, msg: msg}
+
+		return
+	}
+
+	if prev.proposalHash == proposalHash || !isSignedBySender(msg) {
+		return
+	}
+
+	if !isSignedBySender(prev.msg) {
+		// the forged message is replaced by the signed one
+		m.commits[key] = &commitRecord{proposalHash: proposalHash, msg: msg}
+
+		return
+	}
+
+	prevHash := prev.proposalHash
+
+	health.DoubleSigns++
+
+	m.doubleSigns = append(m.doubleSigns, &DoubleSignEvidence{
+		Validator:      signer,
+		Height:         key.height,
+		Round:          key.round,
+		ProposalHashes: []types.Hash{prevHash, proposalHash},
+	})
+
+	if len(m.doubleSigns) > maxDoubleSignEvidences {
+		m.doubleSigns = m.doubleSigns[len(m.doubleSigns)-maxDoubleSignEvidences:]
+	}
+
+	m.logger.Warn("conflicting commit seals received", "validator", signer,
+		"height", key.height, "round", key.round, "hash1", prevHash, "hash2", proposalHash)
+
+	m.alert(&validatorAlert{
+		Type:      alertDoubleSign,
+		Validator: signer,
+		Height:    key.height,
+		Round:     key.round,
+		Message:   fmt.Sprintf("the validator committed the proposals %s and %s", prevHash, proposalHash),
+	})
+
+	metrics.SetGaugeWithLabels([]string{consensusMetricsPrefix, "validator_double_signs"},
+		float32(health.DoubleSigns), []metrics.Label{{Name: "address", Value: signer.String()}})
+}
+
+// isSignedBySender checks if the consensus message is signed by its sender
+func isSignedBySender(msg *proto.Message) bool {
+	msgNoSig, err := msg.PayloadNoSig()
+	if err != nil {
+		return false
+	}
+
+	signer, err := wallet.RecoverAddressFromSignature(msg.Signature, msgNoSig)
+
+	return err == nil && bytes.Equal(msg.From, signer.Bytes())
+}
+
+// GetHealth returns the statistics of the validators sorted by address,
+// the latest double sign evidences and the last processed block
+func (m *validatorMonitor) GetHealth() ([]*ValidatorHealth, []*DoubleSignEvidence, uint64) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	validators := make([]*ValidatorHealth, 0, len(m.validators))
+
+	for _, health := range m.validators {
+		healthCopy := *health
+		validators = append(validators, &healthCopy)
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		return bytes.Compare(validators[i].Address[:], validators[j].Address[:]) < 0
+	})
+
+	doubleSigns := make([]*DoubleSignEvidence, len(m.doubleSigns))
+	copy(doubleSigns, m.doubleSigns)
+
+	return validators, doubleSigns, m.lastBlock
+}
+
+// getOrCreate returns the statistics of the validator (the lock must be held)
+func (m *validatorMonitor) getOrCreate(addr types.Address) *ValidatorHealth {
+	health, ok := m.validators[addr]
+	if !ok {
+		health = &ValidatorHealth{Address: addr}
+		m.validators[addr] = health
+	}
+
+	return health
+}
+
+// updateMetrics updates the validator gauges (the lock must be held)
+func (m *validatorMonitor) updateMetrics() {
+	for addr, health := range m.validators {
+		labels := []metrics.Label{{Name: "address", Value: addr.String()}}
+
+		metrics.SetGaugeWithLabels([]string{consensusMetricsPrefix, "validator_missed_blocks"},
+			float32(health.MissedBlocks), labels)
+		metrics.SetGaugeWithLabels([]string{consensusMetricsPrefix, "validator_consecutive_missed_blocks"},
+			float32(health.ConsecutiveMissedBlocks), labels)
+		metrics.SetGaugeWithLabels([]string{consensusMetricsPrefix, "validator_missed_proposals"},
+			float32(health.MissedProposals), labels)
+		metrics.SetGaugeWithLabels([]string{consensusMetricsPrefix, "validator_double_signs"},
+			float32(health.DoubleSigns), labels)
+	}
+}
+
+// alert queues the alert for the webhook, the alert is dropped if the queue is full
+func (m *validatorMonitor) alert(alert *validatorAlert) {
+	if m.alertCh == nil {
+		return
+	}
+
+	select {
+	case m.alertCh <- alert:
+	default:
+		m.logger.Warn("validator alert queue is full, alert dropped", "type", alert.Type, "validator", alert.Validator)
+	}
+}
+
+// runAlertLoop sends the queued alerts to the webhook
+func (m *validatorMonitor) runAlertLoop() {
+	client := &http.Client{Timeout: alertTimeout}
+
+	for {
+		select {
+		case <-m.closeCh:
+			return
+		case alert := <-m.alertCh:
+			if err := m.sendAlert(client, alert); err != nil {
+				m.logger.Error("failed to send validator alert", "type", alert.Type, "err", err)
+			}
+		}
+	}
+}
+
+// sendAlert posts the alert to the webhook
+func (m *validatorMonitor) sendAlert(client *http.Client, alert *validatorAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Post(m.config.webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package polybft
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorMonitor_PostBlock(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 4)
+	accounts := validators.GetPublicIdentities()
+
+	monitor := newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{})
+	defer monitor.close()
+
+	createBlock := func(number, round uint64, miner types.Address, signers ...int) *types.Block {
+		var b bitmap.Bitmap
+		for _, i := range signers {
+			b.Set(uint64(i))
+		}
+
+		extra := &Extra{
+			Committed:  &Signature{Bitmap: b},
+			Checkpoint: &CheckpointData{BlockRound: round},
+		}
+
+		return &types.Block{Header: &types.Header{
+			Number:    number,
+			Miner:     miner.Bytes(),
+			ExtraData: extra.MarshalRLPTo(nil),
+		}}
+	}
+
+	// the last validator doesn't sign the blocks
+	for number := uint64(1); number <= 3; number++ {
+		require.NoError(t, monitor.PostBlock(createBlock(number, 0, accounts[0].Address, 0, 1, 2), accounts, nil))
+	}
+
+	// the block is committed in the second round, so the proposer of the first round missed its proposal
+	snapshot := NewProposerSnapshot(4, accounts)
+	firstProposer, err := snapshot.Copy().CalcProposer(0, 4)
+	require.NoError(t, err)
+
+	require.NoError(t, monitor.PostBlock(createBlock(4, 1, accounts[1].Address, 0, 1, 2, 3), accounts, snapshot))
+
+	health, doubleSigns, blockNumber := monitor.GetHealth()
+	require.Equal(t, uint64(4), blockNumber)
+	require.Empty(t, doubleSigns)
+	require.Len(t, health, 4)
+
+	healthByAddr := make(map[types.Address]*ValidatorHealth, len(health))
+	for _, h := range health {
+		healthByAddr[h.Address] = h
+	}
+
+	first := healthByAddr[accounts[0].Address]
+	require.Equal(t, uint64(4), first.SignedBlocks)
+	require.Equal(t, uint64(0), first.MissedBlocks)
+	require.Equal(t, uint64(4), first.LastSignedBlock)
+	require.Equal(t, uint64(3), first.ProposedBlocks)
+
+	last := healthByAddr[accounts[3].Address]
+	require.Equal(t, uint64(1), last.SignedBlocks)
+	require.Equal(t, uint64(3), last.MissedBlocks)
+	require.Equal(t, uint64(0), last.ConsecutiveMissedBlocks)
+	require.Equal(t, uint64(4), last.LastSignedBlock)
+
+	require.Equal(t, uint64(1), healthByAddr[firstProposer].MissedProposals)
+
+	// a single alert is sent for the height, regardless of the number of the missed proposals
+	monitor.alertCh = make(chan *validatorAlert, alertQueueSize)
+
+	snapshot = NewProposerSnapshot(5, accounts)
+	firstProposer, err = snapshot.Copy().CalcProposer(0, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, monitor.PostBlock(createBlock(5, 2, accounts[1].Address, 0, 1, 2, 3), accounts, snapshot))
+	require.Len(t, monitor.alertCh, 1)
+
+	alert := <-monitor.alertCh
+	require.Equal(t, alertMissedProposal, alert.Type)
+	require.Equal(t, firstProposer, alert.Validator)
+	require.Equal(t, uint64(5), alert.Height)
+	require.Equal(t, uint64(2), alert.Round)
+}
+
+func TestValidatorMonitor_AddMessage_DoubleSign(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 2)
+	accounts := validators.GetPublicIdentities()
+	signer := validators.GetValidator("0")
+
+	monitor := newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{})
+	defer monitor.close()
+
+	extra := &Extra{Committed: &Signature{}, Checkpoint: &CheckpointData{}}
+	require.NoError(t, monitor.PostBlock(&types.Block{Header: &types.Header{
+		Number:    1,
+		ExtraData: extra.MarshalRLPTo(nil),
+	}}, accounts, nil))
+
+	commitMsg := func(from *validator.TestValidator, proposalHash types.Hash) *proto.Message {
+		msg, err := from.Key().SignIBFTMessage(&proto.Message{
+			View: &proto.View{Height: 2, Round: 0},
+			From: from.Address().Bytes(),
+			Type: proto.MessageType_COMMIT,
+			Payload: &proto.Message_CommitData{CommitData: &proto.CommitMessage{
+				ProposalHash: proposalHash.Bytes(),
+			}},
+		})
+		require.NoError(t, err)
+
+		return msg
+	}
+
+	forgedMsg := func(proposalHash types.Hash) *proto.Message {
+		forged := commitMsg(validators.GetValidator("1"), proposalHash)
+		forged.From = signer.Address().Bytes()
+
+		return forged
+	}
+
+	// the message with the forged sender received first is replaced by the signed one
+	monitor.AddMessage(forgedMsg(types.StringToHash("3")))
+	monitor.AddMessage(commitMsg(signer, types.StringToHash("1")))
+	monitor.AddMessage(commitMsg(signer, types.StringToHash("1")))
+
+	_, doubleSigns, _ := monitor.GetHealth()
+	require.Empty(t, doubleSigns)
+
+	// the message with the forged sender is ignored
+	monitor.AddMessage(forgedMsg(types.StringToHash("2")))
+
+	_, doubleSigns, _ = monitor.GetHealth()
+	require.Empty(t, doubleSigns)
+
+	monitor.AddMessage(commitMsg(signer, types.StringToHash("2")))
+
+	health, doubleSigns, _ := monitor.GetHealth()
+	require.Len(t, doubleSigns, 1)
+	require.Equal(t, &DoubleSignEvidence{
+		Validator:      signer.Address(),
+		Height:         2,
+		Round:          0,
+		ProposalHashes: []types.Hash{types.StringToHash("1"), types.StringToHash("2")},
+	}, doubleSigns[0])
+
+	for _, h := range health {
+		if h.Address == signer.Address() {
+			require.Equal(t, uint64(1), h.DoubleSigns)
+		}
+	}
+}
+
+func TestValidatorMonitor_AddMessage_HeightOutOfHistory(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidators(t, 1)
+	signer := validators.GetValidator("0")
+
+	monitor := newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{})
+	defer monitor.close()
+
+	extra := &Extra{Committed: &Signature{}, Checkpoint: &CheckpointData{}}
+	require.NoError(t, monitor.PostBlock(&types.Block{Header: &types.Header{
+		Number:    commitHistoryHeights,
+		ExtraData: extra.MarshalRLPTo(nil),
+	}}, validators.GetPublicIdentities(), nil))
+
+	commitMsg := func(height uint64) *proto.Message {
+		return &proto.Message{
+			View: &proto.View{Height: height, Round: 0},
+			From: signer.Address().Bytes(),
+			Type: proto.MessageType_COMMIT,
+			Payload: &proto.Message_CommitData{CommitData: &proto.CommitMessage{
+				ProposalHash: types.StringToHash("1").Bytes(),
+			}},
+		}
+	}
+
+	// the heights below and above the commit history are ignored
+	monitor.AddMessage(commitMsg(0))
+	monitor.AddMessage(commitMsg(2*commitHistoryHeights + 1))
+	require.Empty(t, monitor.commits)
+
+	monitor.AddMessage(commitMsg(1))
+	monitor.AddMessage(commitMsg(2 * commitHistoryHeights))
+	require.Len(t, monitor.commits, 2)
+}
+
+func TestValidatorMonitor_Webhook(t *testing.T) {
+	t.Parallel()
+
+	alertsCh := make(chan *validatorAlert, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert *validatorAlert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err == nil {
+			alertsCh <- alert
+		}
+	}))
+	defer server.Close()
+
+	validators := validator.NewTestValidators(t, 2)
+	accounts := validators.GetPublicIdentities()
+
+	monitor := newValidatorMonitor(hclog.NewNullLogger(), validatorMonitorConfig{
+		webhook:                    server.URL,
+		missedBlocksAlertThreshold: 2,
+	})
+	defer monitor.close()
+
+	// only the first validator signs the blocks
+	var b bitmap.Bitmap
+	b.Set(0)
+
+	extra := &Extra{Committed: &Signature{Bitmap: b}, Checkpoint: &CheckpointData{}}
+
+	for number := uint64(1); number <= 2; number++ {
+		require.NoError(t, monitor.PostBlock(&types.Block{Header: &types.Header{
+			Number:    number,
+			ExtraData: extra.MarshalRLPTo(nil),
+		}}, accounts, nil))
+	}
+
+	select {
+	case alert := <-alertsCh:
+		require.Equal(t, alertMissedBlocks, alert.Type)
+		require.Equal(t, accounts[1].Address, alert.Validator)
+		require.Equal(t, uint64(2), alert.Height)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the alert was not sent")
+	}
+}
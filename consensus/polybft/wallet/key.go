@@ -1,19 +1,28 @@
 package wallet
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
 	"github.com/umbracle/ethgo"
+	ethgowallet "github.com/umbracle/ethgo/wallet"
 	protobuf "google.golang.org/protobuf/proto"
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+// errRemoteDigestSigning is returned when a digest is signed with the ECDSA key held by the remote signer
+var errRemoteDigestSigning = errors.New("the remote signer signs only whole transactions, not digests")
+
 type Key struct {
 	raw *Account
+	// remote signs with the keys held by the remote signer, raw is nil if it is set
+	remote *remotesigner.Signer
 }
 
 func NewKey(raw *Account) *Key {
@@ -22,13 +31,39 @@ func NewKey(raw *Account) *Key {
 	}
 }
 
+// NewRemoteKey creates the key which signs with the validator keys held by the remote signer
+func NewRemoteKey(remote *remotesigner.Signer) *Key {
+	return &Key{
+		remote: remote,
+	}
+}
+
+// NewKeyFromSecret creates the key of the validator account stored in the secrets manager,
+// or the key which signs with the remote signer if the secrets manager is the remote signer
+func NewKeyFromSecret(secretsManager secrets.SecretsManager) (*Key, error) {
+	if remoteSigner, ok := secretsManager.(*remotesigner.RemoteSignerSecretsManager); ok {
+		return NewRemoteKey(remoteSigner.Signer()), nil
+	}
+
+	account, err := NewAccountFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKey(account), nil
+}
+
 // String returns hex encoded ECDSA address
 func (k *Key) String() string {
-	return k.raw.Ecdsa.Address().String()
+	return k.Address().String()
 }
 
 // Address returns ECDSA address
 func (k *Key) Address() ethgo.Address {
+	if k.remote != nil {
+		return k.remote.Address()
+	}
+
 	return k.raw.Ecdsa.Address()
 }
 
@@ -40,6 +75,10 @@ func (k *Key) Sign(digest []byte) ([]byte, error) {
 
 // SignWithDomain signs the provided digest with BLS key and provided domain
 func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
+	if k.remote != nil {
+		return k.remote.SignWithDomain(digest, domain)
+	}
+
 	signature, err := k.raw.Bls.Sign(digest, domain)
 	if err != nil {
 		return nil, err
@@ -48,6 +87,16 @@ func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
 	return signature.Marshal()
 }
 
+// SignCommittedSeal signs the proposal hash with BLS key and the checkpoint manager domain.
+// The view is checked by the slashing protection of the remote signer
+func (k *Key) SignCommittedSeal(proposalHash []byte, view *proto.View) ([]byte, error) {
+	if k.remote != nil {
+		return k.remote.SignCommittedSeal(proposalHash, signer.DomainCheckpointManager, view.Height, view.Round)
+	}
+
+	return k.SignWithDomain(proposalHash, signer.DomainCheckpointManager)
+}
+
 // SignIBFTMessage signs the IBFT consensus message with ECDSA key
 func (k *Key) SignIBFTMessage(msg *proto.Message) (*proto.Message, error) {
 	msgRaw, err := protobuf.Marshal(msg)
@@ -55,7 +104,13 @@ func (k *Key) SignIBFTMessage(msg *proto.Message) (*proto.Message, error) {
 		return nil, fmt.Errorf("cannot marshal message: %w", err)
 	}
 
-	if msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw)); err != nil {
+	if k.remote != nil {
+		msg.Signature, err = k.remote.SignIBFTMessage(msgRaw)
+	} else {
+		msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw))
+	}
+
+	if err != nil {
 		return nil, fmt.Errorf("cannot create message signature: %w", err)
 	}
 
@@ -82,6 +137,22 @@ func NewEcdsaSigner(ecdsaKey *Key) *ECDSASigner {
 	return &ECDSASigner{Key: ecdsaKey}
 }
 
+// Sign signs the provided digest with the local ECDSA key.
+// The remote signer signs only whole transactions (see SignTx)
 func (k *ECDSASigner) Sign(b []byte) ([]byte, error) {
+	if k.remote != nil {
+		return nil, errRemoteDigestSigning
+	}
+
 	return k.raw.Ecdsa.Sign(b)
 }
+
+// SignTx signs the transaction with the EIP-155 signer of the given chain.
+// The remote signer receives the whole unsigned transaction and computes its signing hash itself
+func (k *ECDSASigner) SignTx(tx *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error) {
+	if k.remote != nil {
+		return k.remote.SignTransaction(tx, chainID)
+	}
+
+	return ethgowallet.NewEIP155Signer(chainID).SignTx(tx, k)
+}
@@ -1,13 +1,22 @@
 package wallet
 
 import (
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	ethgowallet "github.com/umbracle/ethgo/wallet"
 
 	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
 )
 
 func Test_RecoverAddressFromSignature(t *testing.T) {
@@ -59,3 +68,82 @@ func Test_String(t *testing.T) {
 		require.Equal(t, key.Address().String(), key.String())
 	}
 }
+
+func Test_RemoteKey(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+
+	// the stand-in remote signer holds the account keys
+	signerSecrets, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+	})
+	require.NoError(t, err)
+	require.NoError(t, account.Save(signerSecrets))
+
+	server, err := remotesigner.NewServer(hclog.NewNullLogger(), signerSecrets,
+		filepath.Join(t.TempDir(), "slashing.json"), "")
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	secretsManager, err := remotesigner.SecretsManagerFactory(
+		&secrets.SecretsManagerConfig{Type: secrets.RemoteSigner, ServerURL: httpServer.URL},
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+		},
+	)
+	require.NoError(t, err)
+
+	key, err := NewKeyFromSecret(secretsManager)
+	require.NoError(t, err)
+	require.Nil(t, key.raw)
+	require.Equal(t, account.Ecdsa.Address(), key.Address())
+
+	// the IBFT message is signed with the ECDSA key
+	msg, err := key.SignIBFTMessage(&proto.Message{
+		View:    &proto.View{Height: 1, Round: 0},
+		From:    key.Address().Bytes(),
+		Type:    proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{CommitData: &proto.CommitMessage{ProposalHash: make([]byte, 32)}},
+	})
+	require.NoError(t, err)
+
+	payload, err := msg.PayloadNoSig()
+	require.NoError(t, err)
+
+	address, err := RecoverAddressFromSignature(msg.Signature, payload)
+	require.NoError(t, err)
+	require.Equal(t, key.Address().Bytes(), address.Bytes())
+
+	// the committed seal is signed with the BLS key and the checkpoint manager domain
+	proposalHash := crypto.Keccak256([]byte("proposal"))
+
+	seal, err := key.SignCommittedSeal(proposalHash, &proto.View{Height: 1, Round: 0})
+	require.NoError(t, err)
+
+	sig, err := bls.UnmarshalSignature(seal)
+	require.NoError(t, err)
+	require.True(t, sig.Verify(account.Bls.PublicKey(), proposalHash, signer.DomainCheckpointManager))
+
+	// the other proposal can't be sealed in the same view
+	_, err = key.SignCommittedSeal(crypto.Keccak256([]byte("other")), &proto.View{Height: 1, Round: 0})
+	require.ErrorIs(t, err, remotesigner.ErrSlashingProtection)
+
+	// the transaction is signed by the remote signer, but the bare digests are not
+	ecdsaSigner := NewEcdsaSigner(key)
+
+	to := ethgo.ZeroAddress
+	tx, err := ecdsaSigner.SignTx(&ethgo.Transaction{From: key.Address(), To: &to, Gas: 21000}, 100)
+	require.NoError(t, err)
+
+	sender, err := ethgowallet.NewEIP155Signer(100).RecoverSender(tx)
+	require.NoError(t, err)
+	require.Equal(t, key.Address(), sender)
+
+	_, err = ecdsaSigner.Sign(proposalHash)
+	require.ErrorIs(t, err, errRemoteDigestSigning)
+}
@@ -0,0 +1,79 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// BerlinSigner implements signer for EIP-2930
+type BerlinSigner struct {
+	chainID        uint64
+	isHomestead    bool
+	fallbackSigner TxSigner
+}
+
+// NewBerlinSigner returns a new BerlinSigner object
+func NewBerlinSigner(chainID uint64, isHomestead bool, fallbackSigner TxSigner) *BerlinSigner {
+	return &BerlinSigner{
+		chainID:        chainID,
+		isHomestead:    isHomestead,
+		fallbackSigner: fallbackSigner,
+	}
+}
+
+// Hash is a wrapper function that calls calcTxHash with the BerlinSigner's fields
+func (e *BerlinSigner) Hash(tx *types.Transaction) types.Hash {
+	return calcTxHash(tx, e.chainID)
+}
+
+// Sender returns the transaction sender
+func (e *BerlinSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	// Apply fallback signer for non-access-list-txs
+	if tx.Type != types.AccessListTx {
+		return e.fallbackSigner.Sender(tx)
+	}
+
+	sig, err := encodeSignature(tx.R, tx.S, tx.V, e.isHomestead)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	pub, err := Ecrecover(e.Hash(tx).Bytes(), sig)
+	if err != nil {
+		return types.Address{}, err
+	}
+
+	buf := Keccak256(pub[1:])[12:]
+
+	return types.BytesToAddress(buf), nil
+}
+
+// SignTx signs the transaction using the passed in private key
+func (e *BerlinSigner) SignTx(tx *types.Transaction, pk *ecdsa.PrivateKey) (*types.Transaction, error) {
+	// Apply fallback signer for non-access-list-txs
+	if tx.Type != types.AccessListTx {
+		return e.fallbackSigner.SignTx(tx, pk)
+	}
+
+	tx = tx.Copy()
+
+	h := e.Hash(tx)
+
+	sig, err := Sign(pk, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.R = new(big.Int).SetBytes(sig[:32])
+	tx.S = new(big.Int).SetBytes(sig[32:64])
+	tx.V = new(big.Int).SetBytes(e.calculateV(sig[64]))
+
+	return tx, nil
+}
+
+// calculateV returns the V value for transaction signatures
+func (e *BerlinSigner) calculateV(parity byte) []byte {
+	return big.NewInt(int64(parity)).Bytes()
+}
@@ -0,0 +1,103 @@
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestBerlinSigner_SignAndRecover(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateECDSAKey()
+	require.NoError(t, err)
+
+	to := types.StringToAddress("1")
+	signer := NewBerlinSigner(100, true, NewEIP155Signer(100, true))
+
+	txn := &types.Transaction{
+		Type:     types.AccessListTx,
+		ChainID:  big.NewInt(100),
+		To:       &to,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(10),
+		Gas:      30000,
+		AccessList: types.TxAccessList{
+			{Address: to, StorageKeys: []types.Hash{types.StringToHash("2")}},
+		},
+	}
+
+	signedTx, err := signer.SignTx(txn, key)
+	require.NoError(t, err)
+
+	sender, err := signer.Sender(signedTx)
+	require.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), sender)
+
+	// the access list is part of the signed payload
+	signedTx.AccessList[0].StorageKeys[0] = types.StringToHash("3")
+
+	sender, err = signer.Sender(signedTx)
+	require.NoError(t, err)
+	assert.NotEqual(t, PubKeyToAddress(&key.PublicKey), sender)
+
+	// other transaction types are handled by the fallback signer
+	legacyTx, err := signer.SignTx(&types.Transaction{To: &to, Value: big.NewInt(1), GasPrice: big.NewInt(1)}, key)
+	require.NoError(t, err)
+	assert.True(t, legacyTx.V.Uint64() > 1)
+
+	sender, err = signer.Sender(legacyTx)
+	require.NoError(t, err)
+	assert.Equal(t, PubKeyToAddress(&key.PublicKey), sender)
+}
+
+func TestBerlinSigner_EthgoCompatibility(t *testing.T) {
+	t.Parallel()
+
+	key, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	to := ethgo.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	slot := ethgo.HexToHash("0x01")
+
+	ethgoTx, err := wallet.NewEIP155Signer(100).SignTx(&ethgo.Transaction{
+		Type:     ethgo.TransactionAccessList,
+		ChainID:  big.NewInt(100),
+		Nonce:    3,
+		GasPrice: 1000,
+		Gas:      50000,
+		To:       &to,
+		Value:    big.NewInt(5),
+		AccessList: ethgo.AccessList{
+			{Address: to, Storage: []ethgo.Hash{slot}},
+		},
+	}, key)
+	require.NoError(t, err)
+
+	toAddr := types.Address(to)
+	txn := &types.Transaction{
+		Type:     types.AccessListTx,
+		ChainID:  big.NewInt(100),
+		Nonce:    3,
+		GasPrice: big.NewInt(1000),
+		Gas:      50000,
+		To:       &toAddr,
+		Value:    big.NewInt(5),
+		AccessList: types.TxAccessList{
+			{Address: toAddr, StorageKeys: []types.Hash{types.Hash(slot)}},
+		},
+		V: new(big.Int).SetBytes(ethgoTx.V),
+		R: new(big.Int).SetBytes(ethgoTx.R),
+		S: new(big.Int).SetBytes(ethgoTx.S),
+	}
+
+	sender, err := NewBerlinSigner(100, true, NewEIP155Signer(100, true)).Sender(txn)
+	require.NoError(t, err)
+	assert.Equal(t, types.Address(key.Address()), sender)
+}
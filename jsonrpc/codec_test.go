@@ -17,6 +17,8 @@ func TestBlockNumberOrHash_UnmarshalJSON(t *testing.T) {
 	blockNumberZero := BlockNumber(0x0)
 	blockNumberLatest := LatestBlockNumber
 	blockNumberPending := PendingBlockNumber
+	blockNumberSafe := SafeBlockNumber
+	blockNumberFinalized := FinalizedBlockNumber
 
 	tests := []struct {
 		name        string
@@ -67,6 +69,22 @@ func TestBlockNumberOrHash_UnmarshalJSON(t *testing.T) {
 				BlockNumber: &blockNumberPending,
 			},
 		},
+		{
+			"should unmarshal safe block number properly",
+			`"safe"`,
+			false,
+			BlockNumberOrHash{
+				BlockNumber: &blockNumberSafe,
+			},
+		},
+		{
+			"should unmarshal finalized block number properly",
+			`"finalized"`,
+			false,
+			BlockNumberOrHash{
+				BlockNumber: &blockNumberFinalized,
+			},
+		},
 		{
 			"should unmarshal block number 0 properly #1",
 			`{"blockNumber": "0x0"}`,
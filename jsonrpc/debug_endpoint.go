@@ -2,18 +2,29 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/fourbytetracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/opcounttracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
-const callTracerName = "callTracer"
+const (
+	callTracerName     = "callTracer"
+	prestateTracerName = "prestateTracer"
+	fourByteTracerName = "4byteTracer"
+	opCountTracerName  = "opcountTracer"
+)
 
 var (
 	defaultTraceTimeout = 5 * time.Second
@@ -50,6 +61,9 @@ type debugBlockchainStore interface {
 
 	// TraceCall traces a single call at the point when the given header is mined
 	TraceCall(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+
+	// GetForksInTime returns the forks active at the given block
+	GetForksInTime(blockNumber uint64) chain.ForksInTime
 }
 
 type debugTxPoolStore interface {
@@ -87,6 +101,8 @@ type TraceConfig struct {
 	DisableStructLogs bool    `json:"disableStructLogs"`
 	Timeout           *string `json:"timeout"`
 	Tracer            string  `json:"tracer"`
+	// TracerConfig is the configuration specific to the selected tracer
+	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
 
 func (d *Debug) TraceBlockByNumber(
@@ -166,7 +182,7 @@ func (d *Debug) TraceTransaction(
 				return nil, ErrTraceGenesisBlock
 			}
 
-			tracer, cancel, err := newTracer(config)
+			tracer, cancel, err := newTracer(config, d.store.GetForksInTime(block.Number()))
 			if err != nil {
 				return nil, err
 			}
@@ -201,7 +217,7 @@ func (d *Debug) TraceCall(
 				tx.Gas = header.GasLimit
 			}
 
-			tracer, cancel, err := newTracer(config)
+			tracer, cancel, err := newTracer(config, d.store.GetForksInTime(header.Number))
 			if err != nil {
 				return nil, err
 			}
@@ -221,7 +237,7 @@ func (d *Debug) traceBlock(
 		return nil, ErrTraceGenesisBlock
 	}
 
-	tracer, cancel, err := newTracer(config)
+	tracer, cancel, err := newTracer(config, d.store.GetForksInTime(block.Number()))
 	if err != nil {
 		return nil, err
 	}
@@ -231,8 +247,8 @@ func (d *Debug) traceBlock(
 	return d.store.TraceBlock(block, tracer)
 }
 
-// newTracer creates new tracer by config
-func newTracer(config *TraceConfig) (
+// newTracer creates new tracer by config for the block with the given forks
+func newTracer(config *TraceConfig, forks chain.ForksInTime) (
 	tracer.Tracer,
 	context.CancelFunc,
 	error,
@@ -254,9 +270,25 @@ func newTracer(config *TraceConfig) (
 
 	var tracer tracer.Tracer
 
-	if config.Tracer == callTracerName {
+	switch config.Tracer {
+	case callTracerName:
 		tracer = &calltracer.CallTracer{}
-	} else {
+	case prestateTracerName:
+		var prestateConfig prestatetracer.Config
+
+		if len(config.TracerConfig) > 0 {
+			if err := json.Unmarshal(config.TracerConfig, &prestateConfig); err != nil {
+				return nil, nil, fmt.Errorf("invalid tracer config: %w", err)
+			}
+		}
+
+		tracer = prestatetracer.NewPrestateTracer(prestateConfig)
+	case fourByteTracerName:
+		// the calls to the precompiles are not counted
+		tracer = fourbytetracer.NewFourByteTracer(precompiled.NewPrecompiled().Addresses(&forks)...)
+	case opCountTracerName:
+		tracer = opcounttracer.NewOpCountTracer()
+	default:
 		tracer = structtracer.NewStructTracer(structtracer.Config{
 			EnableMemory:     config.EnableMemory && !config.DisableStructLogs,
 			EnableStack:      !config.DisableStack && !config.DisableStructLogs,
@@ -266,6 +298,12 @@ func newTracer(config *TraceConfig) (
 		})
 	}
 
+	// cancellation of context is done by caller
+	return tracer, cancelOnTimeout(tracer, timeout), nil
+}
+
+// cancelOnTimeout cancels the tracer if it is not done within the timeout
+func cancelOnTimeout(tracer tracer.Tracer, timeout time.Duration) context.CancelFunc {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
 
 	go func() {
@@ -276,6 +314,5 @@ func newTracer(config *TraceConfig) (
 		}
 	}()
 
-	// cancellation of context is done by caller
-	return tracer, cancel, nil
+	return cancel
 }
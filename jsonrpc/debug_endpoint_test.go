@@ -9,8 +9,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/fourbytetracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/opcounttracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -26,6 +31,7 @@ type debugEndpointMockStore struct {
 	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn          func(types.Address) uint64
 	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	getForksInTimeFn    func(uint64) chain.ForksInTime
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -68,6 +74,14 @@ func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address)
 	return s.getAccountFn(root, addr)
 }
 
+func (s *debugEndpointMockStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
+	if s.getForksInTimeFn == nil {
+		return chain.AllForksEnabled.At(blockNumber)
+	}
+
+	return s.getForksInTimeFn(blockNumber)
+}
+
 func TestDebugTraceConfigDecode(t *testing.T) {
 	timeout15s := "15s"
 
@@ -737,7 +751,7 @@ func Test_newTracer(t *testing.T) {
 			EnableReturnData: true,
 			DisableStack:     false,
 			DisableStorage:   false,
-		})
+		}, chain.ForksInTime{})
 
 		t.Cleanup(func() {
 			cancel()
@@ -750,7 +764,7 @@ func Test_newTracer(t *testing.T) {
 	t.Run("should return error if arg is nil", func(t *testing.T) {
 		t.Parallel()
 
-		tracer, cancel, err := newTracer(nil)
+		tracer, cancel, err := newTracer(nil, chain.ForksInTime{})
 
 		assert.Nil(t, tracer)
 		assert.Nil(t, cancel)
@@ -767,7 +781,7 @@ func Test_newTracer(t *testing.T) {
 			DisableStack:     false,
 			DisableStorage:   false,
 			Timeout:          &timeout,
-		})
+		}, chain.ForksInTime{})
 
 		t.Cleanup(func() {
 			cancel()
@@ -793,7 +807,7 @@ func Test_newTracer(t *testing.T) {
 			DisableStack:     false,
 			DisableStorage:   false,
 			Timeout:          &timeout,
-		})
+		}, chain.ForksInTime{})
 
 		assert.NoError(t, err)
 
@@ -814,7 +828,7 @@ func Test_newTracer(t *testing.T) {
 			DisableStack:      false,
 			DisableStorage:    false,
 			DisableStructLogs: true,
-		})
+		}, chain.ForksInTime{})
 
 		t.Cleanup(func() {
 			cancel()
@@ -833,4 +847,74 @@ func Test_newTracer(t *testing.T) {
 			EnableStructLogs: false,
 		}, st.Config)
 	})
+	t.Run("should create native tracers by name", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			expected interface{}
+		}{
+			{callTracerName, &calltracer.CallTracer{}},
+			{prestateTracerName, &prestatetracer.PrestateTracer{}},
+			{fourByteTracerName, &fourbytetracer.FourByteTracer{}},
+			{opCountTracerName, &opcounttracer.OpCountTracer{}},
+		}
+
+		for _, tt := range tests {
+			tracer, cancel, err := newTracer(&TraceConfig{Tracer: tt.name}, chain.ForksInTime{})
+			require.NoError(t, err)
+
+			cancel()
+
+			assert.IsType(t, tt.expected, tracer)
+		}
+	})
+
+	t.Run("should exclude the precompiles active in the given forks from the four byte tracer", func(t *testing.T) {
+		t.Parallel()
+
+		// the modexp precompile is enabled by byzantium
+		modexp := types.StringToAddress("5")
+		input := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+		for _, forks := range []chain.ForksInTime{{}, {Byzantium: true}} {
+			tracer, cancel, err := newTracer(&TraceConfig{Tracer: fourByteTracerName}, forks)
+			require.NoError(t, err)
+
+			cancel()
+
+			tracer.CallStart(1, types.ZeroAddress, modexp, 0, 1000, big.NewInt(0), input)
+
+			res, err := tracer.GetResult()
+			require.NoError(t, err)
+
+			if forks.Byzantium {
+				assert.Empty(t, res)
+			} else {
+				assert.Equal(t, map[string]int{"0xa9059cbb-0": 1}, res)
+			}
+		}
+	})
+
+	t.Run("should pass the config to the prestate tracer", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, cancel, err := newTracer(&TraceConfig{
+			Tracer:       prestateTracerName,
+			TracerConfig: json.RawMessage(`{"diffMode":true}`),
+		}, chain.ForksInTime{})
+		require.NoError(t, err)
+
+		cancel()
+
+		res, err := tracer.GetResult()
+		require.NoError(t, err)
+		assert.IsType(t, &prestatetracer.DiffResult{}, res)
+
+		_, _, err = newTracer(&TraceConfig{
+			Tracer:       prestateTracerName,
+			TracerConfig: json.RawMessage(`{"diffMode":1}`),
+		}, chain.ForksInTime{})
+		assert.ErrorContains(t, err, "invalid tracer config")
+	})
 }
@@ -39,6 +39,9 @@ type endpoints struct {
 	TxPool *TxPool
 	Bridge *Bridge
 	Debug  *Debug
+	Trace  *Trace
+	Oracle *Oracle
+	Hydra  *Hydra
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -67,6 +70,25 @@ func (dp dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
 	return dp.jsonRPCBatchLengthLimit != 0 && value > dp.jsonRPCBatchLengthLimit
 }
 
+// isExceedingBlockRangeLimit checks if the number of blocks requested
+// by the block receipts and block trace calls of the batch is over the block range limit
+func (dp dispatcherParams) isExceedingBlockRangeLimit(batch BatchRequest) bool {
+	if dp.blockRangeLimit == 0 {
+		return false
+	}
+
+	blocks := uint64(0)
+
+	for _, req := range batch {
+		switch req.Method {
+		case "eth_getBlockReceipts", "trace_block", "trace_replayBlockTransactions":
+			blocks++
+		}
+	}
+
+	return blocks > dp.blockRangeLimit
+}
+
 func newDispatcher(
 	logger hclog.Logger,
 	store JSONRPCStore,
@@ -111,6 +133,13 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		store,
 	}
 	d.endpoints.Debug = NewDebug(store, d.params.concurrentRequestsDebug)
+	d.endpoints.Trace = NewTrace(store, d.params.concurrentRequestsDebug, d.params.blockRangeLimit)
+	d.endpoints.Oracle = &Oracle{
+		store,
+	}
+	d.endpoints.Hydra = &Hydra{
+		store,
+	}
 
 	var err error
 
@@ -134,7 +163,19 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		return err
 	}
 
-	return d.registerService("debug", d.endpoints.Debug)
+	if err = d.registerService("debug", d.endpoints.Debug); err != nil {
+		return err
+	}
+
+	if err = d.registerService("trace", d.endpoints.Trace); err != nil {
+		return err
+	}
+
+	if err = d.registerService("oracle", d.endpoints.Oracle); err != nil {
+		return err
+	}
+
+	return d.registerService("hydra", d.endpoints.Hydra)
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -268,6 +309,15 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 			).Bytes()
 		}
 
+		if d.params.isExceedingBlockRangeLimit(batchReq) {
+			return NewRPCResponse(
+				nil,
+				"2.0",
+				nil,
+				NewInvalidRequestError("Batch request block range too high"),
+			).Bytes()
+		}
+
 		responses := make([][]byte, len(batchReq))
 
 		for i, req := range batchReq {
@@ -368,6 +418,15 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 		).Bytes()
 	}
 
+	if d.params.isExceedingBlockRangeLimit(requests) {
+		return NewRPCResponse(
+			nil,
+			"2.0",
+			nil,
+			NewInvalidRequestError("Batch request block range too high"),
+		).Bytes()
+	}
+
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
@@ -417,6 +417,46 @@ func TestDispatcherBatchRequest(t *testing.T) {
 			&ObjectError{Code: -32600, Message: "Batch request length too long"},
 			nil,
 		},
+		{
+			"invalid-batch-block-receipts",
+			"test with batch block receipts req exceeding blockRangeLimit",
+			newTestDispatcher(t,
+				hclog.NewNullLogger(),
+				newMockStore(),
+				&dispatcherParams{
+					chainID:                 0,
+					priceLimit:              0,
+					jsonRPCBatchLengthLimit: 10,
+					blockRangeLimit:         2,
+				},
+			),
+			[]byte(`[
+				{"id":1,"jsonrpc":"2.0","method":"eth_getBlockReceipts","params":["0x1"]},
+				{"id":2,"jsonrpc":"2.0","method":"eth_getBlockReceipts","params":["0x2"]},
+				{"id":3,"jsonrpc":"2.0","method":"eth_getBlockReceipts","params":["0x3"]}]`),
+			&ObjectError{Code: -32600, Message: "Batch request block range too high"},
+			nil,
+		},
+		{
+			"invalid-batch-block-traces",
+			"test with batch block receipts and block traces req exceeding blockRangeLimit",
+			newTestDispatcher(t,
+				hclog.NewNullLogger(),
+				newMockStore(),
+				&dispatcherParams{
+					chainID:                 0,
+					priceLimit:              0,
+					jsonRPCBatchLengthLimit: 10,
+					blockRangeLimit:         2,
+				},
+			),
+			[]byte(`[
+				{"id":1,"jsonrpc":"2.0","method":"eth_getBlockReceipts","params":["0x1"]},
+				{"id":2,"jsonrpc":"2.0","method":"trace_block","params":["0x2"]},
+				{"id":3,"jsonrpc":"2.0","method":"trace_replayBlockTransactions","params":["0x3", ["trace"]]}]`),
+			&ObjectError{Code: -32600, Message: "Batch request block range too high"},
+			nil,
+		},
 		{
 			"no-limits",
 			"test when limits are not set",
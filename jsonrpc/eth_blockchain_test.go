@@ -6,13 +6,18 @@ import (
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/bloombits"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_Block_GetBlockByNumber(t *testing.T) {
@@ -246,6 +251,105 @@ func TestEth_GetTransactionReceipt(t *testing.T) {
 	})
 }
 
+func TestEth_GetBlockReceipts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns nil if block not found", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash1})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns empty list for block without transactions", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+		store.add(newTestBlock(1, hash4))
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash4})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("returns correct receipts data for all the transactions", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+		block := newTestBlock(1, hash4)
+		store.add(block)
+		txn0 := newTestTransaction(uint64(0), addr0)
+		txn1 := newTestTransaction(uint64(1), addr1)
+		txn1.To = nil
+		block.Transactions = []*types.Transaction{txn0, txn1}
+		receipt1 := &types.Receipt{
+			Logs: []*types.Log{
+				{
+					// log 0
+					Topics: []types.Hash{
+						hash1,
+					},
+				},
+				{
+					// log 1
+					Topics: []types.Hash{
+						hash2,
+					},
+				},
+			},
+		}
+		receipt1.SetStatus(types.ReceiptSuccess)
+		receipt2 := &types.Receipt{
+			Logs: []*types.Log{
+				{
+					// log 2
+					Topics: []types.Hash{
+						hash3,
+					},
+				},
+			},
+		}
+		receipt2.SetStatus(types.ReceiptSuccess)
+		receipt2.SetContractAddress(addr2)
+		store.receipts[hash4] = []*types.Receipt{receipt1, receipt2}
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash4})
+
+		assert.NoError(t, err)
+
+		//nolint:forcetypeassert
+		response := res.([]*receipt)
+		assert.Len(t, response, 2)
+
+		for i, txn := range block.Transactions {
+			assert.Equal(t, txn.Hash, response[i].TxHash)
+			assert.Equal(t, uint64(i), uint64(response[i].TxIndex))
+			assert.Equal(t, block.Hash(), response[i].BlockHash)
+			assert.Equal(t, argBig(*txn.GasPrice), response[i].EffectiveGasPrice)
+		}
+
+		assert.Nil(t, response[0].ContractAddress)
+		assert.Equal(t, &addr2, response[1].ContractAddress)
+
+		assert.Len(t, response[0].Logs, 2)
+		assert.Equal(t, uint64(0), uint64(response[0].Logs[0].LogIndex))
+		assert.Equal(t, uint64(1), uint64(response[0].Logs[1].LogIndex))
+
+		assert.Len(t, response[1].Logs, 1)
+		assert.Equal(t, uint64(2), uint64(response[1].Logs[0].LogIndex))
+		assert.Equal(t, uint64(1), uint64(response[1].Logs[0].TxIndex))
+		assert.Equal(t, txn1.Hash, response[1].Logs[0].TxHash)
+	})
+}
+
 func TestEth_Syncing(t *testing.T) {
 	store := newMockBlockStore()
 	eth := newTestEthEndpoint(store)
@@ -454,10 +558,169 @@ type mockBlockStore struct {
 	averageGasPrice int64
 	ethCallError    error
 	returnValue     []byte
+	simulation      *state.Transition
 	forksInTime     chain.ForksInTime
 	baseFee         uint64
 
 	maxPriorityFeePerGasFn func() (*big.Int, error)
+
+	// bloomBits are the generators of the indexed bloom bits sections
+	bloomBits        []*bloombits.Generator
+	bloomSectionSize uint64
+}
+
+func TestEth_SimulateV1(t *testing.T) {
+	t.Parallel()
+
+	var (
+		counter  = types.StringToAddress("0x100")
+		reverter = types.StringToAddress("0x200")
+	)
+
+	// increments the slot 0, emits an empty log and returns the new value
+	counterCode := []byte{
+		0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55, 0x60, 0x00, 0x52,
+		0x60, 0x00, 0x60, 0x00, 0xa0, 0x60, 0x20, 0x60, 0x00, 0xf3,
+	}
+
+	newStore := func(t *testing.T) *mockBlockStore {
+		t.Helper()
+
+		ex := state.NewExecutor(&chain.Params{
+			Forks: chain.AllForksEnabled,
+		}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+		root, err := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+			counter:  {Code: counterCode},
+			reverter: {Code: []byte{0x60, 0x00, 0x60, 0x00, 0xfd}}, // REVERT(0, 0)
+		}, types.Hash{})
+		require.NoError(t, err)
+
+		ex.GetHash = func(_ *types.Header) state.GetHashByNumber {
+			return func(_ uint64) types.Hash {
+				return types.ZeroHash
+			}
+		}
+
+		parent := &types.Header{Number: 100, Hash: hash1, GasLimit: 1000000, Timestamp: 1000, StateRoot: root}
+
+		store := newMockBlockStore()
+		store.add(&types.Block{Header: parent})
+
+		store.simulation, err = ex.BeginTxn(root, parent, types.ZeroAddress)
+		require.NoError(t, err)
+
+		return store
+	}
+
+	t.Run("executes the calls of the blocks one after another", func(t *testing.T) {
+		t.Parallel()
+
+		eth := newTestEthEndpoint(newStore(t))
+		number := argUint64(110)
+
+		res, err := eth.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{
+					Calls: []*txnArgs{
+						{From: &addr0, To: &counter},
+						{From: &addr0, To: &counter},
+					},
+				},
+				{
+					BlockOverrides: &blockOverride{Number: &number},
+					StateOverrides: &stateOverride{
+						counter: overrideAccount{StateDiff: &map[types.Hash]types.Hash{{}: types.StringToHash("0x5")}},
+					},
+					Calls: []*txnArgs{
+						{From: &addr0, To: &counter},
+						{From: &addr0, To: &reverter},
+					},
+				},
+			},
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		blocks, ok := res.([]*simulatedBlock)
+		require.True(t, ok)
+		require.Len(t, blocks, 2)
+
+		first, second := blocks[0], blocks[1]
+
+		assert.Equal(t, argUint64(101), first.Number)
+		assert.Equal(t, argUint64(1001), first.Timestamp)
+		assert.Equal(t, hash1, first.ParentHash)
+		assert.Len(t, first.Transactions, 2)
+		require.Len(t, first.Calls, 2)
+
+		for i, call := range first.Calls {
+			assert.Equal(t, argUint64(types.ReceiptSuccess), call.Status)
+			assert.Nil(t, call.Error)
+			assert.Equal(t, argBytes(types.StringToHash(hex.EncodeUint64(uint64(i+1))).Bytes()), call.ReturnData)
+			require.Len(t, call.Logs, 1)
+			assert.Equal(t, argUint64(i), call.Logs[0].LogIndex)
+			assert.Equal(t, first.Hash, call.Logs[0].BlockHash)
+		}
+
+		assert.Equal(t, first.Calls[0].GasUsed+first.Calls[1].GasUsed, first.GasUsed)
+
+		assert.Equal(t, argUint64(110), second.Number)
+		assert.Equal(t, first.Hash, second.ParentHash)
+		require.Len(t, second.Calls, 2)
+
+		// the state override is applied on top of the state of the previous block
+		assert.Equal(t, argBytes(types.StringToHash("0x6").Bytes()), second.Calls[0].ReturnData)
+
+		reverted := second.Calls[1]
+		assert.Equal(t, argUint64(types.ReceiptFailed), reverted.Status)
+		require.NotNil(t, reverted.Error)
+		assert.Equal(t, 3, reverted.Error.Code)
+		assert.Empty(t, reverted.Logs)
+	})
+
+	t.Run("does not modify the calls", func(t *testing.T) {
+		t.Parallel()
+
+		eth := newTestEthEndpoint(newStore(t))
+		call := &txnArgs{To: &counter}
+
+		_, err := eth.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{Calls: []*txnArgs{call}},
+			},
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		assert.Equal(t, &txnArgs{To: &counter}, call)
+	})
+
+	t.Run("returns error if the block numbers are not increasing", func(t *testing.T) {
+		t.Parallel()
+
+		eth := newTestEthEndpoint(newStore(t))
+		number := argUint64(100)
+
+		_, err := eth.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{BlockOverrides: &blockOverride{Number: &number}},
+			},
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrSimulateBlockOrder)
+	})
+
+	t.Run("returns error if there are no blocks", func(t *testing.T) {
+		t.Parallel()
+
+		eth := newTestEthEndpoint(newStore(t))
+
+		_, err := eth.SimulateV1(&simulateOpts{}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrNoSimulateBlocks)
+
+		_, err = eth.SimulateV1(&simulateOpts{
+			BlockStateCalls: make([]*simulateBlock, maxSimulateBlocks+1),
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrTooManySimulateBlocks)
+	})
 }
 
 func newMockBlockStore() *mockBlockStore {
@@ -635,10 +898,32 @@ func (m *mockBlockStore) ApplyTxn(_ *types.Header, _ *types.Transaction, _ types
 	}, nil
 }
 
+func (m *mockBlockStore) NewSimulation(_ *types.Header) (*state.Transition, error) {
+	if m.simulation == nil {
+		return nil, errors.New("simulation not set")
+	}
+
+	return m.simulation, nil
+}
+
 func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
 
+func (m *mockBlockStore) BloomBitsSections() (uint64, uint64) {
+	return m.bloomSectionSize, uint64(len(m.bloomBits))
+}
+
+func (m *mockBlockStore) GetBloomBits(bit uint, section uint64) ([]byte, bool) {
+	if section >= uint64(len(m.bloomBits)) {
+		return nil, false
+	}
+
+	vector, err := m.bloomBits[section].Bitset(bit)
+
+	return vector, err == nil
+}
+
 func (m *mockBlockStore) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
@@ -5,16 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/gasprice"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/accesslisttracer"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
 )
 
 type ethTxPoolStore interface {
@@ -36,11 +42,30 @@ type Account struct {
 	Nonce   uint64
 }
 
+// StorageProof is the merkle proof of a single storage slot of an account
+type StorageProof struct {
+	Key   types.Hash
+	Value []byte
+	Proof [][]byte
+}
+
+// AccountProof is the merkle proof of an account and of the requested storage slots
+type AccountProof struct {
+	Balance      *big.Int
+	Nonce        uint64
+	CodeHash     types.Hash
+	StorageHash  types.Hash
+	AccountProof [][]byte
+	StorageProof []*StorageProof
+}
+
 type ethStateStore interface {
 	GetAccount(root types.Hash, addr types.Address) (*Account, error)
 	GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error)
 	GetForksInTime(blockNumber uint64) chain.ForksInTime
 	GetCode(root types.Hash, addr types.Address) ([]byte, error)
+	// GetProof returns the merkle proofs of the account and of the given storage slots
+	GetProof(root types.Hash, addr types.Address, storageKeys []types.Hash) (*AccountProof, error)
 }
 
 type ethBlockchainStore interface {
@@ -73,6 +98,18 @@ type ethBlockchainStore interface {
 		nonPayable bool,
 	) (*runtime.ExecutionResult, error)
 
+	// ApplyTxnWithTracer applies a transaction object to the blockchain, tracing its execution
+	ApplyTxnWithTracer(
+		header *types.Header,
+		txn *types.Transaction,
+		tracer tracer.Tracer,
+		nonPayable bool,
+	) (*runtime.ExecutionResult, error)
+
+	// NewSimulation returns the transition on top of the state of the given header,
+	// in which the transactions are simulated one after another
+	NewSimulation(header *types.Header) (*state.Transition, error)
+
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
 }
@@ -100,8 +137,14 @@ type Eth struct {
 	priceLimit    uint64
 }
 
+// maxSimulateBlocks is the maximal number of the blocks simulated by eth_simulateV1
+const maxSimulateBlocks = 256
+
 var (
-	ErrInsufficientFunds = errors.New("insufficient funds for execution")
+	ErrInsufficientFunds     = errors.New("insufficient funds for execution")
+	ErrNoSimulateBlocks      = errors.New("empty block state calls")
+	ErrTooManySimulateBlocks = fmt.Errorf("too many blocks to simulate, the limit is %d", maxSimulateBlocks)
+	ErrSimulateBlockOrder    = errors.New("the simulated block numbers and timestamps must be increasing")
 )
 
 // ChainId returns the chain id of the client
@@ -344,6 +387,53 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 	return toReceipt(raw, txn, uint64(txIndex), block.Header, logs), nil
 }
 
+// GetBlockReceipts returns the receipts of all the transactions of the block
+func (e *Eth) GetBlockReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		// block not found
+		return nil, nil
+	}
+
+	if len(block.Transactions) == 0 {
+		return []*receipt{}, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(header.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		// Receipts not written yet on the db
+		e.logger.Warn(
+			fmt.Sprintf("Receipts for block with hash [%s] not found", header.Hash.String()),
+		)
+
+		return nil, nil
+	}
+
+	var (
+		result   = make([]*receipt, len(receipts))
+		logIndex = uint64(0)
+	)
+
+	for i, raw := range receipts {
+		txn := block.Transactions[i]
+		logs := toLogs(raw.Logs, logIndex, uint64(i), block.Header, txn.Hash)
+
+		result[i] = toReceipt(raw, txn, uint64(i), block.Header, logs)
+		logIndex += uint64(len(raw.Logs))
+	}
+
+	return result, nil
+}
+
 // GetStorageAt returns the contract storage at the index position
 func (e *Eth) GetStorageAt(
 	address types.Address,
@@ -368,6 +458,48 @@ func (e *Eth) GetStorageAt(
 	return argBytesPtr(result), nil
 }
 
+// GetProof returns the account and storage values of the specified account
+// including the merkle proofs (EIP-1186), which can be verified against the block state root
+func (e *Eth) GetProof(
+	address types.Address,
+	storageKeys []types.Hash,
+	filter BlockNumberOrHash,
+) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := e.store.GetProof(header.StateRoot, address, storageKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &accountProof{
+		Address:      address,
+		AccountProof: toProofNodes(proof.AccountProof),
+		Balance:      argBig(*new(big.Int)),
+		CodeHash:     proof.CodeHash,
+		Nonce:        argUint64(proof.Nonce),
+		StorageHash:  proof.StorageHash,
+		StorageProof: make([]*storageProof, len(proof.StorageProof)),
+	}
+
+	if proof.Balance != nil {
+		res.Balance = argBig(*proof.Balance)
+	}
+
+	for i, sp := range proof.StorageProof {
+		res.StorageProof[i] = &storageProof{
+			Key:   sp.Key,
+			Value: argBig(*new(big.Int).SetBytes(sp.Value)),
+			Proof: toProofNodes(sp.Proof),
+		}
+	}
+
+	return res, nil
+}
+
 // GasPrice exposes "getGasPrice"'s function logic to public RPC interface
 func (e *Eth) GasPrice() (interface{}, error) {
 	gasPrice, err := e.getGasPrice()
@@ -459,6 +591,19 @@ func (o *overrideAccount) ToType() types.OverrideAccount {
 // StateOverride is the collection of overridden accounts.
 type stateOverride map[types.Address]overrideAccount
 
+func (s *stateOverride) ToType() types.StateOverride {
+	if s == nil {
+		return nil
+	}
+
+	res := types.StateOverride{}
+	for addr, o := range *s {
+		res[addr] = o.ToType()
+	}
+
+	return res
+}
+
 // Call executes a smart contract call using the transaction object data
 func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOverride) (interface{}, error) {
 	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
@@ -481,16 +626,8 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 		return nil, err
 	}
 
-	var override types.StateOverride
-	if apiOverride != nil {
-		override = types.StateOverride{}
-		for addr, o := range *apiOverride {
-			override[addr] = o.ToType()
-		}
-	}
-
 	// The return value of the execution is saved in the transition (returnValue field)
-	result, err := e.store.ApplyTxn(header, transaction, override, true)
+	result, err := e.store.ApplyTxn(header, transaction, apiOverride.ToType(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -507,6 +644,298 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 	return argBytesPtr(result.ReturnValue), nil
 }
 
+// CreateAccessList creates the access list (EIP-2930) of the transaction, based on the state of the given block.
+// It returns the access list and the gas used by the transaction when it is executed with it
+func (e *Eth) CreateAccessList(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := DecodeTxn(arg, header.Number, e.store, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Type == types.LegacyTx {
+		transaction.Type = types.AccessListTx
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	// Force transaction gas price if empty
+	if err = e.fillTransactionGasPrice(transaction); err != nil {
+		return nil, err
+	}
+
+	// the sender, the recipient and the precompiles are always warm
+	forksInTime := e.store.GetForksInTime(header.Number)
+	excluded := precompiled.NewPrecompiled().Addresses(&forksInTime)
+
+	to := crypto.CreateAddress(transaction.From, transaction.Nonce)
+	if transaction.To != nil {
+		to = *transaction.To
+	}
+
+	excluded = append(excluded, transaction.From, to)
+
+	// execute the transaction with the collected access list until it doesn't change anymore
+	accessList := accesslisttracer.NewAccessListTracer(transaction.AccessList, excluded...).AccessList()
+
+	for {
+		alTracer := accesslisttracer.NewAccessListTracer(accessList, excluded...)
+
+		transaction.AccessList = accessList
+
+		result, err := e.store.ApplyTxnWithTracer(header, transaction, alTracer, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if next := alTracer.AccessList(); !reflect.DeepEqual(accessList, next) {
+			accessList = next
+
+			continue
+		}
+
+		res := &accessListResult{
+			AccessList: accessList,
+			GasUsed:    argUint64(result.GasUsed),
+		}
+
+		if result.Failed() {
+			res.Error = result.Err.Error()
+		}
+
+		return res, nil
+	}
+}
+
+// SimulateV1 executes the calls of the simulated blocks one after another on top of the state of the given block,
+// with the block and state overrides applied before the calls of each block.
+// It returns the simulated blocks with the results of their calls
+func (e *Eth) SimulateV1(opts *simulateOpts, filter BlockNumberOrHash) (interface{}, error) {
+	if opts == nil || len(opts.BlockStateCalls) == 0 {
+		return nil, ErrNoSimulateBlocks
+	}
+
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, ErrTooManySimulateBlocks
+	}
+
+	parent, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := e.store.NewSimulation(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// without the validation the calls are executed as eth_call, regardless of the balances and the fees
+	transition.SetNonPayable(!opts.Validation)
+
+	res := make([]*simulatedBlock, 0, len(opts.BlockStateCalls))
+
+	for i, simBlock := range opts.BlockStateCalls {
+		header, err := simulatedHeader(parent, simBlock.BlockOverrides, opts.Validation)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+
+		override := &types.BlockOverride{
+			Number:    &header.Number,
+			Timestamp: &header.Timestamp,
+			GasLimit:  &header.GasLimit,
+			BaseFee:   new(big.Int).SetUint64(header.BaseFee),
+		}
+
+		// the fee recipient and the randomness of the parent are kept, unless they are overridden
+		if simBlock.BlockOverrides != nil {
+			override.Coinbase = simBlock.BlockOverrides.FeeRecipient
+			override.Difficulty = simBlock.BlockOverrides.PrevRandao
+		}
+
+		transition.WithBlockOverride(override)
+
+		if err := transition.WithStateOverride(simBlock.StateOverrides.ToType()); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+
+		result, err := e.simulateBlock(transition, header, simBlock.Calls, opts)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+
+		res = append(res, result)
+		parent = header
+	}
+
+	return res, nil
+}
+
+// simulatedHeader returns the header of the simulated block on top of the parent, with the overrides applied
+func simulatedHeader(parent *types.Header, override *blockOverride, validation bool) (*types.Header, error) {
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Sha3Uncles: types.EmptyUncleHash,
+		Miner:      parent.Miner,
+		Number:     parent.Number + 1,
+		GasLimit:   parent.GasLimit,
+		Timestamp:  parent.Timestamp + 1,
+	}
+
+	// the fees are paid only if the calls are validated
+	if validation {
+		header.BaseFee = parent.BaseFee
+	}
+
+	if override == nil {
+		return header, nil
+	}
+
+	if override.Number != nil {
+		if uint64(*override.Number) <= parent.Number {
+			return nil, ErrSimulateBlockOrder
+		}
+
+		header.Number = uint64(*override.Number)
+	}
+
+	if override.Time != nil {
+		if uint64(*override.Time) <= parent.Timestamp {
+			return nil, ErrSimulateBlockOrder
+		}
+
+		header.Timestamp = uint64(*override.Time)
+	}
+
+	if override.GasLimit != nil {
+		header.GasLimit = uint64(*override.GasLimit)
+	}
+
+	if override.FeeRecipient != nil {
+		header.Miner = override.FeeRecipient.Bytes()
+	}
+
+	if override.PrevRandao != nil {
+		header.MixHash = *override.PrevRandao
+	}
+
+	if override.BaseFeePerGas != nil {
+		header.BaseFee = ((*big.Int)(override.BaseFeePerGas)).Uint64()
+	}
+
+	return header, nil
+}
+
+// simulateBlock executes the calls of the simulated block and fills its header with their results
+func (e *Eth) simulateBlock(
+	transition *state.Transition,
+	header *types.Header,
+	calls []*txnArgs,
+	opts *simulateOpts,
+) (*simulatedBlock, error) {
+	var (
+		txs      = make([]*types.Transaction, 0, len(calls))
+		receipts = make([]*types.Receipt, 0, len(calls))
+		results  = make([]*simulateCallResult, 0, len(calls))
+		logIndex = uint64(0)
+	)
+
+	for i, call := range calls {
+		// the defaults are filled in a copy, so the request is not modified
+		arg := *call
+
+		if arg.From == nil {
+			from := types.ZeroAddress
+			arg.From = &from
+		}
+
+		// the nonce of the sender is taken from the simulated state, including the previous calls
+		if arg.Nonce == nil {
+			arg.Nonce = argUintPtr(transition.GetNonce(*arg.From))
+		}
+
+		txn, err := DecodeTxn(&arg, header.Number, e.store, false)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		// If the caller didn't supply the gas limit, then the call can use the remaining gas of the block
+		if txn.Gas == 0 {
+			txn.Gas = header.GasLimit - header.GasUsed
+		}
+
+		if opts.Validation {
+			if err := e.fillTransactionGasPrice(txn); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+
+			txn.ComputeHash(header.Number)
+		}
+
+		result, err := transition.WriteWithResult(txn)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		allReceipts := transition.Receipts()
+		receipt := allReceipts[len(allReceipts)-1]
+
+		header.GasUsed += result.GasUsed
+		receipt.CumulativeGasUsed = header.GasUsed
+
+		callResult := &simulateCallResult{
+			ReturnData: argBytes(result.ReturnValue),
+			Logs:       []*Log{},
+			GasUsed:    argUint64(result.GasUsed),
+			Status:     argUint64(*receipt.Status),
+		}
+
+		if result.Reverted() {
+			callResult.Error = &simulateCallError{
+				Code:    3,
+				Message: constructErrorFromRevert(result).Error(),
+				Data:    argBytes(result.ReturnValue),
+			}
+		} else if result.Failed() {
+			callResult.Error = &simulateCallError{
+				Code:    -32015,
+				Message: result.Err.Error(),
+			}
+		}
+
+		txs = append(txs, txn)
+		receipts = append(receipts, receipt)
+		results = append(results, callResult)
+	}
+
+	header.LogsBloom = types.CreateBloom(receipts)
+	header.TxRoot = buildroot.CalculateTransactionsRoot(txs, header.Number)
+	header.ReceiptsRoot = buildroot.CalculateReceiptsRoot(receipts)
+	header.ComputeHash()
+
+	// the logs of the failed calls are reverted
+	for i, receipt := range receipts {
+		if results[i].Error != nil {
+			continue
+		}
+
+		results[i].Logs = toLogs(receipt.Logs, logIndex, uint64(i), header, txs[i].Hash)
+		logIndex += uint64(len(receipt.Logs))
+	}
+
+	return &simulatedBlock{
+		block: toBlock(&types.Block{Header: header, Transactions: txs}, opts.ReturnFullTransactions),
+		Calls: results,
+	}, nil
+}
+
 // EstimateGas estimates the gas needed to execute a transaction
 func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
 	number := LatestBlockNumber
@@ -531,7 +960,7 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	if transaction.IsValueTransfer() {
 		// if it is a simple value transfer or a contract creation,
 		// we already know what is the transaction gas cost, no need to apply transaction
-		gasCost, err := state.TransactionGasCost(transaction, forksInTime.Homestead, forksInTime.Istanbul)
+		gasCost, err := state.TransactionGasCost(transaction, forksInTime.Homestead, forksInTime.Istanbul, forksInTime.Berlin)
 		if err != nil {
 			return nil, err
 		}
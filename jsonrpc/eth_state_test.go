@@ -6,11 +6,16 @@ import (
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -832,7 +837,8 @@ type mockSpecialStore struct {
 	account *mockAccount
 	block   *types.Block
 
-	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyTxnHook           func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyTxnWithTracerHook func(txn *types.Transaction, tracer tracer.Tracer) (*runtime.ExecutionResult, error)
 }
 
 func (m *mockSpecialStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
@@ -909,3 +915,313 @@ func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+func (m *mockSpecialStore) ApplyTxnWithTracer(
+	_ *types.Header,
+	txn *types.Transaction,
+	tracer tracer.Tracer,
+	_ bool,
+) (*runtime.ExecutionResult, error) {
+	if m.applyTxnWithTracerHook != nil {
+		return m.applyTxnWithTracerHook(txn, tracer)
+	}
+
+	return &runtime.ExecutionResult{}, nil
+}
+
+type mockProofStore struct {
+	*mockSpecialStore
+	state   *itrie.State
+	storage itrie.Storage
+}
+
+func (m *mockProofStore) GetProof(root types.Hash, addr types.Address, storageKeys []types.Hash) (*AccountProof, error) {
+	snap, err := m.state.NewSnapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := snap.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		account = &state.Account{Balance: big.NewInt(0), CodeHash: types.EmptyCodeHash.Bytes(), Root: types.EmptyRootHash}
+	}
+
+	accountProof, err := itrie.Prove(root, crypto.Keccak256(addr.Bytes()), m.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &AccountProof{
+		Balance:      account.Balance,
+		Nonce:        account.Nonce,
+		CodeHash:     types.BytesToHash(account.CodeHash),
+		StorageHash:  account.Root,
+		AccountProof: accountProof,
+	}
+
+	for _, key := range storageKeys {
+		proof, err := itrie.Prove(account.Root, crypto.Keccak256(key.Bytes()), m.storage)
+		if err != nil {
+			return nil, err
+		}
+
+		res.StorageProof = append(res.StorageProof, &StorageProof{
+			Key:   key,
+			Value: snap.GetStorage(addr, account.Root, key).Bytes(),
+			Proof: proof,
+		})
+	}
+
+	return res, nil
+}
+
+func TestEth_State_GetProof(t *testing.T) {
+	slot := types.StringToHash("0x1")
+	slotValue := types.StringToHash("0x2a")
+	missingSlot := types.StringToHash("0x2")
+
+	storage := itrie.NewMemoryStorage()
+	st := itrie.NewState(storage)
+
+	_, root, err := st.NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  addr0,
+			Balance:  big.NewInt(100),
+			Nonce:    5,
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+			Storage: []*state.StorageObject{
+				{Key: slot.Bytes(), Val: slotValue.Bytes()},
+			},
+		},
+		{
+			Address:  types.Address{0x2},
+			Balance:  big.NewInt(1),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+	})
+	require.NoError(t, err)
+
+	header := &types.Header{
+		Hash:      hash1,
+		Number:    0,
+		StateRoot: types.BytesToHash(root),
+	}
+
+	store := &mockProofStore{
+		mockSpecialStore: &mockSpecialStore{
+			block: &types.Block{Header: header},
+		},
+		state:   st,
+		storage: storage,
+	}
+
+	eth := newTestEthEndpoint(store)
+	latest := LatestBlockNumber
+
+	verifyAccount := func(t *testing.T, res *accountProof, addr types.Address) *state.Account {
+		t.Helper()
+
+		data, err := itrie.VerifyProof(header.StateRoot, crypto.Keccak256(addr.Bytes()), toProofBytes(res.AccountProof))
+		require.NoError(t, err)
+
+		if data == nil {
+			return nil
+		}
+
+		var account state.Account
+		require.NoError(t, account.UnmarshalRlp(data))
+
+		return &account
+	}
+
+	t.Run("existing account", func(t *testing.T) {
+		result, err := eth.GetProof(addr0, []types.Hash{slot, missingSlot}, BlockNumberOrHash{BlockNumber: &latest})
+		require.NoError(t, err)
+
+		res, ok := result.(*accountProof)
+		require.True(t, ok)
+
+		account := verifyAccount(t, res, addr0)
+		require.NotNil(t, account)
+		require.Equal(t, account.Balance, (*big.Int)(&res.Balance))
+		require.Equal(t, account.Nonce, uint64(res.Nonce))
+		require.Equal(t, account.Root, res.StorageHash)
+		require.Equal(t, types.EmptyCodeHash, res.CodeHash)
+
+		require.Len(t, res.StorageProof, 2)
+
+		// existing slot
+		sp := res.StorageProof[0]
+		require.Equal(t, slot, sp.Key)
+		require.Equal(t, big.NewInt(0x2a), (*big.Int)(&sp.Value))
+
+		data, err := itrie.VerifyProof(res.StorageHash, crypto.Keccak256(slot.Bytes()), toProofBytes(sp.Proof))
+		require.NoError(t, err)
+		require.NotNil(t, data)
+
+		// missing slot
+		sp = res.StorageProof[1]
+		require.Equal(t, missingSlot, sp.Key)
+		require.Equal(t, 0, (*big.Int)(&sp.Value).Sign())
+
+		data, err = itrie.VerifyProof(res.StorageHash, crypto.Keccak256(missingSlot.Bytes()), toProofBytes(sp.Proof))
+		require.NoError(t, err)
+		require.Nil(t, data)
+	})
+
+	t.Run("non-existing account", func(t *testing.T) {
+		result, err := eth.GetProof(uninitializedAddress, nil, BlockNumberOrHash{BlockNumber: &latest})
+		require.NoError(t, err)
+
+		res, ok := result.(*accountProof)
+		require.True(t, ok)
+
+		require.Nil(t, verifyAccount(t, res, uninitializedAddress))
+		require.Equal(t, 0, (*big.Int)(&res.Balance).Sign())
+		require.Equal(t, types.EmptyRootHash, res.StorageHash)
+		require.Empty(t, res.StorageProof)
+	})
+}
+
+func toProofBytes(nodes []argBytes) [][]byte {
+	res := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		res[i] = node
+	}
+
+	return res
+}
+
+type mockVMState struct{}
+
+func (m *mockVMState) Halt() {}
+
+func TestEth_CreateAccessList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contract = types.StringToAddress("0x200")
+		other    = types.StringToAddress("0x300")
+		slot     = types.StringToHash("0x1")
+	)
+
+	// mockExecution reads the storage slot of the contract and calls the other account,
+	// the accesses which are not in the access list of the transaction are more expensive
+	mockExecution := func(txn *types.Transaction, tr tracer.Tracer) *runtime.ExecutionResult {
+		tr.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot.Bytes())}, evm.SLOAD, contract, 1, nil, &mockVMState{})
+		tr.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(addr0.Bytes())}, evm.BALANCE, contract, 1, nil, &mockVMState{})
+		tr.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(other.Bytes()), big.NewInt(1000)},
+			evm.CALL, contract, 2, nil, &mockVMState{})
+
+		gasUsed, err := state.TransactionGasCost(txn, true, true, true)
+		require.NoError(t, err)
+
+		addrOk, slotOk := false, false
+
+		for _, tuple := range txn.AccessList {
+			if tuple.Address == other {
+				addrOk = true
+			}
+
+			if tuple.Address == contract && len(tuple.StorageKeys) == 1 && tuple.StorageKeys[0] == slot {
+				slotOk = true
+			}
+		}
+
+		if addrOk {
+			gasUsed += 100
+		} else {
+			gasUsed += 2600
+		}
+
+		if slotOk {
+			gasUsed += 100
+		} else {
+			gasUsed += 2100
+		}
+
+		return &runtime.ExecutionResult{GasUsed: gasUsed}
+	}
+
+	t.Run("access list is created", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		executions := 0
+		store.applyTxnWithTracerHook = func(txn *types.Transaction, tr tracer.Tracer) (*runtime.ExecutionResult, error) {
+			executions++
+
+			return mockExecution(txn, tr), nil
+		}
+
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.CreateAccessList(&txnArgs{
+			From:     &addr0,
+			To:       &contract,
+			Gas:      argUintPtr(100000),
+			GasPrice: argBytesPtr([]byte{0x64}),
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		result, ok := res.(*accessListResult)
+		require.True(t, ok)
+
+		assert.Equal(t, types.TxAccessList{
+			{Address: contract, StorageKeys: []types.Hash{slot}},
+			{Address: other, StorageKeys: []types.Hash{}},
+		}, result.AccessList)
+		assert.Equal(t, argUint64(21000+2*2400+1900+100+100), result.GasUsed)
+		assert.Empty(t, result.Error)
+		assert.Equal(t, 2, executions)
+	})
+
+	t.Run("execution error is returned in the result", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		store.applyTxnWithTracerHook = func(txn *types.Transaction, tr tracer.Tracer) (*runtime.ExecutionResult, error) {
+			result := mockExecution(txn, tr)
+			result.Err = runtime.ErrExecutionReverted
+
+			return result, nil
+		}
+
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.CreateAccessList(&txnArgs{
+			From: &addr0,
+			To:   &contract,
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		result, ok := res.(*accessListResult)
+		require.True(t, ok)
+
+		assert.Len(t, result.AccessList, 2)
+		assert.Equal(t, runtime.ErrExecutionReverted.Error(), result.Error)
+	})
+
+	t.Run("transition error is returned", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		store.applyTxnWithTracerHook = func(*types.Transaction, tracer.Tracer) (*runtime.ExecutionResult, error) {
+			return nil, state.ErrNonceIncorrect
+		}
+
+		eth := newTestEthEndpoint(store)
+
+		_, err := eth.CreateAccessList(&txnArgs{
+			From: &addr0,
+			To:   &contract,
+		}, BlockNumberOrHash{})
+		require.ErrorIs(t, err, state.ErrNonceIncorrect)
+	})
+}
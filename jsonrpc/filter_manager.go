@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/bloombits"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/google/uuid"
@@ -27,6 +28,8 @@ var (
 	ErrBlockRangeTooHigh                = errors.New("block range too high")
 	ErrNoWSConnection                   = errors.New("no websocket connection")
 	ErrUnknownSubscriptionType          = errors.New("unknown subscription type")
+
+	errBloomBitsNotFound = errors.New("bloom bits not found")
 )
 
 // defaultTimeout is the timeout to remove the filters that don't have a web socket stream
@@ -310,6 +313,12 @@ type filterManagerStore interface {
 
 	// TxPoolSubscribe subscribes for tx pool events
 	TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error)
+
+	// BloomBitsSections returns the section size and the number of indexed sections of the bloom bits index
+	BloomBitsSections() (uint64, uint64)
+
+	// GetBloomBits returns the bit vector of the bloom bit for the indexed section
+	GetBloomBits(bit uint, section uint64) ([]byte, bool)
 }
 
 // FilterManager manages all running filters
@@ -532,8 +541,14 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 	}
 
 	logs := make([]*Log, 0)
+	blocks := f.newBloomBitsFilter(query)
 
 	for i := from; i <= to; i++ {
+		if !blocks.mayMatch(i) {
+			// the logs bloom of the block cannot match the query
+			continue
+		}
+
 		block, ok := f.store.GetBlockByNumber(i, true)
 		if !ok {
 			break
@@ -555,6 +570,75 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 	return logs, nil
 }
 
+// bloomBitsFilter skips the blocks which cannot match the log query, using the bloom bits index
+type bloomBitsFilter struct {
+	store       filterManagerStore
+	matcher     *bloombits.Matcher
+	sectionSize uint64
+	sections    uint64
+
+	// section is the section of the current matches (nil if it is not indexed)
+	section uint64
+	matches []byte
+}
+
+func (f *FilterManager) newBloomBitsFilter(query *LogQuery) *bloomBitsFilter {
+	sectionSize, sections := f.store.BloomBitsSections()
+
+	filters := make([][][]byte, 0, len(query.Topics)+1)
+
+	addresses := make([][]byte, len(query.Addresses))
+	for i, addr := range query.Addresses {
+		addresses[i] = addr.Bytes()
+	}
+
+	filters = append(filters, addresses)
+
+	for _, topics := range query.Topics {
+		values := make([][]byte, len(topics))
+		for i, topic := range topics {
+			values[i] = topic.Bytes()
+		}
+
+		filters = append(filters, values)
+	}
+
+	return &bloomBitsFilter{
+		store:       f.store,
+		matcher:     bloombits.NewMatcher(sectionSize, filters),
+		sectionSize: sectionSize,
+		sections:    sections,
+		section:     sections,
+	}
+}
+
+// mayMatch checks if the block may contain the logs matching the query.
+// The blocks of the sections which are not indexed always may match
+func (b *bloomBitsFilter) mayMatch(number uint64) bool {
+	if b.sectionSize == 0 || number/b.sectionSize >= b.sections {
+		return true
+	}
+
+	if section := number / b.sectionSize; section != b.section {
+		b.section = section
+		b.matches = nil
+
+		matches, err := b.matcher.Match(func(bit uint) ([]byte, error) {
+			vector, ok := b.store.GetBloomBits(bit, section)
+			if !ok {
+				return nil, errBloomBitsNotFound
+			}
+
+			return vector, nil
+		})
+		if err == nil {
+			b.matches = matches
+		}
+	}
+
+	return b.matches == nil || bloombits.IsSet(b.matches, number%b.sectionSize)
+}
+
 // GetLogsForQuery return array of logs for given query
 func (f *FilterManager) GetLogsForQuery(query *LogQuery) ([]*Log, error) {
 	if query.BlockHash != nil {
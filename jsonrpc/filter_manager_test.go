@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/bloombits"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/gorilla/websocket"
@@ -154,6 +155,90 @@ func Test_GetLogsForQuery(t *testing.T) {
 	}
 }
 
+func Test_GetLogsForQuery_BloomBits(t *testing.T) {
+	t.Parallel()
+
+	const sectionSize = 8
+
+	store := newMockBlockStore()
+	store.bloomSectionSize = sectionSize
+
+	blocks := make([]*types.Block, 12)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				{
+					Value: big.NewInt(10),
+				},
+			},
+		}
+
+		store.receipts[blocks[i].Hash()] = []*types.Receipt{
+			{
+				Logs: []*types.Log{
+					{
+						Address: addr1,
+					},
+				},
+			},
+		}
+	}
+
+	store.appendBlocksToStore(blocks)
+
+	// the index of the first section says that only block 5 has the logs of the address
+	generator, err := bloombits.NewGenerator(sectionSize)
+	require.NoError(t, err)
+
+	for i := uint64(0); i < sectionSize; i++ {
+		bloom := types.Bloom{}
+		if i == 5 {
+			bloom = types.CreateBloom(store.receipts[blocks[i].Hash()])
+		}
+
+		require.NoError(t, generator.AddBloom(i, bloom))
+	}
+
+	store.bloomBits = []*bloombits.Generator{generator}
+
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+
+	t.Cleanup(func() {
+		defer f.Close()
+	})
+
+	blockNumbers := func(logs []*Log) []uint64 {
+		numbers := make([]uint64, len(logs))
+		for i, log := range logs {
+			numbers[i] = uint64(log.BlockNumber)
+		}
+
+		return numbers
+	}
+
+	// the blocks of the indexed section which cannot match are skipped
+	logs, err := f.GetLogsForQuery(&LogQuery{
+		fromBlock: 1,
+		toBlock:   11,
+		Addresses: []types.Address{addr1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5, 8, 9, 10, 11}, blockNumbers(logs))
+
+	// the query without filters matches all the blocks
+	logs, err = f.GetLogsForQuery(&LogQuery{
+		fromBlock: 1,
+		toBlock:   11,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, blockNumbers(logs))
+}
+
 func Test_getLogsFromBlock(t *testing.T) {
 	t.Parallel()
 
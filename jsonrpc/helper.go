@@ -20,10 +20,12 @@ type latestHeaderGetter interface {
 	Header() *types.Header
 }
 
-// GetNumericBlockNumber returns block number based on current state or specified number
+// GetNumericBlockNumber returns block number based on current state or specified number.
+// The safe and finalized blocks are the latest block, because the committed seals
+// of the PolyBFT blocks are verified before they are written, so the blocks are final once written
 func GetNumericBlockNumber(number BlockNumber, store latestHeaderGetter) (uint64, error) {
 	switch number {
-	case LatestBlockNumber, PendingBlockNumber:
+	case LatestBlockNumber, PendingBlockNumber, SafeBlockNumber, FinalizedBlockNumber:
 		latest := store.Header()
 		if latest == nil {
 			return 0, ErrLatestNotFound
@@ -48,10 +50,11 @@ type headerGetter interface {
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 }
 
-// GetBlockHeader returns a header using the provided number
+// GetBlockHeader returns a header using the provided number,
+// the safe and finalized blocks are the latest block (see GetNumericBlockNumber)
 func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, error) {
 	switch number {
-	case PendingBlockNumber, LatestBlockNumber:
+	case PendingBlockNumber, LatestBlockNumber, SafeBlockNumber, FinalizedBlockNumber:
 		return store.Header(), nil
 
 	case EarliestBlockNumber:
@@ -235,6 +238,10 @@ func DecodeTxn(arg *txnArgs, blockNumber uint64, store nonceGetter, forceSetNonc
 		txn.To = arg.To
 	}
 
+	if arg.AccessList != nil {
+		txn.AccessList = *arg.AccessList
+	}
+
 	txn.ComputeHash(blockNumber)
 
 	return txn, nil
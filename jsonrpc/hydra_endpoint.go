@@ -0,0 +1,510 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Finality is the proof of the finality of a block,
+// the aggregated signature of its committed seals and the validators which signed it
+type Finality struct {
+	Bitmap              []byte
+	AggregatedSignature []byte
+	Signers             []types.Address
+}
+
+// StakingValidator is the staking state of a validator
+type StakingValidator struct {
+	Address             types.Address
+	BlsKey              []byte
+	Stake               *big.Int
+	DelegatedStake      *big.Int
+	TotalStake          *big.Int
+	Commission          *big.Int
+	WithdrawableRewards *big.Int
+	VotingPower         *big.Int
+	Status              uint8
+	IsActive            bool
+	IsBanned            bool
+	IsBanInitiated      bool
+}
+
+// EpochValidator is a validator of the validator set of an epoch
+type EpochValidator struct {
+	Address     types.Address
+	BlsKey      []byte
+	VotingPower *big.Int
+}
+
+// Delegation is the delegation of a delegator to a validator
+type Delegation struct {
+	Validator  types.Address
+	Amount     *big.Int
+	Reward     *big.Int
+	IsVesting  bool
+	VestingEnd uint64
+}
+
+// PendingRewards are the rewards of an account which are not claimed yet
+type PendingRewards struct {
+	Staking     *big.Int
+	Commission  *big.Int
+	Delegations []*Delegation
+}
+
+// APR is the annual percentage rate of the staking rewards,
+// the values are the numerators of the fractions with the common denominator
+type APR struct {
+	Base        *big.Int
+	Max         *big.Int
+	RSIBonus    *big.Int
+	MacroFactor *big.Int
+	Denominator *big.Int
+}
+
+// ValidatorUptime is the number of the blocks signed by a validator in an epoch
+type ValidatorUptime struct {
+	Validator    types.Address
+	SignedBlocks uint64
+}
+
+// AccountReward is the reward distributed to an account
+type AccountReward struct {
+	Account types.Address
+	Amount  *big.Int
+}
+
+// EpochRewards are the uptime and the rewards distributed at the end of an epoch
+type EpochRewards struct {
+	Epoch             uint64
+	StartBlock        uint64
+	EndBlock          uint64
+	Uptime            []*ValidatorUptime
+	StakingRewards    []*AccountReward
+	DelegatorRewards  []*AccountReward
+	RewardWalletFund  *big.Int
+	VaultFunded       *big.Int
+	DAOIncentive      *big.Int
+	FeeHandlerBalance *big.Int
+	FeeHandlerIncome  *big.Int
+}
+
+// ValidatorHealth is the liveness statistics of a validator collected by the node
+type ValidatorHealth struct {
+	Address                 types.Address
+	SignedBlocks            uint64
+	MissedBlocks            uint64
+	ConsecutiveMissedBlocks uint64
+	LastSignedBlock         uint64
+	ProposedBlocks          uint64
+	MissedProposals         uint64
+	DoubleSigns             uint64
+}
+
+// DoubleSignEvidence are the two different proposals committed by a validator for the same height and round
+type DoubleSignEvidence struct {
+	Validator      types.Address
+	Height         uint64
+	Round          uint64
+	ProposalHashes []types.Hash
+}
+
+// ValidatorHealthReport is the liveness statistics of the validators up to the last processed block
+type ValidatorHealthReport struct {
+	BlockNumber uint64
+	Validators  []*ValidatorHealth
+	DoubleSigns []*DoubleSignEvidence
+}
+
+// maxRewardHistoryEpochs is the maximum number of epochs returned by hydra_getRewardHistory
+const maxRewardHistoryEpochs = 1000
+
+var (
+	ErrInvalidEpochRange  = errors.New("invalid epoch range, the first epoch is greater than the last one")
+	ErrTooManyEpochsQuery = fmt.Errorf("the epoch range is limited to %d epochs", maxRewardHistoryEpochs)
+)
+
+// hydraStore interface provides access to the methods needed by hydra endpoint
+type hydraStore interface {
+	Header() *types.Header
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
+
+	// GetFinality returns the committed seals of the given block
+	GetFinality(header *types.Header) (*Finality, error)
+	// GetStakingValidator returns the staking state of the validator at the given block (nil if it is not registered)
+	GetStakingValidator(header *types.Header, addr types.Address) (*StakingValidator, error)
+	// GetEpochValidators returns the validator set of the epoch, as seen at the given block
+	GetEpochValidators(header *types.Header, epoch uint64) ([]*EpochValidator, error)
+	// GetDelegations returns the delegations of the delegator at the given block
+	GetDelegations(header *types.Header, delegator types.Address) ([]*Delegation, error)
+	// GetPendingRewards returns the rewards of the account which are not claimed yet at the given block
+	GetPendingRewards(header *types.Header, addr types.Address) (*PendingRewards, error)
+	// GetAPR returns the APR of the staking rewards at the given block
+	GetAPR(header *types.Header) (*APR, error)
+	// GetRewardHistory returns the indexed rewards of the epochs in the given range (inclusive)
+	GetRewardHistory(fromEpoch, toEpoch uint64) ([]*EpochRewards, error)
+	// GetValidatorHealth returns the liveness statistics of the validators collected since the node started
+	GetValidatorHealth() (*ValidatorHealthReport, error)
+}
+
+// Hydra is the hydra jsonrpc endpoint, which exposes the data specific to the Hydragon consensus
+type Hydra struct {
+	store hydraStore
+}
+
+type finality struct {
+	BlockNumber         argUint64       `json:"blockNumber"`
+	BlockHash           types.Hash      `json:"blockHash"`
+	Bitmap              argBytes        `json:"bitmap"`
+	AggregatedSignature argBytes        `json:"aggregatedSignature"`
+	Signers             []types.Address `json:"signers"`
+}
+
+// GetFinality returns the committed seals of the given block, which prove its finality
+func (h *Hydra) GetFinality(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.store.GetFinality(header)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := res.Signers
+	if signers == nil {
+		signers = []types.Address{}
+	}
+
+	return &finality{
+		BlockNumber:         argUint64(header.Number),
+		BlockHash:           header.Hash,
+		Bitmap:              res.Bitmap,
+		AggregatedSignature: res.AggregatedSignature,
+		Signers:             signers,
+	}, nil
+}
+
+type stakingValidator struct {
+	Address             types.Address `json:"address"`
+	BlsKey              argBytes      `json:"blsKey"`
+	Stake               *argBig       `json:"stake"`
+	DelegatedStake      *argBig       `json:"delegatedStake"`
+	TotalStake          *argBig       `json:"totalStake"`
+	Commission          *argBig       `json:"commission"`
+	WithdrawableRewards *argBig       `json:"withdrawableRewards"`
+	VotingPower         *argBig       `json:"votingPower"`
+	Status              argUint64     `json:"status"`
+	IsActive            bool          `json:"isActive"`
+	IsBanned            bool          `json:"isBanned"`
+	IsBanInitiated      bool          `json:"isBanInitiated"`
+}
+
+type epochValidator struct {
+	Address     types.Address `json:"address"`
+	BlsKey      argBytes      `json:"blsKey"`
+	VotingPower *argBig       `json:"votingPower"`
+}
+
+type delegation struct {
+	Validator  types.Address `json:"validator"`
+	Amount     *argBig       `json:"amount"`
+	Reward     *argBig       `json:"reward"`
+	IsVesting  bool          `json:"isVesting"`
+	VestingEnd argUint64     `json:"vestingEnd"`
+}
+
+type pendingRewards struct {
+	Staking     *argBig       `json:"staking"`
+	Commission  *argBig       `json:"commission"`
+	Delegations []*delegation `json:"delegations"`
+}
+
+type apr struct {
+	Base        *argBig `json:"base"`
+	Max         *argBig `json:"max"`
+	RSIBonus    *argBig `json:"rsiBonus"`
+	MacroFactor *argBig `json:"macroFactor"`
+	Denominator *argBig `json:"denominator"`
+}
+
+func toDelegations(src []*Delegation) []*delegation {
+	res := make([]*delegation, len(src))
+
+	for i, d := range src {
+		res[i] = &delegation{
+			Validator:  d.Validator,
+			Amount:     argBigPtr(d.Amount),
+			Reward:     argBigPtr(d.Reward),
+			IsVesting:  d.IsVesting,
+			VestingEnd: argUint64(d.VestingEnd),
+		}
+	}
+
+	return res
+}
+
+// GetValidator returns the stake, the delegated stake, the commission, the voting power,
+// the BLS key and the ban status of the validator at the given block
+func (h *Hydra) GetValidator(address types.Address, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := h.store.GetStakingValidator(header, address)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return &stakingValidator{
+		Address:             v.Address,
+		BlsKey:              v.BlsKey,
+		Stake:               argBigPtr(v.Stake),
+		DelegatedStake:      argBigPtr(v.DelegatedStake),
+		TotalStake:          argBigPtr(v.TotalStake),
+		Commission:          argBigPtr(v.Commission),
+		WithdrawableRewards: argBigPtr(v.WithdrawableRewards),
+		VotingPower:         argBigPtr(v.VotingPower),
+		Status:              argUint64(v.Status),
+		IsActive:            v.IsActive,
+		IsBanned:            v.IsBanned,
+		IsBanInitiated:      v.IsBanInitiated,
+	}, nil
+}
+
+// GetValidatorSet returns the validator set of the epoch, as seen at the given block
+func (h *Hydra) GetValidatorSet(epoch argUint64, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := h.store.GetEpochValidators(header, uint64(epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*epochValidator, len(validators))
+
+	for i, v := range validators {
+		res[i] = &epochValidator{
+			Address:     v.Address,
+			BlsKey:      v.BlsKey,
+			VotingPower: argBigPtr(v.VotingPower),
+		}
+	}
+
+	return res, nil
+}
+
+// GetDelegations returns the delegations of the delegator to the validators at the given block
+func (h *Hydra) GetDelegations(delegator types.Address, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := h.store.GetDelegations(header, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDelegations(delegations), nil
+}
+
+// GetPendingRewards returns the staking rewards, the commissions and the delegation rewards
+// of the account, which are not claimed yet at the given block
+func (h *Hydra) GetPendingRewards(address types.Address, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := h.store.GetPendingRewards(header, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pendingRewards{
+		Staking:     argBigPtr(rewards.Staking),
+		Commission:  argBigPtr(rewards.Commission),
+		Delegations: toDelegations(rewards.Delegations),
+	}, nil
+}
+
+// GetAPR returns the APR of the staking rewards at the given block
+func (h *Hydra) GetAPR(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, h.store)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.store.GetAPR(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apr{
+		Base:        argBigPtr(res.Base),
+		Max:         argBigPtr(res.Max),
+		RSIBonus:    argBigPtr(res.RSIBonus),
+		MacroFactor: argBigPtr(res.MacroFactor),
+		Denominator: argBigPtr(res.Denominator),
+	}, nil
+}
+
+type validatorUptime struct {
+	Validator    types.Address `json:"validator"`
+	SignedBlocks argUint64     `json:"signedBlocks"`
+}
+
+type accountReward struct {
+	Account types.Address `json:"account"`
+	Amount  *argBig       `json:"amount"`
+}
+
+type epochRewards struct {
+	Epoch             argUint64          `json:"epoch"`
+	StartBlock        argUint64          `json:"startBlock"`
+	EndBlock          argUint64          `json:"endBlock"`
+	Uptime            []*validatorUptime `json:"uptime"`
+	StakingRewards    []*accountReward   `json:"stakingRewards"`
+	DelegatorRewards  []*accountReward   `json:"delegatorRewards"`
+	RewardWalletFund  *argBig            `json:"rewardWalletFund"`
+	VaultFunded       *argBig            `json:"vaultFunded"`
+	DAOIncentive      *argBig            `json:"daoIncentive"`
+	FeeHandlerBalance *argBig            `json:"feeHandlerBalance"`
+	FeeHandlerIncome  *argBig            `json:"feeHandlerIncome"`
+}
+
+// toAccountRewards converts the rewards, keeping only the rewards of the account if it is given
+func toAccountRewards(src []*AccountReward, account *types.Address) []*accountReward {
+	res := make([]*accountReward, 0, len(src))
+
+	for _, r := range src {
+		if account != nil && r.Account != *account {
+			continue
+		}
+
+		res = append(res, &accountReward{Account: r.Account, Amount: argBigPtr(r.Amount)})
+	}
+
+	return res
+}
+
+// GetRewardHistory returns the uptime and the rewards distributed at the end of the epochs in the given range
+// (inclusive). If the address is given, the uptime and the rewards are filtered to the address.
+// It requires the reward history to be enabled on the node, the epochs which are not indexed are skipped
+func (h *Hydra) GetRewardHistory(fromEpoch, toEpoch argUint64, address *types.Address) (interface{}, error) {
+	if fromEpoch > toEpoch {
+		return nil, ErrInvalidEpochRange
+	}
+
+	if toEpoch-fromEpoch >= maxRewardHistoryEpochs {
+		return nil, ErrTooManyEpochsQuery
+	}
+
+	history, err := h.store.GetRewardHistory(uint64(fromEpoch), uint64(toEpoch))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*epochRewards, len(history))
+
+	for i, r := range history {
+		uptime := make([]*validatorUptime, 0, len(r.Uptime))
+
+		for _, u := range r.Uptime {
+			if address != nil && u.Validator != *address {
+				continue
+			}
+
+			uptime = append(uptime, &validatorUptime{Validator: u.Validator, SignedBlocks: argUint64(u.SignedBlocks)})
+		}
+
+		res[i] = &epochRewards{
+			Epoch:             argUint64(r.Epoch),
+			StartBlock:        argUint64(r.StartBlock),
+			EndBlock:          argUint64(r.EndBlock),
+			Uptime:            uptime,
+			StakingRewards:    toAccountRewards(r.StakingRewards, address),
+			DelegatorRewards:  toAccountRewards(r.DelegatorRewards, address),
+			RewardWalletFund:  argBigPtr(r.RewardWalletFund),
+			VaultFunded:       argBigPtr(r.VaultFunded),
+			DAOIncentive:      argBigPtr(r.DAOIncentive),
+			FeeHandlerBalance: argBigPtr(r.FeeHandlerBalance),
+			FeeHandlerIncome:  argBigPtr(r.FeeHandlerIncome),
+		}
+	}
+
+	return res, nil
+}
+
+type validatorHealth struct {
+	Address                 types.Address `json:"address"`
+	SignedBlocks            argUint64     `json:"signedBlocks"`
+	MissedBlocks            argUint64     `json:"missedBlocks"`
+	ConsecutiveMissedBlocks argUint64     `json:"consecutiveMissedBlocks"`
+	LastSignedBlock         argUint64     `json:"lastSignedBlock"`
+	ProposedBlocks          argUint64     `json:"proposedBlocks"`
+	MissedProposals         argUint64     `json:"missedProposals"`
+	DoubleSigns             argUint64     `json:"doubleSigns"`
+}
+
+type doubleSignEvidence struct {
+	Validator      types.Address `json:"validator"`
+	Height         argUint64     `json:"height"`
+	Round          argUint64     `json:"round"`
+	ProposalHashes []types.Hash  `json:"proposalHashes"`
+}
+
+type validatorHealthReport struct {
+	BlockNumber argUint64             `json:"blockNumber"`
+	Validators  []*validatorHealth    `json:"validators"`
+	DoubleSigns []*doubleSignEvidence `json:"doubleSigns"`
+}
+
+// ValidatorHealth returns the signed and missed blocks, the missed proposals and the conflicting commit seals
+// of the validators, collected by the node since it started
+func (h *Hydra) ValidatorHealth() (interface{}, error) {
+	report, err := h.store.GetValidatorHealth()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &validatorHealthReport{
+		BlockNumber: argUint64(report.BlockNumber),
+		Validators:  make([]*validatorHealth, len(report.Validators)),
+		DoubleSigns: make([]*doubleSignEvidence, len(report.DoubleSigns)),
+	}
+
+	for i, v := range report.Validators {
+		res.Validators[i] = &validatorHealth{
+			Address:                 v.Address,
+			SignedBlocks:            argUint64(v.SignedBlocks),
+			MissedBlocks:            argUint64(v.MissedBlocks),
+			ConsecutiveMissedBlocks: argUint64(v.ConsecutiveMissedBlocks),
+			LastSignedBlock:         argUint64(v.LastSignedBlock),
+			ProposedBlocks:          argUint64(v.ProposedBlocks),
+			MissedProposals:         argUint64(v.MissedProposals),
+			DoubleSigns:             argUint64(v.DoubleSigns),
+		}
+	}
+
+	for i, e := range report.DoubleSigns {
+		res.DoubleSigns[i] = &doubleSignEvidence{
+			Validator:      e.Validator,
+			Height:         argUint64(e.Height),
+			Round:          argUint64(e.Round),
+			ProposalHashes: e.ProposalHashes,
+		}
+	}
+
+	return res, nil
+}
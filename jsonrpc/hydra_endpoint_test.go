@@ -0,0 +1,190 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHydraEndpoint_GetFinality(t *testing.T) {
+	store := newMockStore()
+	store.header = &types.Header{Number: 5, Hash: hash1}
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		store,
+		&dispatcherParams{
+			chainID:                 0,
+			priceLimit:              0,
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+		},
+	)
+
+	handle := func(msg string) *finality {
+		t.Helper()
+
+		data, err := dispatcher.Handle([]byte(msg))
+		require.NoError(t, err)
+
+		resp := new(SuccessResponse)
+		require.NoError(t, json.Unmarshal(data, resp))
+		require.Nil(t, resp.Error)
+
+		res := new(finality)
+		require.NoError(t, json.Unmarshal(resp.Result, res))
+
+		return res
+	}
+
+	res := handle(`{"method": "hydra_getFinality", "params": ["finalized"], "id": 1}`)
+	require.Equal(t, argUint64(5), res.BlockNumber)
+	require.Equal(t, hash1, res.BlockHash)
+	require.Equal(t, argBytes{0x5}, res.Bitmap)
+	require.Equal(t, argBytes{0x1, 0x2}, res.AggregatedSignature)
+	require.Equal(t, []types.Address{types.StringToAddress("1"), types.StringToAddress("3")}, res.Signers)
+
+	// the genesis block has no committed seals
+	store.historicalHeaders = []*types.Header{{Number: 0}}
+
+	res = handle(`{"method": "hydra_getFinality", "params": ["earliest"], "id": 1}`)
+	require.Equal(t, argUint64(0), res.BlockNumber)
+	require.Empty(t, res.Bitmap)
+	require.Equal(t, []types.Address{}, res.Signers)
+}
+
+func TestHydraEndpoint_Staking(t *testing.T) {
+	store := newMockStore()
+	store.header = &types.Header{Number: 5, Hash: hash1}
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		store,
+		&dispatcherParams{
+			chainID:                 0,
+			priceLimit:              0,
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+		},
+	)
+
+	handle := func(msg string, res interface{}) *ObjectError {
+		t.Helper()
+
+		data, err := dispatcher.Handle([]byte(msg))
+		require.NoError(t, err)
+
+		resp := new(SuccessResponse)
+		require.NoError(t, json.Unmarshal(data, resp))
+
+		if resp.Error != nil {
+			return resp.Error
+		}
+
+		require.NoError(t, json.Unmarshal(resp.Result, res))
+
+		return nil
+	}
+
+	t.Run("hydra_getValidator", func(t *testing.T) {
+		var v *stakingValidator
+
+		require.Nil(t, handle(`{"method": "hydra_getValidator", "params": ["`+
+			types.StringToAddress("1").String()+`", "latest"], "id": 1}`, &v))
+		require.NotNil(t, v)
+		require.Equal(t, types.StringToAddress("1"), v.Address)
+		require.Equal(t, argBytes{0x1}, v.BlsKey)
+		require.Equal(t, argBigPtr(big.NewInt(150)), v.TotalStake)
+		require.Equal(t, argUint64(1), v.Status)
+		require.True(t, v.IsActive)
+		require.False(t, v.IsBanned)
+
+		// the unregistered validator
+		v = nil
+		require.Nil(t, handle(`{"method": "hydra_getValidator", "params": ["`+
+			types.StringToAddress("2").String()+`"], "id": 1}`, &v))
+		require.Nil(t, v)
+	})
+
+	t.Run("hydra_getValidatorSet", func(t *testing.T) {
+		var validators []*epochValidator
+
+		require.Nil(t, handle(`{"method": "hydra_getValidatorSet", "params": ["0x2"], "id": 1}`, &validators))
+		require.Len(t, validators, 2)
+		require.Equal(t, types.StringToAddress("2"), validators[1].Address)
+		require.Equal(t, argBigPtr(big.NewInt(2)), validators[1].VotingPower)
+
+		require.NotNil(t, handle(`{"method": "hydra_getValidatorSet", "params": ["0x6"], "id": 1}`, &validators))
+	})
+
+	t.Run("hydra_getDelegations", func(t *testing.T) {
+		var delegations []*delegation
+
+		require.Nil(t, handle(`{"method": "hydra_getDelegations", "params": ["`+
+			types.StringToAddress("3").String()+`"], "id": 1}`, &delegations))
+		require.Len(t, delegations, 2)
+		require.Equal(t, argBigPtr(big.NewInt(20)), delegations[0].Amount)
+		require.True(t, delegations[1].IsVesting)
+		require.Equal(t, argUint64(100), delegations[1].VestingEnd)
+	})
+
+	t.Run("hydra_getPendingRewards", func(t *testing.T) {
+		var rewards *pendingRewards
+
+		require.Nil(t, handle(`{"method": "hydra_getPendingRewards", "params": ["`+
+			types.StringToAddress("3").String()+`"], "id": 1}`, &rewards))
+		require.Equal(t, argBigPtr(big.NewInt(5)), rewards.Staking)
+		require.Equal(t, argBigPtr(big.NewInt(1)), rewards.Commission)
+		require.Len(t, rewards.Delegations, 1)
+	})
+
+	t.Run("hydra_getRewardHistory", func(t *testing.T) {
+		var history []*epochRewards
+
+		require.Nil(t, handle(`{"method": "hydra_getRewardHistory", "params": ["0x1", "0x4"], "id": 1}`, &history))
+		require.Len(t, history, 2)
+		require.Equal(t, argUint64(2), history[0].Epoch)
+		require.Equal(t, argUint64(40), history[1].EndBlock)
+		require.Len(t, history[1].Uptime, 2)
+		require.Len(t, history[1].StakingRewards, 2)
+		require.Equal(t, argBigPtr(big.NewInt(20)), history[1].FeeHandlerIncome)
+
+		// the uptime and the rewards are filtered to the address
+		require.Nil(t, handle(`{"method": "hydra_getRewardHistory", "params": ["0x2", "0x2", "`+
+			types.StringToAddress("2").String()+`"], "id": 1}`, &history))
+		require.Len(t, history, 1)
+		require.Equal(t, []*validatorUptime{{Validator: types.StringToAddress("2"), SignedBlocks: 9}}, history[0].Uptime)
+		require.Equal(t, []*accountReward{
+			{Account: types.StringToAddress("2"), Amount: argBigPtr(big.NewInt(90))},
+		}, history[0].StakingRewards)
+		require.Empty(t, history[0].DelegatorRewards)
+
+		require.NotNil(t, handle(`{"method": "hydra_getRewardHistory", "params": ["0x4", "0x2"], "id": 1}`, &history))
+		require.NotNil(t, handle(`{"method": "hydra_getRewardHistory", "params": ["0x0", "0x3e8"], "id": 1}`, &history))
+	})
+
+	t.Run("hydra_getAPR", func(t *testing.T) {
+		var res *apr
+
+		require.Nil(t, handle(`{"method": "hydra_getAPR", "params": [], "id": 1}`, &res))
+		require.Equal(t, argBigPtr(big.NewInt(500)), res.Base)
+		require.Equal(t, argBigPtr(big.NewInt(10000)), res.Denominator)
+	})
+
+	t.Run("hydra_validatorHealth", func(t *testing.T) {
+		var res *validatorHealthReport
+
+		require.Nil(t, handle(`{"method": "hydra_validatorHealth", "params": [], "id": 1}`, &res))
+		require.Equal(t, argUint64(5), res.BlockNumber)
+		require.Len(t, res.Validators, 2)
+		require.Equal(t, argUint64(3), res.Validators[1].ConsecutiveMissedBlocks)
+		require.Equal(t, argUint64(1), res.Validators[1].MissedProposals)
+		require.Len(t, res.DoubleSigns, 1)
+		require.Equal(t, types.StringToAddress("2"), res.DoubleSigns[0].Validator)
+		require.Equal(t, []types.Hash{types.StringToHash("1"), types.StringToHash("2")}, res.DoubleSigns[0].ProposalHashes)
+	})
+}
@@ -57,6 +57,9 @@ type JSONRPCStore interface {
 	filterManagerStore
 	bridgeStore
 	debugStore
+	traceStore
+	oracleStore
+	hydraStore
 }
 
 type Config struct {
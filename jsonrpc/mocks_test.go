@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"fmt"
 	"math/big"
 	"sync"
 
@@ -149,6 +150,14 @@ func (m *mockStore) SubscribeEvents() blockchain.Subscription {
 	return m.subscription
 }
 
+func (m *mockStore) BloomBitsSections() (uint64, uint64) {
+	return 0, 0
+}
+
+func (m *mockStore) GetBloomBits(bit uint, section uint64) ([]byte, bool) {
+	return nil, false
+}
+
 func (m *mockStore) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
 	txPoolUnsubscribe := func() {
 		close(m.txPoolChannel)
@@ -220,3 +229,159 @@ func (m *mockStore) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 func (m *mockStore) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
+
+func (m *mockStore) GetOracleStatus() (*OracleStatus, error) {
+	return &OracleStatus{
+		Validator:   types.StringToAddress("1"),
+		IsValidator: true,
+		BlockNumber: 10,
+		Day:         20000,
+		Today: &OracleDayVote{
+			Day:    20000,
+			Status: "confirmed",
+		},
+	}, nil
+}
+
+func (m *mockStore) GetOracleVotes(day uint64) (*OracleDayVote, error) {
+	if day != 20000 {
+		return nil, nil
+	}
+
+	return &OracleDayVote{
+		Day:      day,
+		Status:   "pending",
+		Price:    big.NewInt(1000),
+		Attempts: 2,
+		Votes: []*OracleVote{
+			{
+				Validator:   types.StringToAddress("2"),
+				Price:       big.NewInt(1001),
+				BlockNumber: 5,
+			},
+		},
+	}, nil
+}
+
+func (m *mockStore) GetOraclePendingVote() (*OracleDayVote, error) {
+	return nil, nil
+}
+
+func (m *mockStore) GetFinality(header *types.Header) (*Finality, error) {
+	if header.Number == 0 {
+		return &Finality{}, nil
+	}
+
+	return &Finality{
+		Bitmap:              []byte{0x5},
+		AggregatedSignature: []byte{0x1, 0x2},
+		Signers:             []types.Address{types.StringToAddress("1"), types.StringToAddress("3")},
+	}, nil
+}
+
+func (m *mockStore) GetStakingValidator(header *types.Header, addr types.Address) (*StakingValidator, error) {
+	if addr != types.StringToAddress("1") {
+		return nil, nil
+	}
+
+	return &StakingValidator{
+		Address:             addr,
+		BlsKey:              []byte{0x1},
+		Stake:               big.NewInt(100),
+		DelegatedStake:      big.NewInt(50),
+		TotalStake:          big.NewInt(150),
+		Commission:          big.NewInt(10),
+		WithdrawableRewards: big.NewInt(3),
+		VotingPower:         big.NewInt(1),
+		Status:              1,
+		IsActive:            true,
+	}, nil
+}
+
+func (m *mockStore) GetEpochValidators(header *types.Header, epoch uint64) ([]*EpochValidator, error) {
+	if epoch == 0 || epoch > header.Number {
+		return nil, fmt.Errorf("invalid epoch %d", epoch)
+	}
+
+	return []*EpochValidator{
+		{Address: types.StringToAddress("1"), BlsKey: []byte{0x1}, VotingPower: big.NewInt(1)},
+		{Address: types.StringToAddress("2"), BlsKey: []byte{0x2}, VotingPower: big.NewInt(2)},
+	}, nil
+}
+
+func (m *mockStore) GetDelegations(header *types.Header, delegator types.Address) ([]*Delegation, error) {
+	return []*Delegation{
+		{Validator: types.StringToAddress("1"), Amount: big.NewInt(20), Reward: big.NewInt(2)},
+		{Validator: types.StringToAddress("2"), Amount: big.NewInt(30), Reward: big.NewInt(0), IsVesting: true, VestingEnd: 100},
+	}, nil
+}
+
+func (m *mockStore) GetPendingRewards(header *types.Header, addr types.Address) (*PendingRewards, error) {
+	return &PendingRewards{
+		Staking:     big.NewInt(5),
+		Commission:  big.NewInt(1),
+		Delegations: []*Delegation{{Validator: types.StringToAddress("1"), Amount: big.NewInt(20), Reward: big.NewInt(2)}},
+	}, nil
+}
+
+func (m *mockStore) GetAPR(header *types.Header) (*APR, error) {
+	return &APR{
+		Base:        big.NewInt(500),
+		Max:         big.NewInt(3000),
+		RSIBonus:    big.NewInt(100),
+		MacroFactor: big.NewInt(7500),
+		Denominator: big.NewInt(10000),
+	}, nil
+}
+
+func (m *mockStore) GetRewardHistory(fromEpoch, toEpoch uint64) ([]*EpochRewards, error) {
+	res := []*EpochRewards{}
+
+	// only the even epochs are indexed
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		if epoch%2 != 0 {
+			continue
+		}
+
+		res = append(res, &EpochRewards{
+			Epoch:      epoch,
+			StartBlock: epoch*10 - 9,
+			EndBlock:   epoch * 10,
+			Uptime: []*ValidatorUptime{
+				{Validator: types.StringToAddress("1"), SignedBlocks: 10},
+				{Validator: types.StringToAddress("2"), SignedBlocks: 9},
+			},
+			StakingRewards: []*AccountReward{
+				{Account: types.StringToAddress("1"), Amount: big.NewInt(100)},
+				{Account: types.StringToAddress("2"), Amount: big.NewInt(90)},
+			},
+			DelegatorRewards:  []*AccountReward{{Account: types.StringToAddress("1"), Amount: big.NewInt(10)}},
+			RewardWalletFund:  big.NewInt(0),
+			VaultFunded:       big.NewInt(5),
+			DAOIncentive:      big.NewInt(0),
+			FeeHandlerBalance: big.NewInt(50),
+			FeeHandlerIncome:  big.NewInt(20),
+		})
+	}
+
+	return res, nil
+}
+
+func (m *mockStore) GetValidatorHealth() (*ValidatorHealthReport, error) {
+	return &ValidatorHealthReport{
+		BlockNumber: 5,
+		Validators: []*ValidatorHealth{
+			{Address: types.StringToAddress("1"), SignedBlocks: 5, LastSignedBlock: 5, ProposedBlocks: 3},
+			{Address: types.StringToAddress("2"), SignedBlocks: 2, MissedBlocks: 3, ConsecutiveMissedBlocks: 3,
+				LastSignedBlock: 2, ProposedBlocks: 2, MissedProposals: 1, DoubleSigns: 1},
+		},
+		DoubleSigns: []*DoubleSignEvidence{
+			{
+				Validator:      types.StringToAddress("2"),
+				Height:         4,
+				Round:          0,
+				ProposalHashes: []types.Hash{types.StringToHash("1"), types.StringToHash("2")},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,176 @@
+package jsonrpc
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// OracleVote is a price vote of a validator observed on chain
+type OracleVote struct {
+	Validator   types.Address
+	Price       *big.Int
+	BlockNumber uint64
+	TxHash      types.Hash
+}
+
+// OracleDayVote is the price oracle vote state of a single day
+type OracleDayVote struct {
+	Day           uint64
+	Status        string
+	Price         *big.Int
+	TxHash        types.Hash
+	ReceiptStatus *uint64
+	Attempts      uint64
+	LastError     string
+	LastAttempt   uint64
+	NextAttempt   uint64
+	Votes         []*OracleVote
+}
+
+// OracleStatus is the current state of the price oracle of the node
+type OracleStatus struct {
+	Validator         types.Address
+	IsValidator       bool
+	BlockNumber       uint64
+	Day               uint64
+	VotingWindowStart uint64
+	VotingWindowEnd   uint64
+	InVotingWindow    bool
+	Today             *OracleDayVote
+	LastVote          *OracleDayVote
+}
+
+// oracleStore interface provides access to the methods needed by oracle endpoint
+type oracleStore interface {
+	// GetOracleStatus returns the current state of the price oracle
+	GetOracleStatus() (*OracleStatus, error)
+	// GetOracleVotes returns the vote state of the given day (nil if there is none)
+	GetOracleVotes(day uint64) (*OracleDayVote, error)
+	// GetOraclePendingVote returns the vote of the current day waiting to be retried (nil if there is none)
+	GetOraclePendingVote() (*OracleDayVote, error)
+}
+
+// Oracle is the price oracle jsonrpc endpoint
+type Oracle struct {
+	store oracleStore
+}
+
+type oracleVote struct {
+	Validator   types.Address `json:"validator"`
+	Price       *argBig       `json:"price"`
+	BlockNumber argUint64     `json:"blockNumber"`
+	TxHash      types.Hash    `json:"transactionHash"`
+}
+
+type oracleDayVote struct {
+	Day           argUint64     `json:"day"`
+	Status        string        `json:"status"`
+	Price         *argBig       `json:"price"`
+	TxHash        *types.Hash   `json:"transactionHash"`
+	ReceiptStatus *argUint64    `json:"receiptStatus"`
+	Attempts      argUint64     `json:"attempts"`
+	LastError     string        `json:"lastError,omitempty"`
+	LastAttempt   argUint64     `json:"lastAttempt"`
+	NextAttempt   *argUint64    `json:"nextAttempt"`
+	Votes         []*oracleVote `json:"votes"`
+}
+
+type oracleStatus struct {
+	Validator         types.Address  `json:"validator"`
+	IsValidator       bool           `json:"isValidator"`
+	BlockNumber       argUint64      `json:"blockNumber"`
+	Day               argUint64      `json:"day"`
+	VotingWindowStart argUint64      `json:"votingWindowStart"`
+	VotingWindowEnd   argUint64      `json:"votingWindowEnd"`
+	InVotingWindow    bool           `json:"inVotingWindow"`
+	Today             *oracleDayVote `json:"today"`
+	LastVote          *oracleDayVote `json:"lastVote"`
+}
+
+func toOracleDayVote(v *OracleDayVote) *oracleDayVote {
+	if v == nil {
+		return nil
+	}
+
+	res := &oracleDayVote{
+		Day:         argUint64(v.Day),
+		Status:      v.Status,
+		Attempts:    argUint64(v.Attempts),
+		LastError:   v.LastError,
+		LastAttempt: argUint64(v.LastAttempt),
+		Votes:       make([]*oracleVote, len(v.Votes)),
+	}
+
+	if v.Price != nil {
+		res.Price = argBigPtr(v.Price)
+	}
+
+	if v.TxHash != types.ZeroHash {
+		txHash := v.TxHash
+		res.TxHash = &txHash
+	}
+
+	if v.ReceiptStatus != nil {
+		res.ReceiptStatus = argUintPtr(*v.ReceiptStatus)
+	}
+
+	if v.NextAttempt != 0 {
+		res.NextAttempt = argUintPtr(v.NextAttempt)
+	}
+
+	for i, vote := range v.Votes {
+		res.Votes[i] = &oracleVote{
+			Validator:   vote.Validator,
+			BlockNumber: argUint64(vote.BlockNumber),
+			TxHash:      vote.TxHash,
+		}
+
+		if vote.Price != nil {
+			res.Votes[i].Price = argBigPtr(vote.Price)
+		}
+	}
+
+	return res
+}
+
+// Status returns the current state of the price oracle of the node
+func (o *Oracle) Status() (interface{}, error) {
+	status, err := o.store.GetOracleStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &oracleStatus{
+		Validator:         status.Validator,
+		IsValidator:       status.IsValidator,
+		BlockNumber:       argUint64(status.BlockNumber),
+		Day:               argUint64(status.Day),
+		VotingWindowStart: argUint64(status.VotingWindowStart),
+		VotingWindowEnd:   argUint64(status.VotingWindowEnd),
+		InVotingWindow:    status.InVotingWindow,
+		Today:             toOracleDayVote(status.Today),
+		LastVote:          toOracleDayVote(status.LastVote),
+	}, nil
+}
+
+// Votes returns the vote state of the given day,
+// including the PriceVoted events seen from other validators
+func (o *Oracle) Votes(day argUint64) (interface{}, error) {
+	vote, err := o.store.GetOracleVotes(uint64(day))
+	if err != nil {
+		return nil, err
+	}
+
+	return toOracleDayVote(vote), nil
+}
+
+// PendingVote returns the vote of the current day which failed and is waiting to be retried
+func (o *Oracle) PendingVote() (interface{}, error) {
+	vote, err := o.store.GetOraclePendingVote()
+	if err != nil {
+		return nil, err
+	}
+
+	return toOracleDayVote(vote), nil
+}
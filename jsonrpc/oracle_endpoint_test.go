@@ -0,0 +1,68 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleEndpoint(t *testing.T) {
+	store := newMockStore()
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		store,
+		&dispatcherParams{
+			chainID:                 0,
+			priceLimit:              0,
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+		},
+	)
+
+	handle := func(msg string) json.RawMessage {
+		t.Helper()
+
+		data, err := dispatcher.Handle([]byte(msg))
+		require.NoError(t, err)
+
+		resp := new(SuccessResponse)
+		require.NoError(t, json.Unmarshal(data, resp))
+		require.Nil(t, resp.Error)
+
+		return resp.Result
+	}
+
+	t.Run("oracle_status", func(t *testing.T) {
+		var status oracleStatus
+
+		require.NoError(t, json.Unmarshal(handle(`{"method": "oracle_status", "params": [], "id": 1}`), &status))
+		require.True(t, status.IsValidator)
+		require.Equal(t, types.StringToAddress("1"), status.Validator)
+		require.Equal(t, argUint64(20000), status.Day)
+		require.Equal(t, "confirmed", status.Today.Status)
+		require.Nil(t, status.LastVote)
+	})
+
+	t.Run("oracle_votes", func(t *testing.T) {
+		var vote oracleDayVote
+
+		require.NoError(t, json.Unmarshal(handle(`{"method": "oracle_votes", "params": ["0x4e20"], "id": 1}`), &vote))
+		require.Equal(t, "pending", vote.Status)
+		require.Equal(t, argUint64(2), vote.Attempts)
+		require.Equal(t, big.NewInt(1000), (*big.Int)(vote.Price))
+		require.Nil(t, vote.TxHash)
+		require.Len(t, vote.Votes, 1)
+		require.Equal(t, types.StringToAddress("2"), vote.Votes[0].Validator)
+
+		require.Equal(t, "null", string(handle(`{"method": "oracle_votes", "params": ["0x1"], "id": 1}`)))
+	})
+
+	t.Run("oracle_pendingVote", func(t *testing.T) {
+		require.Equal(t, "null", string(handle(`{"method": "oracle_pendingVote", "params": [], "id": 1}`)))
+	})
+}
@@ -0,0 +1,506 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	traceTypeCall   = "call"
+	traceTypeCreate = "create"
+
+	// replayTraceType is the only trace type supported by trace_replayBlockTransactions
+	replayTraceType = "trace"
+)
+
+var (
+	// ErrUnsupportedTraceType is returned when the replay is requested with a trace type other than "trace"
+	ErrUnsupportedTraceType = errors.New("unsupported trace type")
+
+	// parityErrors maps the execution errors to the error messages of the Parity traces
+	parityErrors = map[string]string{
+		runtime.ErrExecutionReverted.Error(): "Reverted",
+		runtime.ErrOutOfGas.Error():          "Out of gas",
+		runtime.ErrCodeStoreOutOfGas.Error(): "Out of gas",
+		runtime.ErrDepth.Error():             "Out of stack",
+	}
+)
+
+type traceStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// ReadTxLookup returns a block hash in which a given txn was mined
+	ReadTxLookup(txnHash types.Hash) (types.Hash, bool)
+
+	// GetBlockByHash gets a block using the provided hash
+	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
+
+	// GetBlockByNumber gets a block using the provided height
+	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// TraceBlock traces all transactions in the given block
+	TraceBlock(*types.Block, tracer.Tracer) ([]interface{}, error)
+
+	// TraceTxn traces a transaction in the block, associated with the given hash
+	TraceTxn(*types.Block, types.Hash, tracer.Tracer) (interface{}, error)
+}
+
+// Trace is the trace jsonrpc endpoint, which returns the call traces
+// of the transactions in the Parity (OpenEthereum) format
+type Trace struct {
+	store           traceStore
+	throttling      *Throttling
+	blockRangeLimit uint64
+}
+
+func NewTrace(store traceStore, requestsPerSecond uint64, blockRangeLimit uint64) *Trace {
+	return &Trace{
+		store:           store,
+		throttling:      NewThrottling(requestsPerSecond, time.Second),
+		blockRangeLimit: blockRangeLimit,
+	}
+}
+
+type parityAction struct {
+	CallType string `json:"callType,omitempty"`
+	From     string `json:"from"`
+	To       string `json:"to,omitempty"`
+	Gas      string `json:"gas"`
+	Input    string `json:"input,omitempty"`
+	Init     string `json:"init,omitempty"`
+	Value    string `json:"value"`
+}
+
+type parityResult struct {
+	GasUsed string `json:"gasUsed"`
+	Output  string `json:"output,omitempty"`
+	Address string `json:"address,omitempty"`
+	Code    string `json:"code,omitempty"`
+}
+
+type parityTrace struct {
+	Action              *parityAction `json:"action"`
+	BlockHash           *types.Hash   `json:"blockHash,omitempty"`
+	BlockNumber         *uint64       `json:"blockNumber,omitempty"`
+	Error               string        `json:"error,omitempty"`
+	Result              *parityResult `json:"result,omitempty"`
+	Subtraces           int           `json:"subtraces"`
+	TraceAddress        []int         `json:"traceAddress"`
+	TransactionHash     *types.Hash   `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64       `json:"transactionPosition,omitempty"`
+	Type                string        `json:"type"`
+}
+
+// from returns the address of the caller
+func (t *parityTrace) from() types.Address {
+	return types.StringToAddress(t.Action.From)
+}
+
+// to returns the address of the callee, or the address of the created contract
+func (t *parityTrace) to() types.Address {
+	if t.Type == traceTypeCreate {
+		if t.Result == nil {
+			return types.ZeroAddress
+		}
+
+		return types.StringToAddress(t.Result.Address)
+	}
+
+	return types.StringToAddress(t.Action.To)
+}
+
+type replayedTransaction struct {
+	Output          string         `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*parityTrace `json:"trace"`
+	TransactionHash types.Hash     `json:"transactionHash"`
+	VMTrace         interface{}    `json:"vmTrace"`
+}
+
+// TraceFilter is the filter of trace_filter
+type TraceFilter struct {
+	FromBlock   *BlockNumber    `json:"fromBlock"`
+	ToBlock     *BlockNumber    `json:"toBlock"`
+	FromAddress []types.Address `json:"fromAddress"`
+	ToAddress   []types.Address `json:"toAddress"`
+	After       *uint64         `json:"after"`
+	Count       *uint64         `json:"count"`
+}
+
+// matches returns true if the caller is one of the from addresses and the callee
+// is one of the to addresses. The empty address list matches any address
+func (f *TraceFilter) matches(trace *parityTrace) bool {
+	return matchesAddress(f.FromAddress, trace.from()) && matchesAddress(f.ToAddress, trace.to())
+}
+
+func matchesAddress(addresses []types.Address, addr types.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+
+	for _, a := range addresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Block returns the traces of all the transactions in the block
+func (t *Trace) Block(number BlockNumber) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			block, err := t.getBlock(number)
+			if err != nil {
+				return nil, err
+			}
+
+			return t.traceBlock(block)
+		},
+	)
+}
+
+// Transaction returns the traces of the transaction
+func (t *Trace) Transaction(txHash types.Hash) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			tx, block := GetTxAndBlockByTxHash(txHash, t.store)
+			if tx == nil {
+				return nil, fmt.Errorf("tx %s not found", txHash.String())
+			}
+
+			if block.Number() == 0 {
+				return nil, ErrTraceGenesisBlock
+			}
+
+			callTracer := &calltracer.CallTracer{WithErrors: true}
+
+			cancel := cancelOnTimeout(callTracer, defaultTraceTimeout)
+			defer cancel()
+
+			result, err := t.store.TraceTxn(block, tx.Hash, callTracer)
+			if err != nil {
+				return nil, err
+			}
+
+			call, err := toCall(result)
+			if err != nil {
+				return nil, err
+			}
+
+			for idx, blockTx := range block.Transactions {
+				if blockTx.Hash == tx.Hash {
+					return withTxInfo(flattenCall(call, []int{}, nil), block, idx), nil
+				}
+			}
+
+			return nil, fmt.Errorf("tx %s not found", txHash.String())
+		},
+	)
+}
+
+// ReplayBlockTransactions replays all the transactions in the block and returns their traces.
+// Only the "trace" trace type is supported
+func (t *Trace) ReplayBlockTransactions(number BlockNumber, traceTypes []string) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			withTrace := false
+
+			for _, traceType := range traceTypes {
+				if traceType != replayTraceType {
+					return nil, fmt.Errorf("%w: %s", ErrUnsupportedTraceType, traceType)
+				}
+
+				withTrace = true
+			}
+
+			block, err := t.getBlock(number)
+			if err != nil {
+				return nil, err
+			}
+
+			calls, err := t.traceBlockCalls(block)
+			if err != nil {
+				return nil, err
+			}
+
+			replayed := make([]*replayedTransaction, len(calls))
+
+			for idx, call := range calls {
+				replayed[idx] = &replayedTransaction{
+					TransactionHash: block.Transactions[idx].Hash,
+				}
+
+				if call == nil {
+					continue
+				}
+
+				replayed[idx].Output = call.Output
+
+				if withTrace {
+					replayed[idx].Trace = flattenCall(call, []int{}, nil)
+				}
+			}
+
+			return replayed, nil
+		},
+	)
+}
+
+// Filter returns the traces of the block range matching the from and to addresses
+func (t *Trace) Filter(filter TraceFilter) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			from, to, err := t.getBlockRange(filter.FromBlock, filter.ToBlock)
+			if err != nil {
+				return nil, err
+			}
+
+			var (
+				traces  = []*parityTrace{}
+				skipped = uint64(0)
+			)
+
+			for n := from; n <= to; n++ {
+				block, ok := t.store.GetBlockByNumber(n, true)
+				if !ok {
+					return nil, fmt.Errorf("block %d not found", n)
+				}
+
+				blockTraces, err := t.traceBlock(block)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, trace := range blockTraces {
+					if !filter.matches(trace) {
+						continue
+					}
+
+					if filter.After != nil && skipped < *filter.After {
+						skipped++
+
+						continue
+					}
+
+					traces = append(traces, trace)
+
+					if filter.Count != nil && uint64(len(traces)) >= *filter.Count {
+						return traces, nil
+					}
+				}
+			}
+
+			return traces, nil
+		},
+	)
+}
+
+// getBlock returns the block with the transactions by number
+func (t *Trace) getBlock(number BlockNumber) (*types.Block, error) {
+	num, err := GetNumericBlockNumber(number, t.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := t.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", num)
+	}
+
+	return block, nil
+}
+
+// getBlockRange returns the numbers of the filter's block range, the latest block by default
+func (t *Trace) getBlockRange(fromBlock, toBlock *BlockNumber) (uint64, uint64, error) {
+	from, to := LatestBlockNumber, LatestBlockNumber
+
+	if fromBlock != nil {
+		from = *fromBlock
+	}
+
+	if toBlock != nil {
+		to = *toBlock
+	}
+
+	fromNum, err := GetNumericBlockNumber(from, t.store)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toNum, err := GetNumericBlockNumber(to, t.store)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if toNum < fromNum {
+		return 0, 0, ErrIncorrectBlockRange
+	}
+
+	// if not disabled, avoid handling large block ranges
+	if t.blockRangeLimit != 0 && toNum-fromNum > t.blockRangeLimit {
+		return 0, 0, ErrBlockRangeTooHigh
+	}
+
+	return fromNum, toNum, nil
+}
+
+// traceBlock returns the flattened traces of all the transactions in the block
+func (t *Trace) traceBlock(block *types.Block) ([]*parityTrace, error) {
+	calls, err := t.traceBlockCalls(block)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*parityTrace{}
+
+	for idx, call := range calls {
+		if call == nil {
+			continue
+		}
+
+		traces = append(traces, withTxInfo(flattenCall(call, []int{}, nil), block, idx)...)
+	}
+
+	return traces, nil
+}
+
+// traceBlockCalls returns the call trees of the transactions in the block
+func (t *Trace) traceBlockCalls(block *types.Block) ([]*calltracer.Call, error) {
+	// the genesis block doesn't have any transactions to trace
+	if block.Number() == 0 || len(block.Transactions) == 0 {
+		return nil, nil
+	}
+
+	callTracer := &calltracer.CallTracer{WithErrors: true}
+
+	cancel := cancelOnTimeout(callTracer, defaultTraceTimeout)
+	defer cancel()
+
+	results, err := t.store.TraceBlock(block, callTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	calls := make([]*calltracer.Call, len(results))
+
+	for idx, result := range results {
+		if calls[idx], err = toCall(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return calls, nil
+}
+
+func toCall(result interface{}) (*calltracer.Call, error) {
+	call, ok := result.(*calltracer.Call)
+	if !ok {
+		return nil, fmt.Errorf("unexpected trace result type %T", result)
+	}
+
+	return call, nil
+}
+
+// flattenCall appends the call and its sub calls to the traces in the depth-first order.
+// The trace address of a call is the path of the indexes of its sub calls from the top call
+func flattenCall(call *calltracer.Call, traceAddress []int, traces []*parityTrace) []*parityTrace {
+	if call == nil {
+		return traces
+	}
+
+	trace := &parityTrace{
+		Subtraces:    len(call.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	var result *parityResult
+
+	switch call.Type {
+	case "CREATE", "CREATE2":
+		trace.Type = traceTypeCreate
+		trace.Action = &parityAction{
+			From:  call.From,
+			Gas:   call.Gas,
+			Init:  call.Input,
+			Value: call.Value,
+		}
+		result = &parityResult{
+			GasUsed: call.GasUsed,
+			Address: call.To,
+			Code:    call.Output,
+		}
+	default:
+		trace.Type = traceTypeCall
+		trace.Action = &parityAction{
+			CallType: strings.ToLower(call.Type),
+			From:     call.From,
+			To:       call.To,
+			Gas:      call.Gas,
+			Input:    call.Input,
+			Value:    call.Value,
+		}
+		result = &parityResult{
+			GasUsed: call.GasUsed,
+			Output:  call.Output,
+		}
+	}
+
+	if call.Error != "" {
+		trace.Error = toParityError(call.Error)
+	} else {
+		trace.Result = result
+	}
+
+	traces = append(traces, trace)
+
+	for idx, subCall := range call.Calls {
+		subAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+
+		traces = flattenCall(subCall, append(subAddress, idx), traces)
+	}
+
+	return traces
+}
+
+// withTxInfo sets the block and the transaction of the traces
+func withTxInfo(traces []*parityTrace, block *types.Block, txIndex int) []*parityTrace {
+	var (
+		blockHash   = block.Hash()
+		blockNumber = block.Number()
+		txHash      = block.Transactions[txIndex].Hash
+		txPosition  = uint64(txIndex)
+	)
+
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txPosition
+	}
+
+	return traces
+}
+
+func toParityError(err string) string {
+	if parityErr, ok := parityErrors[err]; ok {
+		return parityErr
+	}
+
+	return err
+}
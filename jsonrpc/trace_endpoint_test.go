@@ -0,0 +1,370 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	traceAddr1 = types.StringToAddress("0x1")
+	traceAddr2 = types.StringToAddress("0x2")
+	traceAddr3 = types.StringToAddress("0x3")
+	traceAddr4 = types.StringToAddress("0x4")
+)
+
+// testTraceCall is the call tree of a transaction from addr1 to addr2, which calls addr3,
+// creates a contract at addr4 and fails to call addr1
+func testTraceCall() *calltracer.Call {
+	return &calltracer.Call{
+		Type:    "CALL",
+		From:    traceAddr1.String(),
+		To:      traceAddr2.String(),
+		Value:   "0x1",
+		Gas:     "0x1000",
+		GasUsed: "0x500",
+		Input:   "0x12345678",
+		Output:  "0x",
+		Calls: []*calltracer.Call{
+			{
+				Type:    "STATICCALL",
+				From:    traceAddr2.String(),
+				To:      traceAddr3.String(),
+				Value:   "0x0",
+				Gas:     "0x800",
+				GasUsed: "0x100",
+				Input:   "0x",
+				Output:  "0x01",
+			},
+			{
+				Type:    "CREATE",
+				From:    traceAddr2.String(),
+				To:      traceAddr4.String(),
+				Value:   "0x0",
+				Gas:     "0x600",
+				GasUsed: "0x200",
+				Input:   "0x6080",
+				Output:  "0x60",
+				Calls: []*calltracer.Call{
+					{
+						Type:    "CALL",
+						From:    traceAddr4.String(),
+						To:      traceAddr1.String(),
+						Value:   "0x0",
+						Gas:     "0x100",
+						GasUsed: "0x100",
+						Input:   "0x",
+						Output:  "0x",
+						Error:   "execution reverted",
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTraceTestStore(blocks ...*types.Block) *debugEndpointMockStore {
+	return &debugEndpointMockStore{
+		headerFn: func() *types.Header {
+			return blocks[len(blocks)-1].Header
+		},
+		getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+			for _, block := range blocks {
+				if block.Number() == num {
+					return block, true
+				}
+			}
+
+			return nil, false
+		},
+		getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
+			for _, block := range blocks {
+				if block.Hash() == hash {
+					return block, true
+				}
+			}
+
+			return nil, false
+		},
+		readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
+			for _, block := range blocks {
+				for _, tx := range block.Transactions {
+					if tx.Hash == hash {
+						return block.Hash(), true
+					}
+				}
+			}
+
+			return types.ZeroHash, false
+		},
+		traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
+			results := make([]interface{}, len(block.Transactions))
+			for i := range block.Transactions {
+				results[i] = testTraceCall()
+			}
+
+			return results, nil
+		},
+		traceTxnFn: func(block *types.Block, hash types.Hash, tracer tracer.Tracer) (interface{}, error) {
+			return testTraceCall(), nil
+		},
+	}
+}
+
+func newTraceTestBlock(number uint64, txHashes ...types.Hash) *types.Block {
+	block := &types.Block{
+		Header: &types.Header{Number: number},
+	}
+
+	for _, hash := range txHashes {
+		block.Transactions = append(block.Transactions, &types.Transaction{Hash: hash})
+	}
+
+	block.Header.ComputeHash()
+
+	return block
+}
+
+func TestTrace_Transaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := types.StringToHash("0x10")
+	block := newTraceTestBlock(1, types.StringToHash("0x9"), txHash)
+
+	endpoint := NewTrace(newTraceTestStore(newTraceTestBlock(0), block), 10, 100)
+
+	res, err := endpoint.Transaction(txHash)
+	require.NoError(t, err)
+
+	traces, ok := res.([]*parityTrace)
+	require.True(t, ok)
+	require.Len(t, traces, 4)
+
+	blockHash, blockNumber, txPosition := block.Hash(), uint64(1), uint64(1)
+
+	assert.Equal(t, &parityTrace{
+		Action: &parityAction{
+			CallType: "call",
+			From:     traceAddr1.String(),
+			To:       traceAddr2.String(),
+			Gas:      "0x1000",
+			Input:    "0x12345678",
+			Value:    "0x1",
+		},
+		BlockHash:           &blockHash,
+		BlockNumber:         &blockNumber,
+		Result:              &parityResult{GasUsed: "0x500", Output: "0x"},
+		Subtraces:           2,
+		TraceAddress:        []int{},
+		TransactionHash:     &txHash,
+		TransactionPosition: &txPosition,
+		Type:                "call",
+	}, traces[0])
+
+	assert.Equal(t, "staticcall", traces[1].Action.CallType)
+	assert.Equal(t, []int{0}, traces[1].TraceAddress)
+
+	// the creation
+	assert.Equal(t, "create", traces[2].Type)
+	assert.Equal(t, []int{1}, traces[2].TraceAddress)
+	assert.Equal(t, &parityAction{From: traceAddr2.String(), Gas: "0x600", Init: "0x6080", Value: "0x0"}, traces[2].Action)
+	assert.Equal(t, &parityResult{GasUsed: "0x200", Address: traceAddr4.String(), Code: "0x60"}, traces[2].Result)
+
+	// the failed call has no result
+	assert.Equal(t, []int{1, 0}, traces[3].TraceAddress)
+	assert.Equal(t, "Reverted", traces[3].Error)
+	assert.Nil(t, traces[3].Result)
+
+	_, err = endpoint.Transaction(types.StringToHash("0x11"))
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestTrace_Block(t *testing.T) {
+	t.Parallel()
+
+	block := newTraceTestBlock(1, types.StringToHash("0x9"), types.StringToHash("0x10"))
+	endpoint := NewTrace(newTraceTestStore(newTraceTestBlock(0), block), 10, 100)
+
+	res, err := endpoint.Block(LatestBlockNumber)
+	require.NoError(t, err)
+
+	traces, ok := res.([]*parityTrace)
+	require.True(t, ok)
+	require.Len(t, traces, 8)
+	assert.Equal(t, uint64(0), *traces[0].TransactionPosition)
+	assert.Equal(t, uint64(1), *traces[4].TransactionPosition)
+
+	// the genesis block has no traces
+	res, err = endpoint.Block(EarliestBlockNumber)
+	require.NoError(t, err)
+	assert.Empty(t, res)
+
+	_, err = endpoint.Block(BlockNumber(2))
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestTrace_ReplayBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	txHash := types.StringToHash("0x10")
+	endpoint := NewTrace(newTraceTestStore(newTraceTestBlock(0), newTraceTestBlock(1, txHash)), 10, 100)
+
+	res, err := endpoint.ReplayBlockTransactions(BlockNumber(1), []string{"trace"})
+	require.NoError(t, err)
+
+	replayed, ok := res.([]*replayedTransaction)
+	require.True(t, ok)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, txHash, replayed[0].TransactionHash)
+	assert.Equal(t, "0x", replayed[0].Output)
+	require.Len(t, replayed[0].Trace, 4)
+	// the replayed traces don't include the block and the transaction
+	assert.Nil(t, replayed[0].Trace[0].BlockHash)
+
+	_, err = endpoint.ReplayBlockTransactions(BlockNumber(1), []string{"vmTrace"})
+	assert.ErrorIs(t, err, ErrUnsupportedTraceType)
+}
+
+func TestTrace_Filter(t *testing.T) {
+	t.Parallel()
+
+	blocks := []*types.Block{
+		newTraceTestBlock(0),
+		newTraceTestBlock(1, types.StringToHash("0x9")),
+		newTraceTestBlock(2),
+		newTraceTestBlock(3, types.StringToHash("0x10")),
+	}
+
+	endpoint := NewTrace(newTraceTestStore(blocks...), 10, 2)
+
+	blockNumber := func(n int64) *BlockNumber {
+		num := BlockNumber(n)
+
+		return &num
+	}
+
+	count := func(n uint64) *uint64 {
+		return &n
+	}
+
+	tests := []struct {
+		name     string
+		filter   TraceFilter
+		expected []types.Hash
+		err      error
+	}{
+		{
+			name:   "all traces of the range",
+			filter: TraceFilter{FromBlock: blockNumber(1), ToBlock: blockNumber(3)},
+			expected: []types.Hash{
+				types.StringToHash("0x9"), types.StringToHash("0x9"), types.StringToHash("0x9"), types.StringToHash("0x9"),
+				types.StringToHash("0x10"), types.StringToHash("0x10"), types.StringToHash("0x10"), types.StringToHash("0x10"),
+			},
+		},
+		{
+			// only the creation matches
+			name: "from and to addresses",
+			filter: TraceFilter{
+				FromBlock:   blockNumber(1),
+				ToBlock:     blockNumber(3),
+				FromAddress: []types.Address{traceAddr2},
+				ToAddress:   []types.Address{traceAddr4},
+			},
+			expected: []types.Hash{types.StringToHash("0x9"), types.StringToHash("0x10")},
+		},
+		{
+			name: "paginated",
+			filter: TraceFilter{
+				FromBlock:   blockNumber(1),
+				ToBlock:     blockNumber(3),
+				FromAddress: []types.Address{traceAddr2},
+				After:       count(1),
+				Count:       count(2),
+			},
+			expected: []types.Hash{types.StringToHash("0x9"), types.StringToHash("0x10")},
+		},
+		{
+			name:     "latest block by default",
+			filter:   TraceFilter{ToAddress: []types.Address{traceAddr3}},
+			expected: []types.Hash{types.StringToHash("0x10")},
+		},
+		{
+			name:   "too large range",
+			filter: TraceFilter{FromBlock: blockNumber(0), ToBlock: blockNumber(3)},
+			err:    ErrBlockRangeTooHigh,
+		},
+		{
+			name:   "incorrect range",
+			filter: TraceFilter{FromBlock: blockNumber(3), ToBlock: blockNumber(1)},
+			err:    ErrIncorrectBlockRange,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := endpoint.Filter(tt.filter)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			traces, ok := res.([]*parityTrace)
+			require.True(t, ok)
+
+			txHashes := []types.Hash{}
+			for _, trace := range traces {
+				txHashes = append(txHashes, *trace.TransactionHash)
+			}
+
+			assert.Equal(t, tt.expected, txHashes)
+		})
+	}
+}
+
+func TestTrace_ErrorFromStore(t *testing.T) {
+	t.Parallel()
+
+	storeErr := errors.New("trace failed")
+
+	store := newTraceTestStore(newTraceTestBlock(0), newTraceTestBlock(1, types.StringToHash("0x9")))
+	store.traceBlockFn = func(*types.Block, tracer.Tracer) ([]interface{}, error) {
+		return nil, storeErr
+	}
+
+	_, err := NewTrace(store, 10, 100).Block(LatestBlockNumber)
+	assert.ErrorIs(t, err, storeErr)
+}
+
+func TestTraceFilterDecode(t *testing.T) {
+	t.Parallel()
+
+	var filter TraceFilter
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fromBlock": "0x1",
+		"toBlock": "latest",
+		"fromAddress": ["0x0000000000000000000000000000000000000001"],
+		"after": 10,
+		"count": 5
+	}`), &filter))
+
+	assert.Equal(t, BlockNumber(1), *filter.FromBlock)
+	assert.Equal(t, LatestBlockNumber, *filter.ToBlock)
+	assert.Equal(t, []types.Address{traceAddr1}, filter.FromAddress)
+	assert.Equal(t, uint64(10), *filter.After)
+	assert.Equal(t, uint64(5), *filter.Count)
+}
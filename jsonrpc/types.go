@@ -18,24 +18,25 @@ type transactionOrHash interface {
 }
 
 type transaction struct {
-	Nonce       argUint64      `json:"nonce"`
-	GasPrice    *argBig        `json:"gasPrice,omitempty"`
-	GasTipCap   *argBig        `json:"maxPriorityFeePerGas,omitempty"`
-	GasFeeCap   *argBig        `json:"maxFeePerGas,omitempty"`
-	Gas         argUint64      `json:"gas"`
-	To          *types.Address `json:"to"`
-	Value       argBig         `json:"value"`
-	Input       argBytes       `json:"input"`
-	V           argBig         `json:"v"`
-	R           argBig         `json:"r"`
-	S           argBig         `json:"s"`
-	Hash        types.Hash     `json:"hash"`
-	From        types.Address  `json:"from"`
-	BlockHash   *types.Hash    `json:"blockHash"`
-	BlockNumber *argUint64     `json:"blockNumber"`
-	TxIndex     *argUint64     `json:"transactionIndex"`
-	ChainID     *argBig        `json:"chainId,omitempty"`
-	Type        argUint64      `json:"type"`
+	Nonce       argUint64           `json:"nonce"`
+	GasPrice    *argBig             `json:"gasPrice,omitempty"`
+	GasTipCap   *argBig             `json:"maxPriorityFeePerGas,omitempty"`
+	GasFeeCap   *argBig             `json:"maxFeePerGas,omitempty"`
+	Gas         argUint64           `json:"gas"`
+	To          *types.Address      `json:"to"`
+	Value       argBig              `json:"value"`
+	Input       argBytes            `json:"input"`
+	V           argBig              `json:"v"`
+	R           argBig              `json:"r"`
+	S           argBig              `json:"s"`
+	Hash        types.Hash          `json:"hash"`
+	From        types.Address       `json:"from"`
+	BlockHash   *types.Hash         `json:"blockHash"`
+	BlockNumber *argUint64          `json:"blockNumber"`
+	TxIndex     *argUint64          `json:"transactionIndex"`
+	ChainID     *argBig             `json:"chainId,omitempty"`
+	Type        argUint64           `json:"type"`
+	AccessList  *types.TxAccessList `json:"accessList,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -95,6 +96,15 @@ func toTransaction(
 		res.ChainID = &chainID
 	}
 
+	if t.Type == types.AccessListTx || t.Type == types.DynamicFeeTx {
+		accessList := t.AccessList
+		if accessList == nil {
+			accessList = types.TxAccessList{}
+		}
+
+		res.AccessList = &accessList
+	}
+
 	if txIndex != nil {
 		res.TxIndex = argUintPtr(uint64(*txIndex))
 	}
@@ -206,6 +216,8 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	EffectiveGasPrice argBig         `json:"effectiveGasPrice"`
+	Type              argUint64      `json:"type"`
 }
 
 func toReceipt(src *types.Receipt, tx *types.Transaction,
@@ -224,6 +236,8 @@ func toReceipt(src *types.Receipt, tx *types.Transaction,
 		FromAddr:          tx.From,
 		ToAddr:            tx.To,
 		Logs:              logs,
+		EffectiveGasPrice: argBig(*tx.GetGasPrice(header.BaseFee)),
+		Type:              argUint64(tx.Type),
 	}
 }
 
@@ -261,6 +275,31 @@ func toLog(src *types.Log, logIdx, txIdx uint64, header *types.Header, txHash ty
 	}
 }
 
+type storageProof struct {
+	Key   types.Hash `json:"key"`
+	Value argBig     `json:"value"`
+	Proof []argBytes `json:"proof"`
+}
+
+type accountProof struct {
+	Address      types.Address   `json:"address"`
+	AccountProof []argBytes      `json:"accountProof"`
+	Balance      argBig          `json:"balance"`
+	CodeHash     types.Hash      `json:"codeHash"`
+	Nonce        argUint64       `json:"nonce"`
+	StorageHash  types.Hash      `json:"storageHash"`
+	StorageProof []*storageProof `json:"storageProof"`
+}
+
+func toProofNodes(nodes [][]byte) []argBytes {
+	res := make([]argBytes, len(nodes))
+	for i, node := range nodes {
+		res[i] = node
+	}
+
+	return res
+}
+
 type argBig big.Int
 
 func argBigPtr(b *big.Int) *argBig {
@@ -376,17 +415,68 @@ func encodeToHex(b []byte) []byte {
 
 // txnArgs is the transaction argument for the rpc endpoints
 type txnArgs struct {
-	From      *types.Address
-	To        *types.Address
-	Gas       *argUint64
-	GasPrice  *argBytes
-	GasTipCap *argBytes
-	GasFeeCap *argBytes
-	Value     *argBytes
-	Data      *argBytes
-	Input     *argBytes
-	Nonce     *argUint64
-	Type      *argUint64
+	From       *types.Address
+	To         *types.Address
+	Gas        *argUint64
+	GasPrice   *argBytes
+	GasTipCap  *argBytes
+	GasFeeCap  *argBytes
+	Value      *argBytes
+	Data       *argBytes
+	Input      *argBytes
+	Nonce      *argUint64
+	Type       *argUint64
+	AccessList *types.TxAccessList
+}
+
+type accessListResult struct {
+	AccessList types.TxAccessList `json:"accessList"`
+	GasUsed    argUint64          `json:"gasUsed"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// blockOverride overrides the fields of the simulated block
+type blockOverride struct {
+	Number        *argUint64     `json:"number"`
+	Time          *argUint64     `json:"time"`
+	GasLimit      *argUint64     `json:"gasLimit"`
+	FeeRecipient  *types.Address `json:"feeRecipient"`
+	PrevRandao    *types.Hash    `json:"prevRandao"`
+	BaseFeePerGas *argBig        `json:"baseFeePerGas"`
+}
+
+// simulateBlock is the block of the calls simulated by eth_simulateV1
+type simulateBlock struct {
+	BlockOverrides *blockOverride `json:"blockOverrides"`
+	StateOverrides *stateOverride `json:"stateOverrides"`
+	Calls          []*txnArgs     `json:"calls"`
+}
+
+// simulateOpts are the options of eth_simulateV1
+type simulateOpts struct {
+	BlockStateCalls        []*simulateBlock `json:"blockStateCalls"`
+	Validation             bool             `json:"validation"`
+	ReturnFullTransactions bool             `json:"returnFullTransactions"`
+}
+
+type simulateCallError struct {
+	Code    int      `json:"code"`
+	Message string   `json:"message"`
+	Data    argBytes `json:"data,omitempty"`
+}
+
+type simulateCallResult struct {
+	ReturnData argBytes           `json:"returnData"`
+	Logs       []*Log             `json:"logs"`
+	GasUsed    argUint64          `json:"gasUsed"`
+	Status     argUint64          `json:"status"`
+	Error      *simulateCallError `json:"error,omitempty"`
+}
+
+// simulatedBlock is the block built by eth_simulateV1 with the results of its calls
+type simulatedBlock struct {
+	*block
+	Calls []*simulateCallResult `json:"calls"`
 }
 
 type progression struct {
@@ -2,6 +2,7 @@ package network
 
 import (
 	"net"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -20,6 +21,18 @@ type Config struct {
 	MaxOutboundPeers int64                  // the maximum number of outbound peer connections
 	Chain            *chain.Chain           // the reference to the chain configuration
 	SecretsManager   secrets.SecretsManager // the secrets manager used for key storage
+
+	GossipWorkers      int              // the number of workers handling the messages of each gossip topic
+	PeerScore          *PeerScoreConfig // the GossipSub peer scoring parameters (peer scoring is disabled if nil)
+	MaxInvalidMessages uint64           // the number of invalid gossip messages after which the peer is banned (0 disables)
+	PeerBanDuration    time.Duration    // the duration of the peer ban
+
+	StaticPeers  []string // the multiaddrs of the peers which are always redialed, exempt from the outbound limit
+	TrustedPeers []string // the IDs of the peers allowed to connect past the inbound limit
+
+	// PrivateMode hides the node behind its static (sentry) peers: the discovery is disabled,
+	// the node connects only to the static and trusted peers, and its address is not advertised by them
+	PrivateMode bool
 }
 
 func DefaultConfig() *Config {
@@ -36,5 +49,10 @@ func DefaultConfig() *Config {
 		// The default ratio for outbound / inbound connections is 0.25
 		MaxInboundPeers:  32,
 		MaxOutboundPeers: 8,
+		// The gossip messages are validated and handled concurrently
+		GossipWorkers:      DefaultGossipWorkers,
+		PeerScore:          DefaultPeerScoreConfig(),
+		MaxInvalidMessages: DefaultMaxInvalidMessages,
+		PeerBanDuration:    DefaultPeerBanDuration,
 	}
 }
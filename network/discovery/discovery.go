@@ -67,6 +67,9 @@ type networkingServer interface {
 	// GetRandomPeer fetches a random peer from the server's peer store
 	GetRandomPeer() *peer.ID
 
+	// IsPrivatePeer checks if the peer runs in the private mode, so it must not be advertised [Thread safe]
+	IsPrivatePeer(peerID peer.ID) bool
+
 	// TEMPORARY DIALING //
 
 	// FetchOrSetTemporaryDial checks if the peer connection is a temporary dial,
@@ -137,6 +140,11 @@ func (d *DiscoveryService) HandleNetworkEvent(peerEvent *event.PeerEvent) {
 
 	switch peerEvent.Type {
 	case event.PeerConnected:
+		if d.baseServer.IsPrivatePeer(peerID) {
+			// The private peers are not added to the routing table, so they are not advertised
+			return
+		}
+
 		// Add peer to the routing table and to our local peer table
 		_, err := d.routingTable.TryAddPeer(peerID, false, false)
 		if err != nil {
@@ -303,6 +311,11 @@ func (d *DiscoveryService) regularPeerDiscovery() {
 		return
 	}
 
+	if d.baseServer.IsPrivatePeer(*peerID) {
+		// The private peers don't run the discovery
+		return
+	}
+
 	d.logger.Debug("running regular peer discovery", "peer", peerID.String())
 	// Try to discover the peers connected to the reference peer
 	if err := d.attemptToFindPeers(*peerID); err != nil {
@@ -423,6 +436,11 @@ func (d *DiscoveryService) FindPeers(
 			continue
 		}
 
+		if d.baseServer.IsPrivatePeer(id) {
+			// The address of a private peer is never shared
+			continue
+		}
+
 		if info := d.baseServer.GetPeerInfo(id); len(info.Addrs) > 0 {
 			addr, err := common.AddrInfoToString(info)
 			if err != nil {
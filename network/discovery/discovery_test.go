@@ -8,6 +8,8 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/0xPolygon/polygon-edge/network/event"
+	networkGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/network/proto"
 	networkTesting "github.com/0xPolygon/polygon-edge/network/testing"
 	"github.com/hashicorp/go-hclog"
@@ -301,3 +303,60 @@ func TestDiscoveryService_RegularPeerDiscoveryUnconnected(t *testing.T) {
 	// Make sure that no peers were added to the peer store
 	assert.Len(t, peerStore, 0)
 }
+
+// TestDiscoveryService_PrivatePeers makes sure the peers running in the private mode
+// are never added to the routing table, nor shared through the peer discovery
+func TestDiscoveryService_PrivatePeers(t *testing.T) {
+	randomPeers := getRandomPeers(t, 3)
+	privatePeer, publicPeer, requester := randomPeers[0], randomPeers[1], randomPeers[2]
+
+	peerStore := make(map[peer.ID]*peer.AddrInfo)
+	for _, info := range randomPeers {
+		peerStore[info.ID] = info
+	}
+
+	discoveryService, setupErr := newDiscoveryService(
+		func(server *networkTesting.MockNetworkingServer) {
+			server.HookIsPrivatePeer(func(id peer.ID) bool {
+				return id == privatePeer.ID
+			})
+
+			server.HookGetPeerInfo(func(id peer.ID) *peer.AddrInfo {
+				return peerStore[id]
+			})
+		},
+	)
+	if setupErr != nil {
+		t.Fatalf("Unable to setup the discovery service")
+	}
+
+	// The private peer is not added to the routing table once it connects
+	for _, info := range []*peer.AddrInfo{privatePeer, publicPeer} {
+		discoveryService.HandleNetworkEvent(&event.PeerEvent{
+			PeerID: info.ID,
+			Type:   event.PeerConnected,
+		})
+	}
+
+	assert.Equal(t, []peer.ID{publicPeer.ID}, discoveryService.RoutingTablePeers())
+
+	// The private peer is not shared, even if it is in the routing table
+	_, err := discoveryService.routingTable.TryAddPeer(privatePeer.ID, false, false)
+	assert.NoError(t, err)
+
+	resp, err := discoveryService.FindPeers(
+		&networkGrpc.Context{
+			Context: context.Background(),
+			PeerID:  requester.ID,
+		},
+		&proto.FindPeersReq{
+			Count: maxDiscoveryPeerReqCount,
+		},
+	)
+	assert.NoError(t, err)
+
+	publicAddr, err := common.AddrInfoToString(publicPeer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{publicAddr}, resp.Nodes)
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -19,16 +20,34 @@ const (
 	// we should have enough capacity of the queue
 	// because when queue is full, if the consumer does not read fast enough, new messages are dropped
 	subscribeOutputBufferSize = 1024
+
+	// DefaultGossipWorkers is the default number of workers handling the messages of a topic
+	DefaultGossipWorkers = 16
 )
 
+// ErrIgnoreMessage is returned by the topic validator when the message should be dropped
+// without penalizing the peer which delivered it (e.g. the message is outdated)
+var ErrIgnoreMessage = errors.New("gossip message ignored")
+
+// TopicValidator runs cheap checks (e.g. signature and size) on the decoded gossip message,
+// before it is relayed to the other peers and handled. The message is rejected if an error is returned,
+// and the peer which delivered it is penalized, unless the error is ErrIgnoreMessage
+type TopicValidator func(obj proto.Message, from peer.ID) error
+
 type Topic struct {
 	logger hclog.Logger
 
+	ps        *pubsub.PubSub
 	topic     *pubsub.Topic
 	typ       reflect.Type
+	validator TopicValidator
+	workers   int
 	closeCh   chan struct{}
 	closed    atomic.Bool
 	waitGroup sync.WaitGroup
+
+	// reportInvalid records the invalid message delivered by the peer
+	reportInvalid func(peer.ID)
 }
 
 func (t *Topic) createObj() proto.Message {
@@ -51,6 +70,10 @@ func (t *Topic) Close() {
 
 	// if all subscribers are finished, close the topic
 	if t.topic != nil {
+		if err := t.ps.UnregisterTopicValidator(t.topic.String()); err != nil {
+			t.logger.Error("failed to unregister topic validator", "err", err)
+		}
+
 		t.topic.Close()
 		t.topic = nil
 	}
@@ -92,6 +115,29 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 		cancelFn()
 	}()
 
+	// the messages are handled by the fixed number of workers,
+	// when all of them are busy the subscription buffer fills up and the new messages are dropped
+	msgCh := make(chan *pubsub.Message)
+
+	var workersWg sync.WaitGroup
+
+	for i := 0; i < t.workers; i++ {
+		workersWg.Add(1)
+
+		go func() {
+			defer workersWg.Done()
+
+			for msg := range msgCh {
+				t.handleMessage(msg, handler)
+			}
+		}()
+	}
+
+	defer func() {
+		close(msgCh)
+		workersWg.Wait()
+	}()
+
 	for {
 		msg, err := sub.Next(ctx)
 		if err != nil {
@@ -106,35 +152,103 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 			continue
 		}
 
-		go func() {
-			obj := t.createObj()
-			if err := proto.Unmarshal(msg.Data, obj); err != nil {
-				t.logger.Error("failed to unmarshal topic", "err", err)
-				metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+		select {
+		case msgCh <- msg:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// handleMessage passes the message decoded by the topic validator to the handler
+func (t *Topic) handleMessage(msg *pubsub.Message, handler func(obj interface{}, from peer.ID)) {
+	obj, ok := msg.ValidatorData.(proto.Message)
+	if !ok {
+		t.logger.Error("gossip message is not decoded by the topic validator")
 
-				return
+		return
+	}
+
+	metrics.SetGauge([]string{networkMetrics, "ingress_bytes"}, float32(len(msg.Data)))
+
+	handler(obj, msg.GetFrom())
+}
+
+// validate decodes the message and runs the topic validator on it.
+// The decoded message is kept in the message, so it is not decoded again by the handler
+func (t *Topic) validate(_ context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	obj := t.createObj()
+	if err := proto.Unmarshal(msg.Data, obj); err != nil {
+		t.rejectMessage(from, fmt.Errorf("failed to unmarshal topic: %w", err))
+
+		return pubsub.ValidationReject
+	}
+
+	if t.validator != nil {
+		if err := t.validator(obj, from); err != nil {
+			if errors.Is(err, ErrIgnoreMessage) {
+				return pubsub.ValidationIgnore
 			}
 
-			metrics.SetGauge([]string{networkMetrics, "ingress_bytes"}, float32(len(msg.Data)))
+			t.rejectMessage(from, err)
 
-			handler(obj, msg.GetFrom())
-		}()
+			return pubsub.ValidationReject
+		}
+	}
+
+	msg.ValidatorData = obj
+
+	return pubsub.ValidationAccept
+}
+
+// rejectMessage records the invalid message delivered by the peer
+func (t *Topic) rejectMessage(from peer.ID, err error) {
+	t.logger.Debug("invalid gossip message", "peer", from, "err", err)
+	metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+
+	if t.reportInvalid != nil {
+		t.reportInvalid(from)
 	}
 }
 
-func (s *Server) NewTopic(protoID string, obj proto.Message) (*Topic, error) {
+// NewTopic joins the gossip topic of the provided message type.
+// The received messages are checked by the validator (if not nil) before they are relayed and handled
+func (s *Server) NewTopic(protoID string, obj proto.Message, validator TopicValidator) (*Topic, error) {
 	topic, err := s.ps.Join(protoID)
 	if err != nil {
 		return nil, err
 	}
 
+	workers := s.config.GossipWorkers
+	if workers <= 0 {
+		workers = DefaultGossipWorkers
+	}
+
 	tt := &Topic{
-		logger:  s.logger.Named(protoID),
-		topic:   topic,
-		typ:     reflect.TypeOf(obj).Elem(),
-		closeCh: make(chan struct{}),
+		logger:        s.logger.Named(protoID),
+		ps:            s.ps,
+		topic:         topic,
+		typ:           reflect.TypeOf(obj).Elem(),
+		validator:     validator,
+		workers:       workers,
+		closeCh:       make(chan struct{}),
+		reportInvalid: s.reportInvalidMessage,
 	}
 	tt.closed.Store(false)
 
+	if err := s.ps.RegisterTopicValidator(protoID, tt.validate); err != nil {
+		topic.Close()
+
+		return nil, fmt.Errorf("failed to register topic validator: %w", err)
+	}
+
+	if s.config.PeerScore != nil {
+		if err := topic.SetScoreParams(s.config.PeerScore.topicScoreParams()); err != nil {
+			tt.Close()
+
+			return nil, fmt.Errorf("failed to set topic score parameters: %w", err)
+		}
+	}
+
 	return tt, nil
 }
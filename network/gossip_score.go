@@ -0,0 +1,88 @@
+package network
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// peerScoreDecayInterval is the interval at which the peer scores are decayed
+	peerScoreDecayInterval = time.Second
+
+	// peerScoreDecayToZero is the value below which the decayed counters are reset to zero
+	peerScoreDecayToZero = 0.01
+
+	// peerScoreRetention is the time the score of a disconnected peer is kept
+	peerScoreRetention = 10 * time.Minute
+)
+
+// PeerScoreConfig holds the GossipSub peer scoring parameters.
+// The peers delivering invalid messages are penalized, and the peers with low score
+// are excluded from the gossip (and the mesh) until their score decays
+type PeerScoreConfig struct {
+	// InvalidMessageWeight is the (negative) weight of the squared number of invalid messages delivered on a topic
+	InvalidMessageWeight float64
+
+	// InvalidMessageDecay is the decay of the invalid message counter applied every second
+	InvalidMessageDecay float64
+
+	// GossipThreshold is the score below which no gossip is emitted to the peer and its gossip is ignored
+	GossipThreshold float64
+
+	// PublishThreshold is the score below which the own messages are not published to the peer
+	PublishThreshold float64
+
+	// GraylistThreshold is the score below which all the messages of the peer are ignored
+	GraylistThreshold float64
+}
+
+// DefaultPeerScoreConfig returns the default peer scoring parameters
+func DefaultPeerScoreConfig() *PeerScoreConfig {
+	return &PeerScoreConfig{
+		InvalidMessageWeight: -100,
+		InvalidMessageDecay:  0.99,
+		GossipThreshold:      -500,
+		PublishThreshold:     -1000,
+		GraylistThreshold:    -2500,
+	}
+}
+
+// peerScoreParams returns the GossipSub peer score parameters.
+// The topic parameters are set when the topic is joined
+func (c *PeerScoreConfig) peerScoreParams() *pubsub.PeerScoreParams {
+	return &pubsub.PeerScoreParams{
+		SkipAtomicValidation: true,
+		Topics:               make(map[string]*pubsub.TopicScoreParams),
+		AppSpecificScore: func(peer.ID) float64 {
+			return 0
+		},
+		DecayInterval: peerScoreDecayInterval,
+		DecayToZero:   peerScoreDecayToZero,
+		RetainScore:   peerScoreRetention,
+	}
+}
+
+// topicScoreParams returns the GossipSub score parameters of a topic.
+// Only the invalid message deliveries are scored
+func (c *PeerScoreConfig) topicScoreParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		SkipAtomicValidation: true,
+		TopicWeight:          1,
+		// the time in mesh is not scored (zero weight), but the quantum is used as a divisor
+		TimeInMeshQuantum:              time.Second,
+		InvalidMessageDeliveriesWeight: c.InvalidMessageWeight,
+		InvalidMessageDeliveriesDecay:  c.InvalidMessageDecay,
+	}
+}
+
+// peerScoreThresholds returns the GossipSub peer score thresholds
+func (c *PeerScoreConfig) peerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		SkipAtomicValidation: true,
+		GossipThreshold:      c.GossipThreshold,
+		PublishThreshold:     c.PublishThreshold,
+		GraylistThreshold:    c.GraylistThreshold,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	testproto "github.com/0xPolygon/polygon-edge/network/proto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func NumSubscribers(srv *Server, topic string) int {
@@ -51,7 +52,7 @@ func TestSimpleGossip(t *testing.T) {
 	serverTopics := make([]*Topic, numServers)
 
 	for i := 0; i < numServers; i++ {
-		topic, topicErr := servers[i].NewTopic(topicName, &testproto.GenericMessage{})
+		topic, topicErr := servers[i].NewTopic(topicName, &testproto.GenericMessage{}, nil)
 		require.NoError(t, topicErr, "Unable to create topic")
 
 		serverTopics[i] = topic
@@ -98,6 +99,89 @@ func TestSimpleGossip(t *testing.T) {
 	}
 }
 
+func TestGossipValidator_BansPeer(t *testing.T) {
+	const (
+		topicName          = "msg-pub-sub"
+		invalidMessage     = "invalid"
+		maxInvalidMessages = 3
+	)
+
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {
+			ConfigCallback: func(c *Config) {
+				c.MaxInvalidMessages = maxInvalidMessages
+			},
+		},
+	})
+	require.NoError(t, createErr, "Unable to create servers")
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	joinErrors := MeshJoin(servers...)
+	require.Empty(t, joinErrors, "Unable to join servers [%d], %v", len(joinErrors), joinErrors)
+
+	validator := func(obj proto.Message, _ peer.ID) error {
+		genericMessage, ok := obj.(*testproto.GenericMessage)
+		if !ok {
+			return errors.New("invalid type assert")
+		}
+
+		if genericMessage.Message == invalidMessage {
+			return errors.New("invalid message")
+		}
+
+		return nil
+	}
+
+	handledCh := make(chan string, maxInvalidMessages+1)
+
+	// the first server validates the messages
+	validatingTopic, err := servers[0].NewTopic(topicName, &testproto.GenericMessage{}, validator)
+	require.NoError(t, err)
+	require.NoError(t, validatingTopic.Subscribe(func(obj interface{}, _ peer.ID) {
+		genericMessage, ok := obj.(*testproto.GenericMessage)
+		require.True(t, ok, "invalid type assert")
+
+		handledCh <- genericMessage.Message
+	}))
+
+	// the second server publishes the messages without the validation
+	publisherTopic, err := servers[1].NewTopic(topicName, &testproto.GenericMessage{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, publisherTopic.Subscribe(func(interface{}, peer.ID) {}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, WaitForSubscribers(ctx, servers[1], topicName, 1))
+
+	// the valid message is handled
+	require.NoError(t, publisherTopic.Publish(&testproto.GenericMessage{Message: "valid"}))
+
+	select {
+	case message := <-handledCh:
+		require.Equal(t, "valid", message)
+	case <-ctx.Done():
+		t.Fatal("valid message not handled before timeout")
+	}
+
+	// the peer delivering the invalid messages is banned
+	for i := 0; i < maxInvalidMessages; i++ {
+		require.NoError(t, publisherTopic.Publish(&testproto.GenericMessage{Message: invalidMessage}))
+	}
+
+	disconnected, err := WaitUntilPeerDisconnectsFrom(ctx, servers[0], servers[1].host.ID())
+	require.NoError(t, err)
+	require.True(t, disconnected)
+	require.True(t, servers[0].IsBanned(servers[1].host.ID()))
+	require.Len(t, handledCh, 0)
+
+	// the banned peer is not dialed
+	require.Error(t, servers[0].host.Connect(ctx, *servers[1].AddrInfo()))
+}
+
 func Test_RepeatedClose(t *testing.T) {
 	topic := &Topic{
 		closeCh: make(chan struct{}),
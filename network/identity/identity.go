@@ -14,7 +14,13 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
-const PeerID = "peerID"
+const (
+	PeerID = "peerID"
+
+	// PrivatePeer is the metadata key set by the nodes running in the private mode,
+	// so their address is not advertised by the peers
+	PrivatePeer = "private"
+)
 
 var (
 	ErrInvalidChainID   = errors.New("invalid chain ID")
@@ -52,6 +58,12 @@ type networkingServer interface {
 
 	// HasFreeConnectionSlot checks if there are available outbound connection slots [Thread safe]
 	HasFreeConnectionSlot(direction network.Direction) bool
+
+	// IsSlotExempt checks if the peer connection in the direction is exempt from the connection limits [Thread safe]
+	IsSlotExempt(peerID peer.ID, direction network.Direction) bool
+
+	// AddPrivatePeer marks the peer as running in the private mode, so it is not advertised [Thread safe]
+	AddPrivatePeer(peerID peer.ID)
 }
 
 // IdentityService is a networking service used to handle peer handshaking.
@@ -65,6 +77,7 @@ type IdentityService struct {
 
 	chainID int64   // The chain ID of the network
 	hostID  peer.ID // The base networking server's host peer ID
+	private bool    // Flag indicating if the node runs in the private mode
 }
 
 // NewIdentityService returns a new instance of the IdentityService
@@ -73,12 +86,14 @@ func NewIdentityService(
 	logger hclog.Logger,
 	chainID int64,
 	hostID peer.ID,
+	private bool,
 ) *IdentityService {
 	return &IdentityService{
 		logger:     logger.Named("identity"),
 		baseServer: server,
 		chainID:    chainID,
 		hostID:     hostID,
+		private:    private,
 	}
 }
 
@@ -93,7 +108,8 @@ func (i *IdentityService) GetNotifyBundle() *network.NotifyBundle {
 				return
 			}
 
-			if !i.baseServer.HasFreeConnectionSlot(conn.Stat().Direction) {
+			if !i.baseServer.IsSlotExempt(peerID, conn.Stat().Direction) &&
+				!i.baseServer.HasFreeConnectionSlot(conn.Stat().Direction) {
 				i.disconnectFromPeer(peerID, ErrNoAvailableSlots.Error())
 
 				return
@@ -182,6 +198,12 @@ func (i *IdentityService) handleConnected(peerID peer.ID, direction network.Dire
 		return ErrInvalidChainID
 	}
 
+	// The peer running in the private mode must be known before it is saved,
+	// so it is never advertised by the discovery
+	if resp.Metadata[PrivatePeer] == "true" {
+		i.baseServer.AddPrivatePeer(peerID)
+	}
+
 	// If this is a NOT temporary connection, save it
 	if !resp.TemporaryDial && !status.TemporaryDial {
 		i.baseServer.AddPeer(peerID, direction)
@@ -205,11 +227,17 @@ func (i *IdentityService) Hello(_ context.Context, req *proto.Status) (*proto.St
 
 // constructStatus constructs a status response of the current node
 func (i *IdentityService) constructStatus(peerID peer.ID) *proto.Status {
-	return &proto.Status{
+	status := &proto.Status{
 		Metadata: map[string]string{
 			PeerID: i.hostID.String(),
 		},
 		Chain:         i.chainID,
 		TemporaryDial: i.baseServer.IsTemporaryDial(peerID),
 	}
+
+	if i.private {
+		status.Metadata[PrivatePeer] = "true"
+	}
+
+	return status
 }
@@ -0,0 +1,108 @@
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// DefaultMaxInvalidMessages is the default number of invalid gossip messages
+	// a peer can deliver within the offense window before it is banned
+	DefaultMaxInvalidMessages = 20
+
+	// DefaultPeerBanDuration is the default duration of the peer ban
+	DefaultPeerBanDuration = time.Hour
+
+	// invalidMessagesWindow is the window in which the invalid gossip messages of a peer are counted
+	invalidMessagesWindow = time.Minute
+)
+
+// peerOffense is the number of invalid gossip messages delivered by a peer since the start of the window
+type peerOffense struct {
+	count       uint64
+	windowStart time.Time
+}
+
+// peerOffenses counts the invalid gossip messages delivered by the peers
+type peerOffenses struct {
+	lock     sync.Mutex
+	offenses map[peer.ID]*peerOffense
+}
+
+func newPeerOffenses() *peerOffenses {
+	return &peerOffenses{
+		offenses: make(map[peer.ID]*peerOffense),
+	}
+}
+
+// add records the invalid message delivered by the peer and returns the number
+// of invalid messages delivered within the current window [Thread safe]
+func (o *peerOffenses) add(peerID peer.ID) uint64 {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	now := time.Now()
+
+	offense, ok := o.offenses[peerID]
+	if !ok || now.Sub(offense.windowStart) > invalidMessagesWindow {
+		offense = &peerOffense{windowStart: now}
+		o.offenses[peerID] = offense
+	}
+
+	offense.count++
+
+	return offense.count
+}
+
+// remove removes the offenses of the peer [Thread safe]
+func (o *peerOffenses) remove(peerID peer.ID) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	delete(o.offenses, peerID)
+}
+
+// BanPeer disconnects the peer and refuses its connections for the duration.
+// The ban is persisted in the peer store
+func (s *Server) BanPeer(peerID peer.ID, duration time.Duration, reason string) {
+	s.logger.Warn("Banning peer", "id", peerID, "duration", duration, "reason", reason)
+
+	s.peerStore.ban(peerID, time.Now().Add(duration), reason)
+	s.offenses.remove(peerID)
+
+	metrics.IncrCounter([]string{networkMetrics, "banned_peers"}, float32(1))
+
+	s.DisconnectFromPeer(peerID, reason)
+}
+
+// UnbanPeer removes the ban of the peer, and returns false if the peer was not banned
+func (s *Server) UnbanPeer(peerID peer.ID) bool {
+	if !s.peerStore.unban(peerID) {
+		return false
+	}
+
+	s.logger.Info("Peer unbanned", "id", peerID)
+
+	return true
+}
+
+// IsBanned checks if the peer is banned
+func (s *Server) IsBanned(peerID peer.ID) bool {
+	return s.peerStore.isBanned(peerID)
+}
+
+// reportInvalidMessage records the invalid gossip message delivered by the peer.
+// The peer is banned once it delivers too many invalid messages within the offense window
+func (s *Server) reportInvalidMessage(peerID peer.ID) {
+	// the own messages are validated too
+	if peerID == s.host.ID() || s.config.MaxInvalidMessages == 0 {
+		return
+	}
+
+	if s.offenses.add(peerID) >= s.config.MaxInvalidMessages {
+		s.BanPeer(peerID, s.config.PeerBanDuration, "too many invalid gossip messages")
+	}
+}
@@ -0,0 +1,453 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// peerStoreFileName is the name of the bolt db file (in the networking data dir) holding the known peers
+	peerStoreFileName = "peerstore.db"
+
+	// peerStoreRetention is the time after which a peer that was not seen is forgotten
+	peerStoreRetention = 30 * 24 * time.Hour
+)
+
+var (
+	// bucket to store the known peers
+	peersBucket = []byte("peers")
+)
+
+/*
+Bolt DB schema:
+
+peers/
+|--> peerID -> *PeerRecord (json marshalled)
+*/
+
+// PeerRecord holds the information about a known peer
+type PeerRecord struct {
+	ID peer.ID `json:"id"`
+	// Addrs are the last known addresses of the peer
+	Addrs []string `json:"addrs"`
+	// LastSeen is the time of the last established connection with the peer
+	LastSeen time.Time `json:"lastSeen"`
+	// Score is the last GossipSub score of the peer
+	Score float64 `json:"score"`
+	// Static peers are always redialed and are exempt from the outbound connection limit.
+	// They are set through the configuration only, so the flag is not persisted
+	Static bool `json:"-"`
+	// Trusted peers are exempt from the inbound connection limit.
+	// The flag is set at runtime by the peers trust command, so it is persisted
+	Trusted bool `json:"trusted"`
+	// ConfigTrusted peers are trusted through the configuration only, so the flag is not persisted
+	ConfigTrusted bool `json:"-"`
+	// BannedUntil is the expiry of the peer ban, the peer is not banned if it is zero
+	BannedUntil time.Time `json:"bannedUntil"`
+	// BanReason is the reason of the peer ban
+	BanReason string `json:"banReason,omitempty"`
+}
+
+// isBanned checks if the peer is banned at the given time
+func (r *PeerRecord) isBanned(now time.Time) bool {
+	return !r.BannedUntil.IsZero() && now.Before(r.BannedUntil)
+}
+
+// isTrusted checks if the peer is trusted through the configuration or at runtime
+func (r *PeerRecord) isTrusted() bool {
+	return r.Trusted || r.ConfigTrusted
+}
+
+// isExpired checks if the peer was not seen for too long, and can be forgotten.
+// The static, trusted and banned peers are never forgotten
+func (r *PeerRecord) isExpired(now time.Time) bool {
+	return !r.Static && !r.isTrusted() && !r.isBanned(now) && now.Sub(r.LastSeen) > peerStoreRetention
+}
+
+// addrInfo returns the address info of the peer, or nil if none of its addresses is valid
+func (r *PeerRecord) addrInfo() *peer.AddrInfo {
+	info := &peer.AddrInfo{ID: r.ID}
+
+	for _, rawAddr := range r.Addrs {
+		addr, err := multiaddr.NewMultiaddr(rawAddr)
+		if err != nil {
+			continue
+		}
+
+		info.Addrs = append(info.Addrs, addr)
+	}
+
+	if len(info.Addrs) == 0 {
+		return nil
+	}
+
+	return info
+}
+
+// peerStore keeps the known peers, along with the static, trusted and banned peer lists.
+// The records are persisted in the networking data dir, or kept in memory only if there is none.
+// It is used as the libp2p connection gater, so the connections to and from the banned peers are refused.
+// In the private mode, only the connections to and from the static and trusted peers are allowed
+type peerStore struct {
+	logger      hclog.Logger
+	db          *bolt.DB // nil if the store is not persisted
+	privateMode bool     // flag indicating if only the static and trusted peers are allowed
+
+	lock    sync.Mutex
+	records map[peer.ID]*PeerRecord
+}
+
+// newPeerStore opens (or creates) the peer store in the data dir and loads the known peers.
+// The peers not seen for too long are removed
+func newPeerStore(logger hclog.Logger, dataDir string) (*peerStore, error) {
+	s := &peerStore{
+		logger:  logger,
+		records: make(map[peer.ID]*PeerRecord),
+	}
+
+	if dataDir == "" {
+		return s, nil
+	}
+
+	db, err := bolt.Open(filepath.Join(dataDir, peerStoreFileName), 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the peer store: %w", err)
+	}
+
+	s.db = db
+
+	if err := db.Update(s.load); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load creates the peers bucket if it doesn't exist, and loads the known peers
+func (s *peerStore) load(tx *bolt.Tx) error {
+	bucket, err := tx.CreateBucketIfNotExists(peersBucket)
+	if err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(peersBucket), err)
+	}
+
+	now := time.Now()
+	expired := make([][]byte, 0)
+
+	if err := bucket.ForEach(func(k, v []byte) error {
+		var record *PeerRecord
+		if err := json.Unmarshal(v, &record); err != nil {
+			return fmt.Errorf("failed to decode the peer record %x: %w", k, err)
+		}
+
+		if record.isExpired(now) {
+			expired = append(expired, k)
+
+			return nil
+		}
+
+		s.records[record.ID] = record
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, k := range expired {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	if len(expired) > 0 {
+		s.logger.Debug("forgot the peers not seen for too long", "count", len(expired))
+	}
+
+	return nil
+}
+
+// close persists the records and closes the underlying db
+func (s *peerStore) close() error {
+	if s.db == nil {
+		return nil
+	}
+
+	s.lock.Lock()
+	records := make([]*PeerRecord, 0, len(s.records))
+
+	for _, record := range s.records {
+		recordCopy := *record
+		records = append(records, &recordCopy)
+	}
+	s.lock.Unlock()
+
+	if err := s.persist(records...); err != nil {
+		s.logger.Error("failed to persist the peer store", "err", err)
+	}
+
+	return s.db.Close()
+}
+
+// persist writes the records to the db, if the store is persisted
+func (s *peerStore) persist(records ...*PeerRecord) error {
+	if s.db == nil || len(records) == 0 {
+		return nil
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(peersBucket)
+
+		for _, record := range records {
+			raw, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put([]byte(record.ID), raw); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// update applies the update function to the record of the peer (a new record is created if there is none),
+// and persists the result if requested [Thread safe]
+func (s *peerStore) update(peerID peer.ID, persist bool, updateFn func(record *PeerRecord)) {
+	s.lock.Lock()
+
+	record, ok := s.records[peerID]
+	if !ok {
+		record = &PeerRecord{ID: peerID}
+		s.records[peerID] = record
+	}
+
+	updateFn(record)
+
+	// persist a copy, so the record can be updated in the meantime
+	recordCopy := *record
+	s.lock.Unlock()
+
+	if !persist {
+		return
+	}
+
+	if err := s.persist(&recordCopy); err != nil {
+		s.logger.Error("failed to persist the peer record", "id", peerID, "err", err)
+	}
+}
+
+// get returns a copy of the record of the peer, or nil if the peer is unknown [Thread safe]
+func (s *peerStore) get(peerID peer.ID) *PeerRecord {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[peerID]
+	if !ok {
+		return nil
+	}
+
+	recordCopy := *record
+
+	return &recordCopy
+}
+
+// markSeen records the connection with the peer, along with the listen addresses it advertises.
+// The known addresses are kept if none are provided [Thread safe]
+func (s *peerStore) markSeen(peerID peer.ID, addrs []multiaddr.Multiaddr) {
+	s.update(peerID, true, func(record *PeerRecord) {
+		record.LastSeen = time.Now()
+
+		if len(addrs) == 0 {
+			return
+		}
+
+		record.Addrs = make([]string, 0, len(addrs))
+		for _, addr := range addrs {
+			record.Addrs = append(record.Addrs, addr.String())
+		}
+	})
+}
+
+// updateScores updates the scores of the known peers.
+// The scores are persisted when the store is closed [Thread safe]
+func (s *peerStore) updateScores(scores map[peer.ID]float64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for peerID, score := range scores {
+		if record, ok := s.records[peerID]; ok {
+			record.Score = score
+		}
+	}
+}
+
+// setStatic marks the peer as static, and records its addresses [Thread safe]
+func (s *peerStore) setStatic(info *peer.AddrInfo) {
+	s.update(info.ID, false, func(record *PeerRecord) {
+		record.Static = true
+
+		record.Addrs = make([]string, 0, len(info.Addrs))
+		for _, addr := range info.Addrs {
+			record.Addrs = append(record.Addrs, addr.String())
+		}
+	})
+}
+
+// setConfigTrusted marks the peer as trusted through the configuration [Thread safe]
+func (s *peerStore) setConfigTrusted(peerID peer.ID) {
+	s.update(peerID, false, func(record *PeerRecord) {
+		record.ConfigTrusted = true
+	})
+}
+
+// setTrusted marks the peer as trusted, or revokes the trust set at runtime [Thread safe]
+func (s *peerStore) setTrusted(peerID peer.ID, trusted bool) {
+	s.update(peerID, true, func(record *PeerRecord) {
+		record.Trusted = trusted
+	})
+}
+
+// ban bans the peer until the expiry [Thread safe]
+func (s *peerStore) ban(peerID peer.ID, expiry time.Time, reason string) {
+	s.update(peerID, true, func(record *PeerRecord) {
+		record.BannedUntil = expiry
+		record.BanReason = reason
+	})
+}
+
+// unban removes the ban of the peer, and returns false if the peer was not banned [Thread safe]
+func (s *peerStore) unban(peerID peer.ID) bool {
+	if !s.isBanned(peerID) {
+		return false
+	}
+
+	s.update(peerID, true, func(record *PeerRecord) {
+		record.BannedUntil = time.Time{}
+		record.BanReason = ""
+	})
+
+	return true
+}
+
+// isBanned checks if the peer is banned [Thread safe]
+func (s *peerStore) isBanned(peerID peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[peerID]
+
+	return ok && record.isBanned(time.Now())
+}
+
+// isStatic checks if the peer is static [Thread safe]
+func (s *peerStore) isStatic(peerID peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[peerID]
+
+	return ok && record.Static
+}
+
+// isTrusted checks if the peer is trusted [Thread safe]
+func (s *peerStore) isTrusted(peerID peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[peerID]
+
+	return ok && record.isTrusted()
+}
+
+// isAllowed checks if the connection to and from the peer is allowed:
+// the peer must not be banned, and it must be static or trusted in the private mode [Thread safe]
+func (s *peerStore) isAllowed(peerID peer.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[peerID]
+	if !ok {
+		return !s.privateMode
+	}
+
+	if record.isBanned(time.Now()) {
+		return false
+	}
+
+	return !s.privateMode || record.Static || record.isTrusted()
+}
+
+// staticPeers returns the address info of the static peers [Thread safe]
+func (s *peerStore) staticPeers() []*peer.AddrInfo {
+	return s.filter(func(record *PeerRecord, _ time.Time) bool {
+		return record.Static
+	})
+}
+
+// dialablePeers returns the address info of the known peers worth dialing:
+// the peers which are not static (those are dialed separately), not banned,
+// not expired and without a negative score [Thread safe]
+func (s *peerStore) dialablePeers() []*peer.AddrInfo {
+	return s.filter(func(record *PeerRecord, now time.Time) bool {
+		return !record.Static && !record.isBanned(now) && !record.isExpired(now) && record.Score >= 0
+	})
+}
+
+// filter returns the address info of the peers matching the filter [Thread safe]
+func (s *peerStore) filter(filterFn func(record *PeerRecord, now time.Time) bool) []*peer.AddrInfo {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	infos := make([]*peer.AddrInfo, 0)
+
+	for _, record := range s.records {
+		if !filterFn(record, now) {
+			continue
+		}
+
+		if info := record.addrInfo(); info != nil {
+			infos = append(infos, info)
+		}
+	}
+
+	return infos
+}
+
+// InterceptPeerDial refuses to dial the peer which is not allowed
+func (s *peerStore) InterceptPeerDial(peerID peer.ID) bool {
+	return s.isAllowed(peerID)
+}
+
+// InterceptAddrDial refuses to dial the peer which is not allowed
+func (s *peerStore) InterceptAddrDial(peerID peer.ID, _ multiaddr.Multiaddr) bool {
+	return s.isAllowed(peerID)
+}
+
+// InterceptAccept accepts all the inbound connections, the peer is not known yet
+func (s *peerStore) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured refuses the connection of the peer which is not allowed, once it is authenticated
+func (s *peerStore) InterceptSecured(_ network.Direction, peerID peer.ID, _ network.ConnMultiaddrs) bool {
+	return s.isAllowed(peerID)
+}
+
+// InterceptUpgraded accepts all the upgraded connections
+func (s *peerStore) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
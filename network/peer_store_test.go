@@ -0,0 +1,157 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerStore_Persistence(t *testing.T) {
+	dir := t.TempDir()
+
+	seenPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	bannedPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	trustedPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	addr, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/1478")
+	require.NoError(t, err)
+
+	store, err := newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	store.markSeen(seenPeer, []multiaddr.Multiaddr{addr})
+	store.updateScores(map[peer.ID]float64{seenPeer: 5})
+	store.ban(bannedPeer, time.Now().Add(time.Hour), "test")
+	store.setTrusted(trustedPeer, true)
+
+	require.NoError(t, store.close())
+
+	// the records survive the restart
+	store, err = newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.close())
+	})
+
+	record := store.get(seenPeer)
+	require.NotNil(t, record)
+	require.Equal(t, []string{addr.String()}, record.Addrs)
+	require.Equal(t, float64(5), record.Score)
+
+	require.True(t, store.isBanned(bannedPeer))
+	require.True(t, store.isTrusted(trustedPeer))
+
+	// only the seen peer can be dialed, the others have no address
+	dialable := store.dialablePeers()
+	require.Len(t, dialable, 1)
+	require.Equal(t, seenPeer, dialable[0].ID)
+
+	// the peer is not dialed once it is banned
+	store.ban(seenPeer, time.Now().Add(time.Hour), "test")
+	require.Empty(t, store.dialablePeers())
+
+	require.True(t, store.unban(seenPeer))
+	require.False(t, store.unban(seenPeer))
+	require.Len(t, store.dialablePeers(), 1)
+}
+
+func TestPeerStore_BanExpiry(t *testing.T) {
+	store, err := newPeerStore(hclog.NewNullLogger(), "")
+	require.NoError(t, err)
+
+	peerID, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	store.ban(peerID, time.Now().Add(-time.Second), "expired")
+
+	require.False(t, store.isBanned(peerID))
+	require.True(t, store.InterceptPeerDial(peerID))
+
+	store.ban(peerID, time.Now().Add(time.Hour), "active")
+
+	require.True(t, store.isBanned(peerID))
+	require.False(t, store.InterceptPeerDial(peerID))
+}
+
+func TestPeerStore_ForgetsExpiredPeers(t *testing.T) {
+	dir := t.TempDir()
+
+	expiredPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	trustedPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	store, err := newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	for _, peerID := range []peer.ID{expiredPeer, trustedPeer} {
+		store.update(peerID, true, func(record *PeerRecord) {
+			record.LastSeen = time.Now().Add(-peerStoreRetention - time.Hour)
+		})
+	}
+
+	store.setTrusted(trustedPeer, true)
+
+	require.NoError(t, store.close())
+
+	store, err = newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.close())
+	})
+
+	// the trusted peer is never forgotten
+	require.Nil(t, store.get(expiredPeer))
+	require.NotNil(t, store.get(trustedPeer))
+}
+
+func TestPeerStore_ConfigTrustedNotPersisted(t *testing.T) {
+	dir := t.TempDir()
+
+	configPeer, err := test.RandPeerID()
+	require.NoError(t, err)
+
+	addr, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/1478")
+	require.NoError(t, err)
+
+	store, err := newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	store.setConfigTrusted(configPeer)
+	store.markSeen(configPeer, []multiaddr.Multiaddr{addr})
+	require.True(t, store.isTrusted(configPeer))
+
+	// the trust from the configuration can't be revoked at runtime
+	store.setTrusted(configPeer, false)
+	require.True(t, store.isTrusted(configPeer))
+
+	// the known addresses are kept if the peer advertised none
+	store.markSeen(configPeer, nil)
+	require.Equal(t, []string{addr.String()}, store.get(configPeer).Addrs)
+
+	require.NoError(t, store.close())
+
+	// the peer is not trusted once it is removed from the configuration
+	store, err = newPeerStore(hclog.NewNullLogger(), dir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.close())
+	})
+
+	require.NotNil(t, store.get(configPeer))
+	require.False(t, store.isTrusted(configPeer))
+}
@@ -0,0 +1,137 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	testproto "github.com/0xPolygon/polygon-edge/network/proto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSentry_PrivateValidator checks the sentry node topology:
+//
+// validator (private) <-> sentry 0 <-> sentry 1 <-> public node
+//
+// The validator is connected only to its sentry, its address is not advertised,
+// and the gossip is relayed by the sentries in both directions
+func TestSentry_PrivateValidator(t *testing.T) {
+	const topicName = "consensus"
+
+	// sentries[0] is the sentry of the validator
+	sentries, createErr := createServers(2, nil)
+	require.NoError(t, createErr, "Unable to create sentries")
+
+	publicNode, createErr := CreateServer(nil)
+	require.NoError(t, createErr, "Unable to create public node")
+
+	sentryAddr, err := common.AddrInfoToString(sentries[0].AddrInfo())
+	require.NoError(t, err)
+
+	validator, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.PrivateMode = true
+			c.StaticPeers = []string{sentryAddr}
+		},
+	})
+	require.NoError(t, createErr, "Unable to create validator")
+
+	servers := []*Server{validator, sentries[0], sentries[1], publicNode}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancel()
+
+	// The validator dials its sentry on its own
+	_, err = WaitUntilPeerConnectsTo(ctx, validator, sentries[0].host.ID())
+	require.NoError(t, err)
+
+	require.NoError(t, JoinAndWait(sentries[0], sentries[1], DefaultBufferTimeout, DefaultJoinTimeout))
+	require.NoError(t, JoinAndWait(publicNode, sentries[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	// The sentry knows the validator runs in the private mode, and doesn't advertise it
+	require.True(t, sentries[0].IsPrivatePeer(validator.host.ID()))
+	require.NotContains(t, sentries[0].discovery.RoutingTablePeers(), validator.host.ID())
+
+	discoveryClient, err := sentries[1].NewDiscoveryClient(sentries[0].host.ID())
+	require.NoError(t, err)
+
+	resp, err := discoveryClient.FindPeers(ctx, &testproto.FindPeersReq{
+		Key:   validator.host.ID().String(),
+		Count: 16,
+	})
+	require.NoError(t, err)
+
+	for _, node := range resp.Nodes {
+		info, err := common.StringToAddrInfo(node)
+		require.NoError(t, err)
+		require.NotEqual(t, validator.host.ID(), info.ID, "validator advertised by its sentry")
+	}
+
+	// The validator refuses to connect to the other peers
+	require.Error(t, validator.host.Connect(ctx, *publicNode.AddrInfo()))
+
+	_ = publicNode.host.Connect(ctx, *validator.AddrInfo())
+
+	refusedCtx, refusedCancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer refusedCancel()
+
+	_, err = WaitUntilPeerConnectsTo(refusedCtx, validator, publicNode.host.ID())
+	require.Error(t, err, "validator accepted the connection of a public node")
+
+	// The sentries relay the gossip of the validator and to the validator
+	received := make([]chan string, len(servers))
+	topics := make([]*Topic, len(servers))
+
+	for i, server := range servers {
+		receivedCh := make(chan string, 4)
+
+		topic, err := server.NewTopic(topicName, &testproto.GenericMessage{}, nil)
+		require.NoError(t, err)
+
+		require.NoError(t, topic.Subscribe(func(obj interface{}, _ peer.ID) {
+			genericMessage, ok := obj.(*testproto.GenericMessage)
+			require.True(t, ok, "invalid type assert")
+
+			receivedCh <- genericMessage.Message
+		}))
+
+		received[i] = receivedCh
+		topics[i] = topic
+	}
+
+	// validator and public node have a single peer, the sentries have two
+	require.NoError(t, WaitForSubscribers(ctx, validator, topicName, 1))
+	require.NoError(t, WaitForSubscribers(ctx, sentries[0], topicName, 2))
+	require.NoError(t, WaitForSubscribers(ctx, sentries[1], topicName, 2))
+	require.NoError(t, WaitForSubscribers(ctx, publicNode, topicName, 1))
+
+	// the publishers receive their own messages as well, so the other messages are skipped
+	expectMessage := func(receivedCh chan string, expected string) {
+		t.Helper()
+
+		timeout := time.After(15 * time.Second)
+
+		for {
+			select {
+			case message := <-receivedCh:
+				if message == expected {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("message %q not received before timeout", expected)
+			}
+		}
+	}
+
+	require.NoError(t, topics[0].Publish(&testproto.GenericMessage{Message: "from validator"}))
+	expectMessage(received[3], "from validator")
+
+	require.NoError(t, topics[3].Publish(&testproto.GenericMessage{Message: "from public node"}))
+	expectMessage(received[0], "from public node")
+}
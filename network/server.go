@@ -54,8 +54,9 @@ const (
 )
 
 var (
-	ErrNoBootnodes  = errors.New("no bootnodes specified")
-	ErrMinBootnodes = errors.New("minimum 1 bootnode is required")
+	ErrNoBootnodes   = errors.New("no bootnodes specified")
+	ErrMinBootnodes  = errors.New("minimum 1 bootnode is required")
+	ErrNoStaticPeers = errors.New("at least 1 static peer is required in the private mode")
 )
 
 type Server struct {
@@ -87,13 +88,22 @@ type Server struct {
 
 	temporaryDials sync.Map // map of temporary connections; peerID -> bool
 
+	privatePeers sync.Map // map of the connected peers running in the private mode; peerID -> bool
+
 	bootnodes *bootnodesWrapper // reference of all bootnodes for the node
+
+	peerStore *peerStore    // known, static, trusted and banned peers, used as the connection gater
+	offenses  *peerOffenses // invalid gossip messages delivered by the peers
 }
 
 // NewServer returns a new instance of the networking server
 func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 	logger = logger.Named("network")
 
+	if config.PrivateMode && len(config.StaticPeers) == 0 {
+		return nil, ErrNoStaticPeers
+	}
+
 	key, err := setupLibp2pKey(config.SecretsManager)
 	if err != nil {
 		return nil, err
@@ -118,14 +128,31 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return addrs
 	}
 
+	peerStore, err := newPeerStore(logger, config.DataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	peerStore.privateMode = config.PrivateMode
+
+	if err := setupPeerLists(peerStore, config); err != nil {
+		_ = peerStore.close()
+
+		return nil, err
+	}
+
 	host, err := libp2p.New(
 		// Use noise as the encryption protocol
 		libp2p.Security(noise.ID, noise.New),
 		libp2p.ListenAddrs(listenAddr),
 		libp2p.AddrsFactory(addrsFactory),
 		libp2p.Identity(key),
+		// Refuse the connections of the banned peers (and the unknown peers in the private mode)
+		libp2p.ConnectionGater(peerStore),
 	)
 	if err != nil {
+		_ = peerStore.close()
+
 		return nil, fmt.Errorf("failed to create libp2p stack: %w", err)
 	}
 
@@ -154,14 +181,30 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 			config.MaxInboundPeers,
 			config.MaxOutboundPeers,
 		),
+		peerStore: peerStore,
+		offenses:  newPeerOffenses(),
 	}
 
-	// start gossip protocol
-	ps, err := pubsub.NewGossipSub(
-		context.Background(),
-		host, pubsub.WithPeerOutboundQueueSize(peerOutboundBufferSize),
+	pubsubOpts := []pubsub.Option{
+		pubsub.WithPeerOutboundQueueSize(peerOutboundBufferSize),
 		pubsub.WithValidateQueueSize(validateBufferSize),
-	)
+	}
+
+	if config.PeerScore != nil {
+		// penalize the peers delivering invalid messages
+		pubsubOpts = append(pubsubOpts, pubsub.WithPeerScore(
+			config.PeerScore.peerScoreParams(),
+			config.PeerScore.peerScoreThresholds(),
+		))
+		// remember the scores of the known peers
+		pubsubOpts = append(pubsubOpts, pubsub.WithPeerScoreInspect(
+			pubsub.PeerScoreInspectFn(peerStore.updateScores),
+			peerScoreInspectInterval,
+		))
+	}
+
+	// start gossip protocol
+	ps, err := pubsub.NewGossipSub(context.Background(), host, pubsubOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +224,7 @@ type PeerConnInfo struct {
 	Info peer.AddrInfo
 
 	connDirections  map[network.Direction]bool
+	slotExempt      map[network.Direction]bool // the directions not counted towards the connection limits
 	protocolStreams map[string]*rawGrpc.ClientConn
 }
 
@@ -249,12 +293,17 @@ func (s *Server) Start() error {
 
 	s.logger.Info("LibP2P server running", "addr", addr)
 
+	if setupErr := s.recordAdvertisedAddrs(); setupErr != nil {
+		return fmt.Errorf("unable to subscribe to the peer identification, %w", setupErr)
+	}
+
 	if setupErr := s.setupIdentity(); setupErr != nil {
 		return fmt.Errorf("unable to setup identity, %w", setupErr)
 	}
 
-	// Set up the peer discovery mechanism if needed
-	if !s.config.NoDiscover {
+	// Set up the peer discovery mechanism if needed.
+	// The discovery is disabled in the private mode, the node connects only to its static peers
+	if !s.config.NoDiscover && !s.config.PrivateMode {
 		// Parse the bootnode data
 		if setupErr := s.setupBootnodes(); setupErr != nil {
 			return fmt.Errorf("unable to parse bootnode data, %w", setupErr)
@@ -267,8 +316,14 @@ func (s *Server) Start() error {
 	}
 
 	go s.runDial()
+	go s.runStaticDial()
 	go s.keepAliveMinimumPeerConnections()
 
+	// Dial the peers remembered from the previous runs
+	if !s.config.PrivateMode {
+		s.dialKnownPeers()
+	}
+
 	// watch for disconnected peers
 	s.host.Network().Notify(&network.NotifyBundle{
 		DisconnectedF: func(net network.Network, conn network.Conn) {
@@ -361,6 +416,11 @@ func (s *Server) runDial() {
 
 	if err := s.Subscribe(ctx, func(event *peerEvent.PeerEvent) {
 		// Return back slot on PeerFailedToConnect or PeerDisconnected
+		// The static peers are dialed without taking a slot
+		if s.peerStore.isStatic(event.PeerID) {
+			return
+		}
+
 		switch event.Type {
 		case
 			peerEvent.PeerFailedToConnect,
@@ -394,7 +454,7 @@ func (s *Server) runDial() {
 
 			peerInfo := tt.GetAddrInfo()
 
-			if s.IsConnected(peerInfo.ID) {
+			if s.IsConnected(peerInfo.ID) || s.peerStore.isStatic(peerInfo.ID) {
 				continue
 			}
 
@@ -472,6 +532,8 @@ func (s *Server) GetProtocols(peerID peer.ID) ([]string, error) {
 func (s *Server) removePeer(peerID peer.ID) {
 	s.logger.Info("Peer disconnected", "id", peerID)
 
+	s.privatePeers.Delete(peerID)
+
 	// Remove the peer from the peers map
 	connectionInfo := s.removePeerInfo(peerID)
 	if connectionInfo == nil {
@@ -506,7 +568,7 @@ func (s *Server) removePeerInfo(peerID peer.ID) *PeerConnInfo {
 
 	// Update connection counters
 	for connDirection, active := range connectionInfo.connDirections {
-		if active {
+		if active && !connectionInfo.slotExempt[connDirection] {
 			s.connectionCounts.UpdateConnCountByDirection(-1, connDirection)
 			s.updateConnCountMetrics(connDirection)
 			s.updateBootnodeConnCount(peerID, -1)
@@ -583,7 +645,11 @@ func (s *Server) Close() error {
 	err := s.host.Close()
 	s.dialQueue.Close()
 
-	if !s.config.NoDiscover {
+	if closeErr := s.peerStore.close(); closeErr != nil {
+		s.logger.Error("failed to close the peer store", "err", closeErr)
+	}
+
+	if s.discovery != nil {
 		s.discovery.Close()
 	}
 
@@ -33,6 +33,10 @@ func (s *Server) NewIdentityClient(peerID peer.ID) (proto.IdentityClient, error)
 func (s *Server) AddPeer(id peer.ID, direction network.Direction) {
 	s.logger.Info("Peer connected", "id", id.String())
 
+	// Remember the peer, so it can be dialed after a restart.
+	// Its addresses are recorded once it is identified (see recordAdvertisedAddrs)
+	s.peerStore.markSeen(id, nil)
+
 	// Update the peer connection info
 	if connectionExists := s.addPeerInfo(id, direction); connectionExists {
 		// The peer connection information was already present in the networking
@@ -65,6 +69,7 @@ func (s *Server) addPeerInfo(id peer.ID, direction network.Direction) bool {
 		connectionInfo = &PeerConnInfo{
 			Info:            s.host.Peerstore().PeerInfo(id),
 			connDirections:  make(map[network.Direction]bool),
+			slotExempt:      make(map[network.Direction]bool),
 			protocolStreams: make(map[string]*rawGrpc.ClientConn),
 		}
 	}
@@ -74,6 +79,15 @@ func (s *Server) addPeerInfo(id peer.ID, direction network.Direction) bool {
 
 	s.peers[id] = connectionInfo
 
+	if s.IsSlotExempt(id, direction) {
+		// The connection doesn't take a slot, so the connection counters are not updated
+		connectionInfo.slotExempt[direction] = true
+
+		metrics.SetGauge([]string{networkMetrics, "peers"}, float32(len(s.peers)))
+
+		return false
+	}
+
 	// Update connection counters
 	s.connectionCounts.UpdateConnCountByDirection(1, direction)
 	s.updateConnCountMetrics(direction)
@@ -112,6 +126,7 @@ func (s *Server) setupIdentity() error {
 		s.logger,
 		s.config.Chain.Params.ChainID,
 		s.host.ID(),
+		s.config.PrivateMode,
 	)
 
 	// Register the identity service protocol
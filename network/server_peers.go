@@ -0,0 +1,183 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// staticPeersRedialInterval is the interval at which the disconnected static peers are redialed
+	staticPeersRedialInterval = 10 * time.Second
+
+	// peerScoreInspectInterval is the interval at which the scores of the known peers are updated
+	peerScoreInspectInterval = time.Minute
+)
+
+// setupPeerLists adds the static and trusted peers from the configuration to the peer store
+func setupPeerLists(store *peerStore, config *Config) error {
+	for _, rawAddr := range config.StaticPeers {
+		info, err := common.StringToAddrInfo(rawAddr)
+		if err != nil {
+			return fmt.Errorf("failed to parse static peer %s: %w", rawAddr, err)
+		}
+
+		store.setStatic(info)
+	}
+
+	for _, rawID := range config.TrustedPeers {
+		peerID, err := peer.Decode(rawID)
+		if err != nil {
+			return fmt.Errorf("failed to parse trusted peer %s: %w", rawID, err)
+		}
+
+		store.setConfigTrusted(peerID)
+	}
+
+	return nil
+}
+
+// runStaticDial keeps the connections to the static peers alive.
+// The static peers are dialed directly, without taking a dialing slot
+func (s *Server) runStaticDial() {
+	staticPeers := s.peerStore.staticPeers()
+	if len(staticPeers) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		for _, peerInfo := range staticPeers {
+			if peerInfo.ID == s.host.ID() || s.IsConnected(peerInfo.ID) || s.IsBanned(peerInfo.ID) {
+				continue
+			}
+
+			go func(peerInfo peer.AddrInfo) {
+				s.logger.Debug("Dialing static peer", "addr", peerInfo)
+
+				if err := s.host.Connect(ctx, peerInfo); err != nil {
+					s.logger.Debug("failed to dial static peer", "addr", peerInfo, "err", err.Error())
+				}
+			}(*peerInfo)
+		}
+
+		select {
+		case <-time.After(staticPeersRedialInterval):
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// recordAdvertisedAddrs records the addresses of the peers in the peer store, once the peers are identified.
+// After the identification, the libp2p peer store holds only the listen addresses advertised by the peer,
+// so the ephemeral addresses of the inbound connections are never recorded
+func (s *Server) recordAdvertisedAddrs() error {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtPeerIdentificationCompleted))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer sub.Close()
+
+		for {
+			select {
+			case <-s.closeCh:
+				return
+
+			case evnt := <-sub.Out():
+				if obj, ok := evnt.(event.EvtPeerIdentificationCompleted); ok {
+					s.peerStore.markSeen(obj.Peer, s.advertisedAddrs(obj.Peer))
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// advertisedAddrs returns the addresses from the signed peer record of the identified peer,
+// or the listen addresses it advertised if it sent no signed record
+func (s *Server) advertisedAddrs(peerID peer.ID) []multiaddr.Multiaddr {
+	if certifiedAddrBook, ok := peerstore.GetCertifiedAddrBook(s.host.Peerstore()); ok {
+		if envelope := certifiedAddrBook.GetPeerRecord(peerID); envelope != nil {
+			if record, err := envelope.Record(); err == nil {
+				if peerRecord, ok := record.(*peer.PeerRecord); ok {
+					return peerRecord.Addrs
+				}
+			}
+		}
+	}
+
+	return s.host.Peerstore().Addrs(peerID)
+}
+
+// dialKnownPeers adds the peers remembered in the peer store to the dial queue
+func (s *Server) dialKnownPeers() {
+	for _, peerInfo := range s.peerStore.dialablePeers() {
+		if peerInfo.ID == s.host.ID() {
+			continue
+		}
+
+		s.host.Peerstore().AddAddrs(peerInfo.ID, peerInfo.Addrs, peerstore.AddressTTL)
+		s.addToDialQueue(peerInfo, common.PriorityRandomDial)
+	}
+}
+
+// TrustPeer marks the peer as trusted, or revokes the trust. The change is persisted,
+// unlike the trust of the peers from the configuration, which can't be revoked at runtime.
+// The trusted peers are allowed to connect past the inbound connection limit
+func (s *Server) TrustPeer(peerID peer.ID, trusted bool) {
+	s.logger.Info("Updating peer trust", "id", peerID, "trusted", trusted)
+
+	s.peerStore.setTrusted(peerID, trusted)
+}
+
+// IsTrusted checks if the peer is trusted
+func (s *Server) IsTrusted(peerID peer.ID) bool {
+	return s.peerStore.isTrusted(peerID)
+}
+
+// IsStatic checks if the peer is a static peer
+func (s *Server) IsStatic(peerID peer.ID) bool {
+	return s.peerStore.isStatic(peerID)
+}
+
+// IsSlotExempt checks if the peer connection in the specified direction is exempt
+// from the connection limits. The outbound connections to the static peers,
+// and the inbound connections from the trusted peers are not limited [Thread safe]
+func (s *Server) IsSlotExempt(peerID peer.ID, direction network.Direction) bool {
+	switch direction {
+	case network.DirOutbound:
+		return s.peerStore.isStatic(peerID)
+	case network.DirInbound:
+		return s.peerStore.isTrusted(peerID)
+	}
+
+	return false
+}
+
+// AddPrivatePeer marks the connected peer as running in the private mode,
+// so its address is not advertised by the discovery [Thread safe]
+func (s *Server) AddPrivatePeer(peerID peer.ID) {
+	s.logger.Debug("Peer runs in the private mode", "id", peerID)
+
+	s.privatePeers.Store(peerID, true)
+}
+
+// IsPrivatePeer checks if the peer runs in the private mode [Thread safe]
+func (s *Server) IsPrivatePeer(peerID peer.ID) bool {
+	_, ok := s.privatePeers.Load(peerID)
+
+	return ok
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -12,6 +14,7 @@ import (
 	peerEvent "github.com/0xPolygon/polygon-edge/network/event"
 
 	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/secrets"
 
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/network"
@@ -492,6 +495,19 @@ func TestReconnectionWithNewIP(t *testing.T) {
 
 	_, dir0 := GenerateTestLibp2pKey(t)
 	_, dir1 := GenerateTestLibp2pKey(t)
+	_, dir2 := GenerateTestLibp2pKey(t)
+
+	// servers[2] has the same key, but its own data dir (the peer store can't be shared)
+	keyPath := filepath.Join(secrets.NetworkFolderLocal, secrets.NetworkKeyLocal)
+
+	key, err := os.ReadFile(filepath.Join(dir1, keyPath))
+	if err != nil {
+		t.Fatalf("Unable to read the libp2p key, %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir2, keyPath), key, 0600); err != nil {
+		t.Fatalf("Unable to copy the libp2p key, %v", err)
+	}
 
 	defaultConfig := func(c *Config) {
 		c.NoDiscover = true
@@ -514,7 +530,7 @@ func TestReconnectionWithNewIP(t *testing.T) {
 			2: {
 				ConfigCallback: func(c *Config) {
 					defaultConfig(c)
-					c.DataDir = dir1
+					c.DataDir = dir2
 					// same ID to but different IP from servers[1]
 					c.NatAddr = net.ParseIP(natIP)
 				},
@@ -1159,3 +1175,106 @@ func generateRandomPeers(t *testing.T, count int) ([]*randomPeer, error) {
 
 	return randomPeers, nil
 }
+
+func TestConnLimit_TrustedPeer(t *testing.T) {
+	// the trusted peers are allowed past the inbound connection limit
+	defaultConfig := &CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.MaxInboundPeers = 1
+			c.MaxOutboundPeers = 1
+			c.NoDiscover = true
+		},
+	}
+
+	servers, createErr := createServers(3, map[int]*CreateServerParams{
+		0: defaultConfig,
+		1: defaultConfig,
+		2: defaultConfig,
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// Server 0 takes the only inbound slot of Server 1
+	if joinErr := JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	// Server 2 is trusted, so it can connect to Server 1 anyway
+	servers[1].TrustPeer(servers[2].host.ID(), true)
+
+	if joinErr := JoinAndWait(servers[2], servers[1], DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	// the trusted peer doesn't take an inbound slot
+	assert.Equal(t, int64(2), servers[1].numPeers())
+	assert.Equal(t, int64(1), servers[1].connectionCounts.GetInboundConnCount())
+}
+
+func TestStaticPeers_Dial(t *testing.T) {
+	// the static peers are dialed past the outbound connection limit
+	defaultConfig := func(c *Config) {
+		c.MaxInboundPeers = 1
+		c.MaxOutboundPeers = 1
+		c.NoDiscover = true
+	}
+
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: defaultConfig},
+		1: {ConfigCallback: defaultConfig},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	staticAddr, err := common.AddrInfoToString(servers[1].AddrInfo())
+	if err != nil {
+		t.Fatalf("Unable to encode the static peer address, %v", err)
+	}
+
+	staticServer, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			defaultConfig(c)
+			c.StaticPeers = []string{staticAddr}
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create server, %v", createErr)
+	}
+
+	servers = append(servers, staticServer)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// Server 0 takes the only outbound slot, the static peer doesn't need one
+	if joinErr := JoinAndWait(staticServer, servers[0], DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	connectCtx, connectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer connectFn()
+
+	if _, connectErr := WaitUntilPeerConnectsTo(connectCtx, staticServer, servers[1].host.ID()); connectErr != nil {
+		t.Fatalf("Unable to wait for the static peer connection, %v", connectErr)
+	}
+
+	assert.True(t, staticServer.IsStatic(servers[1].host.ID()))
+	assert.Equal(t, int64(1), staticServer.connectionCounts.GetOutboundConnCount())
+
+	// the static peer is redialed once it disconnects
+	staticServer.DisconnectFromPeer(servers[1].host.ID(), "bye")
+
+	reconnectCtx, reconnectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer reconnectFn()
+
+	if _, connectErr := WaitUntilPeerConnectsTo(reconnectCtx, staticServer, servers[1].host.ID()); connectErr != nil {
+		t.Fatalf("Unable to wait for the static peer reconnection, %v", connectErr)
+	}
+}
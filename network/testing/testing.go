@@ -30,6 +30,8 @@ type MockNetworkingServer struct {
 	emitEventFn              emitEventDelegate
 	isTemporaryDialFn        isTemporaryDialDelegate
 	hasFreeConnectionSlotFn  hasFreeConnectionSlotDelegate
+	isSlotExemptFn           isSlotExemptDelegate
+	addPrivatePeerFn         addPrivatePeerDelegate
 
 	// Discovery Hooks
 	newDiscoveryClientFn       newDiscoveryClientDelegate
@@ -43,6 +45,7 @@ type MockNetworkingServer struct {
 	fetchAndSetTemporaryDialFn fetchAndSetTemporaryDialDelegate
 	removeTemporaryDialFn      removeTemporaryDialDelegate
 	temporaryDialPeerFn        temporaryDialPeerDelegate
+	isPrivatePeerFn            isPrivatePeerDelegate
 }
 
 func NewMockNetworkingServer() *MockNetworkingServer {
@@ -74,6 +77,8 @@ type updatePendingConnCountDelegate func(int64, network.Direction)
 type emitEventDelegate func(*event.PeerEvent)
 type isTemporaryDialDelegate func(peer.ID) bool
 type hasFreeConnectionSlotDelegate func(network.Direction) bool
+type isSlotExemptDelegate func(peer.ID, network.Direction) bool
+type addPrivatePeerDelegate func(peer.ID)
 
 // Required for Discovery
 type getRandomBootnodeDelegate func() *peer.AddrInfo
@@ -87,6 +92,7 @@ type getRandomPeerDelegate func() *peer.ID
 type fetchAndSetTemporaryDialDelegate func(peer.ID, bool) bool
 type removeTemporaryDialDelegate func(peer.ID)
 type temporaryDialPeerDelegate func(peerAddrInfo *peer.AddrInfo)
+type isPrivatePeerDelegate func(peer.ID) bool
 
 func (m *MockNetworkingServer) TemporaryDialPeer(peerAddrInfo *peer.AddrInfo) {
 	if m.temporaryDialPeerFn != nil {
@@ -174,6 +180,28 @@ func (m *MockNetworkingServer) HookHasFreeConnectionSlot(fn hasFreeConnectionSlo
 	m.hasFreeConnectionSlotFn = fn
 }
 
+func (m *MockNetworkingServer) IsSlotExempt(peerID peer.ID, direction network.Direction) bool {
+	if m.isSlotExemptFn != nil {
+		return m.isSlotExemptFn(peerID, direction)
+	}
+
+	return false
+}
+
+func (m *MockNetworkingServer) HookIsSlotExempt(fn isSlotExemptDelegate) {
+	m.isSlotExemptFn = fn
+}
+
+func (m *MockNetworkingServer) AddPrivatePeer(peerID peer.ID) {
+	if m.addPrivatePeerFn != nil {
+		m.addPrivatePeerFn(peerID)
+	}
+}
+
+func (m *MockNetworkingServer) HookAddPrivatePeer(fn addPrivatePeerDelegate) {
+	m.addPrivatePeerFn = fn
+}
+
 func (m *MockNetworkingServer) GetRandomBootnode() *peer.AddrInfo {
 	if m.getRandomBootnodeFn != nil {
 		return m.getRandomBootnodeFn()
@@ -288,6 +316,18 @@ func (m *MockNetworkingServer) HookRemoveTemporaryDial(fn removeTemporaryDialDel
 	m.removeTemporaryDialFn = fn
 }
 
+func (m *MockNetworkingServer) IsPrivatePeer(peerID peer.ID) bool {
+	if m.isPrivatePeerFn != nil {
+		return m.isPrivatePeerFn(peerID)
+	}
+
+	return false
+}
+
+func (m *MockNetworkingServer) HookIsPrivatePeer(fn isPrivatePeerDelegate) {
+	m.isPrivatePeerFn = fn
+}
+
 // MockIdentityClient mocks an identity client (other peer in the communication)
 type MockIdentityClient struct {
 	// Hooks that the test can set
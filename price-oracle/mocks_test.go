@@ -6,7 +6,6 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/umbracle/ethgo"
@@ -84,6 +83,16 @@ func (m *MockBlockchainBackend) UnubscribeEvents(subscription blockchain.Subscri
 	m.Called(subscription)
 }
 
+func (m *MockBlockchainBackend) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	args := m.Called(hash)
+	receipts, ok := args.Get(0).([]*types.Receipt)
+	if !ok {
+		panic("Expected []*types.Receipt but got a different type")
+	}
+
+	return receipts, args.Error(1)
+}
+
 type MockPolybftBackend struct {
 	mock.Mock
 }
@@ -135,6 +144,16 @@ func (m *MockTxRelayer) SendTransactionLocal(txn *ethgo.Transaction) (*ethgo.Rec
 	return receipt, args.Error(1)
 }
 
+func (m *MockTxRelayer) SendRawTransaction(data []byte) (*ethgo.Receipt, error) {
+	args := m.Called(data)
+	receipt, ok := args.Get(0).(*ethgo.Receipt)
+	if !ok {
+		panic("Expected *ethgo.Receipt but got a different type")
+	}
+
+	return receipt, args.Error(1)
+}
+
 func (m *MockTxRelayer) Client() *jsonrpc.Client {
 	args := m.Called()
 	client, ok := args.Get(0).(*jsonrpc.Client)
@@ -165,9 +184,9 @@ type MockStateProvider struct {
 
 func (m *MockStateProvider) GetPriceOracleState(
 	header *types.Header,
-	validatorAccount *wallet.Account,
+	validatorKey ethgo.Key,
 ) (PriceOracleState, error) {
-	args := m.Called(header, validatorAccount)
+	args := m.Called(header, validatorKey)
 	state, ok := args.Get(0).(PriceOracleState)
 	if !ok {
 		panic("Expected PriceOracleState but got a different type")
@@ -1,14 +1,24 @@
 package priceoracle
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+var (
+	errNoPriceSources       = errors.New("no price sources configured")
+	errInvalidPriceQuorum   = errors.New("price feed quorum must not exceed the number of sources")
+	errInvalidPriceSpread   = errors.New("price feed max spread must not be negative")
+	errDuplicatePriceSource = errors.New("duplicate price source name")
 )
 
 type PriceFeed interface {
@@ -26,74 +36,238 @@ func (d *dummyPriceFeed) GetPrice(header *types.Header) (*big.Int, error) {
 	return nil, nil
 }
 
-type priceFeed struct {
-	coinGeckoAPIKey string
+// PriceFeedConfig defines the price sources the price feed aggregates
+// and the rules applied to their responses
+type PriceFeedConfig struct {
+	// Sources are the price sources queried on every price request
+	Sources []*PriceSourceConfig `json:"sources" yaml:"sources"`
+	// Quorum is the minimum number of successful responses needed to produce a price.
+	// If not set, a simple majority of the configured sources is required
+	Quorum uint64 `json:"quorum" yaml:"quorum"`
+	// MaxSpread is the maximum allowed difference (in percents) between the highest
+	// and the lowest reported price, relative to the median. Zero disables the check
+	MaxSpread float64 `json:"max_spread" yaml:"max_spread"`
+}
+
+// SourceError holds the error returned by a single price source
+type SourceError struct {
+	Source string
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("price source %s: %v", e.Source, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// aggregatedPriceFeed queries multiple price sources concurrently
+// and returns the median of the successful responses
+type aggregatedPriceFeed struct {
+	logger    hclog.Logger
+	sources   []PriceSource
+	quorum    uint64
+	maxSpread float64
 }
 
-func NewPriceFeed(secretsManagerConfig *secrets.SecretsManagerConfig) (PriceFeed, error) {
-	apiKey, ok := secretsManagerConfig.Extra[secrets.CoinGeckoAPIKey].(string)
-	if !ok {
-		return nil, fmt.Errorf(secrets.CoinGeckoAPIKey + " is not a string")
+// NewPriceFeed creates a price feed from the given configuration.
+// If config is nil, the price sources are read from the secrets manager configuration
+// (secrets.PriceFeed) and, as a fallback, a single CoinGecko source is used
+func NewPriceFeed(
+	logger hclog.Logger,
+	config *PriceFeedConfig,
+	secretsManagerConfig *secrets.SecretsManagerConfig,
+) (PriceFeed, error) {
+	var extra map[string]interface{}
+	if secretsManagerConfig != nil {
+		extra = secretsManagerConfig.Extra
 	}
 
-	return &priceFeed{coinGeckoAPIKey: apiKey}, nil
+	if config == nil || len(config.Sources) == 0 {
+		var err error
+
+		if config, err = priceFeedConfigFromExtra(extra); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(config.Sources) == 0 {
+		return nil, errNoPriceSources
+	}
+
+	quorum := config.Quorum
+	if quorum == 0 {
+		quorum = uint64(len(config.Sources)/2 + 1)
+	}
+
+	if quorum > uint64(len(config.Sources)) {
+		return nil, errInvalidPriceQuorum
+	}
+
+	if config.MaxSpread < 0 {
+		return nil, errInvalidPriceSpread
+	}
+
+	sources := make([]PriceSource, 0, len(config.Sources))
+	names := make(map[string]struct{}, len(config.Sources))
+
+	for _, sourceConfig := range config.Sources {
+		source, err := newPriceSource(sourceConfig, extra)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, exists := names[source.Name()]; exists {
+			return nil, fmt.Errorf("%w: %s", errDuplicatePriceSource, source.Name())
+		}
+
+		names[source.Name()] = struct{}{}
+		sources = append(sources, source)
+	}
+
+	return &aggregatedPriceFeed{
+		logger:    logger.Named("price-feed"),
+		sources:   sources,
+		quorum:    quorum,
+		maxSpread: config.MaxSpread,
+	}, nil
 }
 
-func (p *priceFeed) GetPrice(header *types.Header) (*big.Int, error) {
-	price, err := getCoingeckoPrice(p.coinGeckoAPIKey)
-	if err != nil {
-		return nil, fmt.Errorf("get price from CoinGecko failed: %w", err)
+// GetPrice queries all the sources for the price of the day before the header,
+// enforces the quorum and the maximum spread and returns the median price
+func (p *aggregatedPriceFeed) GetPrice(header *types.Header) (*big.Int, error) {
+	now := time.Now()
+	if header != nil {
+		now = time.Unix(int64(header.Timestamp), 0) //nolint:gosec
+	}
+
+	date := priceDate(now)
+	prices, failures := p.fetchPrices(date)
+
+	for _, failure := range failures {
+		p.logger.Warn("price source failed", "source", failure.Source, "err", failure.Err)
+	}
+
+	if uint64(len(prices)) < p.quorum {
+		return nil, fmt.Errorf("price quorum not reached (%d/%d sources responded): %w",
+			len(prices), p.quorum, joinSourceErrors(failures))
+	}
+
+	median := calcMedian(prices)
+
+	if p.maxSpread > 0 {
+		spread := calcSpread(prices, median)
+		if spread > p.maxSpread {
+			return nil, fmt.Errorf("price spread %.2f%% exceeds the allowed maximum of %.2f%%",
+				spread, p.maxSpread)
+		}
 	}
 
-	return price, nil
+	p.logger.Debug("aggregated price", "date", date.Format("2006-01-02"), "price", median, "responded", len(prices), "failed", len(failures))
+
+	return median, nil
 }
 
-type PriceDataCoinGecko struct {
-	ID         string `json:"id"`
-	Symbol     string `json:"symbol"`
-	Name       string `json:"name"`
-	MarketData struct {
-		CurrentPrice struct {
-			USD float64 `json:"usd"`
-		} `json:"current_price"`
-	} `json:"market_data"`
+// fetchPrices queries all the sources concurrently for the price of the same day and returns
+// the successfully fetched prices along with the sources that failed
+func (p *aggregatedPriceFeed) fetchPrices(date time.Time) ([]*big.Int, []*SourceError) {
+	var (
+		wg       sync.WaitGroup
+		prices   = make([]*big.Int, len(p.sources))
+		errs     = make([]error, len(p.sources))
+		failures []*SourceError
+		result   []*big.Int
+	)
+
+	for i, source := range p.sources {
+		wg.Add(1)
+
+		go func(i int, source PriceSource) {
+			defer wg.Done()
+
+			price, err := source.FetchPrice(date)
+			if err == nil && (price == nil || price.Sign() <= 0) {
+				err = fmt.Errorf("invalid price %v", price)
+			}
+
+			prices[i], errs[i] = price, err
+		}(i, source)
+	}
+
+	wg.Wait()
+
+	for i, source := range p.sources {
+		if errs[i] != nil {
+			failures = append(failures, &SourceError{Source: source.Name(), Err: errs[i]})
+
+			continue
+		}
+
+		result = append(result, prices[i])
+	}
+
+	return result, failures
 }
 
-// getCoingeckoPrice fetches the current price of the Hydra cryptocurrency from the CoinGecko API.
-// It takes a timeout as input to wait for the request, in minutes.
-// It returns a big.Int representing the average price for the previous day.
-func getCoingeckoPrice(apiKey string) (*big.Int, error) {
-	yesterday := getYesterdayFormatted()
-	apiURL := fmt.Sprintf(`https://api.coingecko.com/api/v3/coins/hydra/history?date=%s`, yesterday)
+// calcMedian returns the median of the given (non-empty) prices.
+// For an even number of prices the average of the two middle values is returned
+func calcMedian(prices []*big.Int) *big.Int {
+	sorted := make([]*big.Int, len(prices))
+	copy(sorted, prices)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
 
-	req, err := common.GenerateThirdPartyJSONRequest(apiURL)
-	if err != nil {
-		return nil, err
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
 	}
 
-	// Add the key in the header
-	req.Header.Add("x-cg-demo-api-key", apiKey)
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
 
-	body, err := common.FetchData(req)
-	if err != nil {
-		return nil, err
+	return sum.Div(sum, big.NewInt(2))
+}
+
+// calcSpread returns the difference between the highest and the lowest price
+// in percents of the median
+func calcSpread(prices []*big.Int, median *big.Int) float64 {
+	if median.Sign() == 0 {
+		return 0
 	}
 
-	var priceData PriceDataCoinGecko
+	lowest, highest := prices[0], prices[0]
+
+	for _, price := range prices[1:] {
+		if price.Cmp(lowest) < 0 {
+			lowest = price
+		}
 
-	err = json.Unmarshal(body, &priceData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		if price.Cmp(highest) > 0 {
+			highest = price
+		}
 	}
 
-	price := priceData.MarketData.CurrentPrice.USD
+	diff := new(big.Float).SetInt(new(big.Int).Sub(highest, lowest))
+	spread, _ := diff.Quo(diff, new(big.Float).SetInt(median)).Float64()
 
-	return common.ConvertFloatToBigInt(price, 8)
+	return spread * 100
 }
 
-// getYesterdayFormatted returns the date in the format dd-mm-yyyy for the previous day.
-func getYesterdayFormatted() string {
-	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+func joinSourceErrors(failures []*SourceError) error {
+	if len(failures) == 0 {
+		return errors.New("no failed sources")
+	}
+
+	names := make([]string, len(failures))
+	errs := make([]error, len(failures))
+
+	for i, failure := range failures {
+		names[i] = failure.Source
+		errs[i] = failure
+	}
 
-	return yesterday.Format("02-01-2006")
+	return fmt.Errorf("failed sources [%s]: %w", strings.Join(names, ", "), errors.Join(errs...))
 }
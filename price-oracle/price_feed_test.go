@@ -0,0 +1,322 @@
+package priceoracle
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+// staticPriceSource is a price source returning a predefined price or error
+type staticPriceSource struct {
+	name  string
+	price *big.Int
+	err   error
+}
+
+func (s *staticPriceSource) Name() string {
+	return s.name
+}
+
+func (s *staticPriceSource) FetchPrice(_ time.Time) (*big.Int, error) {
+	return s.price, s.err
+}
+
+func writePriceFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "price")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestCalcMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		prices   []int64
+		expected int64
+	}{
+		{name: "single price", prices: []int64{5}, expected: 5},
+		{name: "odd number of prices", prices: []int64{9, 1, 5}, expected: 5},
+		{name: "even number of prices", prices: []int64{10, 2, 4, 8}, expected: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices := make([]*big.Int, len(tt.prices))
+			for i, p := range tt.prices {
+				prices[i] = big.NewInt(p)
+			}
+
+			require.Equal(t, big.NewInt(tt.expected), calcMedian(prices))
+			// the input must not be reordered
+			require.Equal(t, big.NewInt(tt.prices[0]), prices[0])
+		})
+	}
+}
+
+func TestAggregatedPriceFeed_GetPrice(t *testing.T) {
+	sourceErr := errors.New("rate limited")
+
+	tests := []struct {
+		name          string
+		sources       []PriceSource
+		quorum        uint64
+		maxSpread     float64
+		expectedPrice *big.Int
+		expectedErr   string
+	}{
+		{
+			name: "median of all sources",
+			sources: []PriceSource{
+				&staticPriceSource{name: "a", price: big.NewInt(100)},
+				&staticPriceSource{name: "b", price: big.NewInt(102)},
+				&staticPriceSource{name: "c", price: big.NewInt(101)},
+			},
+			quorum:        2,
+			expectedPrice: big.NewInt(101),
+		},
+		{
+			name: "failed source is skipped",
+			sources: []PriceSource{
+				&staticPriceSource{name: "a", price: big.NewInt(100)},
+				&staticPriceSource{name: "b", err: sourceErr},
+				&staticPriceSource{name: "c", price: big.NewInt(104)},
+			},
+			quorum:        2,
+			expectedPrice: big.NewInt(102),
+		},
+		{
+			name: "quorum not reached",
+			sources: []PriceSource{
+				&staticPriceSource{name: "a", price: big.NewInt(100)},
+				&staticPriceSource{name: "b", err: sourceErr},
+				&staticPriceSource{name: "c", price: big.NewInt(0)},
+			},
+			quorum:      2,
+			expectedErr: "price quorum not reached (1/2 sources responded): failed sources [b, c]",
+		},
+		{
+			name: "spread too high",
+			sources: []PriceSource{
+				&staticPriceSource{name: "a", price: big.NewInt(100)},
+				&staticPriceSource{name: "b", price: big.NewInt(120)},
+			},
+			quorum:      2,
+			maxSpread:   5,
+			expectedErr: "price spread 18.18% exceeds the allowed maximum of 5.00%",
+		},
+		{
+			name: "spread within limit",
+			sources: []PriceSource{
+				&staticPriceSource{name: "a", price: big.NewInt(100)},
+				&staticPriceSource{name: "b", price: big.NewInt(104)},
+			},
+			quorum:        2,
+			maxSpread:     5,
+			expectedPrice: big.NewInt(102),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := &aggregatedPriceFeed{
+				logger:    hclog.NewNullLogger(),
+				sources:   tt.sources,
+				quorum:    tt.quorum,
+				maxSpread: tt.maxSpread,
+			}
+
+			price, err := feed.GetPrice(nil)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				require.Nil(t, price)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedPrice, price)
+			}
+		})
+	}
+}
+
+func TestNewPriceFeed(t *testing.T) {
+	t.Run("defaults to coingecko with key from secrets config", func(t *testing.T) {
+		feed, err := NewPriceFeed(hclog.NewNullLogger(), nil, &secrets.SecretsManagerConfig{
+			Extra: map[string]interface{}{secrets.CoinGeckoAPIKey: "key"},
+		})
+		require.NoError(t, err)
+
+		aggregated, ok := feed.(*aggregatedPriceFeed)
+		require.True(t, ok)
+		require.Len(t, aggregated.sources, 1)
+		require.Equal(t, string(CoinGecko), aggregated.sources[0].Name())
+		require.Equal(t, uint64(1), aggregated.quorum)
+	})
+
+	t.Run("missing coingecko key", func(t *testing.T) {
+		_, err := NewPriceFeed(hclog.NewNullLogger(), nil, &secrets.SecretsManagerConfig{})
+		require.ErrorContains(t, err, secrets.CoinGeckoAPIKey+" is not a string")
+	})
+
+	t.Run("sources from secrets config extra", func(t *testing.T) {
+		feed, err := NewPriceFeed(hclog.NewNullLogger(), nil, &secrets.SecretsManagerConfig{
+			Extra: map[string]interface{}{
+				secrets.CoinMarketCapAPIKey: "cmc-key",
+				secrets.PriceFeed: map[string]interface{}{
+					"sources": []interface{}{
+						map[string]interface{}{"type": "coinmarketcap"},
+						map[string]interface{}{"type": "file", "name": "local", "file": "/tmp/price"},
+					},
+					"quorum":     1,
+					"max_spread": 2.5,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		aggregated, ok := feed.(*aggregatedPriceFeed)
+		require.True(t, ok)
+		require.Len(t, aggregated.sources, 2)
+		require.Equal(t, "local", aggregated.sources[1].Name())
+		require.Equal(t, uint64(1), aggregated.quorum)
+		require.Equal(t, 2.5, aggregated.maxSpread)
+	})
+
+	t.Run("invalid configurations", func(t *testing.T) {
+		_, err := NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: LocalFile, File: "a"}},
+			Quorum:  2,
+		}, nil)
+		require.ErrorIs(t, err, errInvalidPriceQuorum)
+
+		_, err = NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: "unknown"}},
+		}, nil)
+		require.ErrorIs(t, err, errPriceSourceTypeUnknown)
+
+		_, err = NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: LocalFile, File: "a"}, {Type: LocalFile, File: "b"}},
+		}, nil)
+		require.ErrorIs(t, err, errDuplicatePriceSource)
+
+		_, err = NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: JSONHTTP, URL: "http://localhost/{date}"}},
+		}, nil)
+		require.ErrorIs(t, err, errPriceSourcePathMissing)
+
+		_, err = NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: JSONHTTP, URL: "http://localhost", JSONPath: "price"}},
+		}, nil)
+		require.ErrorIs(t, err, errPriceSourceDateMissing)
+	})
+}
+
+func TestPriceSources_FetchPrice(t *testing.T) {
+	// the block of 2024-03-02 votes for the price of 2024-03-01
+	header := &types.Header{Timestamp: uint64(time.Date(2024, 3, 2, 0, 40, 0, 0, time.UTC).Unix())}
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/json":
+			if r.URL.Query().Get("at") != "1709337599" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"data":[{"price":"0.01234567"}]}`))
+		case "/cmc":
+			if r.Header.Get("X-CMC_PRO_API_KEY") != "cmc-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+
+			if r.URL.Query().Get("time_end") != "2024-03-01" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"data":{"HYDRA":[{"quotes":[{"quote":{"USD":{"price":1.5}}}]}]}}`))
+		case "/coingecko":
+			if r.URL.Query().Get("date") != "01-03-2024" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{"market_data":{"current_price":{"usd":0.25}}}`))
+		}
+	}))
+	defer server.Close()
+
+	feed, err := NewPriceFeed(hclog.NewNullLogger(), &PriceFeedConfig{
+		Sources: []*PriceSourceConfig{
+			{Type: JSONHTTP, URL: server.URL + "/json?at={timestamp}", JSONPath: "data.0.price"},
+			{Type: CoinMarketCap, URL: server.URL + "/cmc?time_end={date}", APIKey: "cmc-key"},
+			{Type: CoinGecko, URL: server.URL + "/coingecko", APIKey: "cg-key"},
+			{Type: LocalFile, Name: "plain", File: writePriceFile(t, "2\n")},
+			{Type: LocalFile, Name: "json", File: writePriceFile(t, `{"usd":3}`), JSONPath: "usd"},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	aggregated, ok := feed.(*aggregatedPriceFeed)
+	require.True(t, ok)
+
+	expected := []*big.Int{
+		big.NewInt(1234567),
+		big.NewInt(150000000),
+		big.NewInt(25000000),
+		big.NewInt(200000000),
+		big.NewInt(300000000),
+	}
+
+	for i, source := range aggregated.sources {
+		price, err := source.FetchPrice(date)
+		require.NoError(t, err, source.Name())
+		require.Equal(t, expected[i], price, source.Name())
+	}
+
+	price, err := feed.GetPrice(header)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(150000000), price)
+}
+
+func TestPriceDate(t *testing.T) {
+	require.Equal(t,
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		priceDate(time.Date(2024, 3, 1, 23, 59, 59, 0, time.UTC)),
+	)
+	require.Equal(t,
+		"https://example.com/price?date=2024-02-29&ts=1709251199",
+		formatPriceURL("https://example.com/price?date={date}&ts={timestamp}", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)),
+	)
+}
+
+func TestExtractJSONPrice(t *testing.T) {
+	_, err := extractJSONPrice([]byte(`{"a":{"b":[1]}}`), "a.c")
+	require.ErrorContains(t, err, "key c not found")
+
+	_, err = extractJSONPrice([]byte(`{"a":{"b":[1]}}`), "a.b.1")
+	require.ErrorContains(t, err, "invalid array index 1")
+
+	_, err = extractJSONPrice([]byte(`{"a":{"b":[true]}}`), "a.b.0")
+	require.ErrorContains(t, err, "value is not a number")
+
+	price, err := extractJSONPrice([]byte(`{"a":{"b":[0.5]}}`), "a.b.0")
+	require.NoError(t, err)
+	require.Equal(t, 0.5, price)
+}
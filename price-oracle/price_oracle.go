@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
@@ -25,10 +27,7 @@ import (
 )
 
 var (
-	// hasExecutedForDay mapping will indicate when there is no need to vote for a given day
-	hasExecutedForDay   = make(map[uint64]bool)
-	calculatedDayNumber = make(map[uint64]uint64)
-	priceVotedEventABI  = contractsapi.PriceOracle.Abi.Events["PriceVoted"]
+	priceVotedEventABI = contractsapi.PriceOracle.Abi.Events["PriceVoted"]
 )
 
 // emit PriceVoted(_price, msg.sender, day);
@@ -49,6 +48,8 @@ type blockchainBackend interface {
 	SubscribeEvents() blockchain.Subscription
 	// UnubscribeEvents unsubscribes from blockchain events
 	UnubscribeEvents(subscription blockchain.Subscription)
+	// GetReceiptsByHash retrieves receipts by hash
+	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
 }
 
 // polybftBackend is an interface defining polybft methods needed by fsm and sync tracker
@@ -64,10 +65,12 @@ type PriceOracle struct {
 	blockchain     blockchainBackend
 	polybftBackend polybftBackend
 	stateProvider  PriceOracleStateProvider
-	// key encapsulates ECDSA signing account
-	account   *wallet.Account
+	// key signs the vote transactions with the validator ECDSA key (local or held by the remote signer)
+	key       ethgo.Key
 	priceFeed PriceFeed
 	txRelayer txrelayer.TxRelayer
+	// voteStore persists the per-day vote state
+	voteStore *VoteStore
 }
 
 func NewPriceOracle(
@@ -78,8 +81,10 @@ func NewPriceOracle(
 	jsonRPC string,
 	secretsManager secrets.SecretsManager,
 	secretsManagerConfig *secrets.SecretsManagerConfig,
+	priceFeedConfig *PriceFeedConfig,
+	stateDir string,
 ) (*PriceOracle, error) {
-	priceFeed, err := NewPriceFeed(secretsManagerConfig)
+	priceFeed, err := NewPriceFeed(logger, priceFeedConfig, secretsManagerConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +95,7 @@ func NewPriceOracle(
 	}
 
 	// read account
-	account, err := wallet.NewAccountFromSecret(secretsManager)
+	validatorKey, err := wallet.NewKeyFromSecret(secretsManager)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read account data. Error: %w", err)
 	}
@@ -102,6 +107,15 @@ func NewPriceOracle(
 		return nil, err
 	}
 
+	if err := common.CreateDirSafe(stateDir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create price oracle state directory: %w", err)
+	}
+
+	voteStore, err := newVoteStore(filepath.Join(stateDir, voteStoreFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open price oracle state: %w", err)
+	}
+
 	return &PriceOracle{
 		logger:         logger.Named("price-oracle"),
 		blockchain:     blockchainBackend,
@@ -109,7 +123,8 @@ func NewPriceOracle(
 		priceFeed:      priceFeed,
 		polybftBackend: polybftConsensus,
 		txRelayer:      txRelayer,
-		account:        account,
+		key:            wallet.NewEcdsaSigner(validatorKey),
+		voteStore:      voteStore,
 		closeCh:        make(chan struct{}),
 	}, nil
 }
@@ -140,6 +155,11 @@ func (p *PriceOracle) StartOracleProcess() {
 
 func (p *PriceOracle) Close() error {
 	close(p.closeCh)
+
+	if err := p.voteStore.close(); err != nil {
+		p.logger.Error("failed to close price oracle state", "err", err)
+	}
+
 	p.logger.Info("price oracle stopped")
 
 	return nil
@@ -149,6 +169,20 @@ func (p *PriceOracle) handleEvent(ev *blockchain.Event) {
 	block := ev.NewChain[0]
 	p.logger.Debug("received new block notification", "block", block.Number)
 
+	// the votes are recorded from every canonical block, including the blocks received
+	// during the sync and outside the voting window, so the vote history has no gaps
+	if ev.Type != blockchain.EventFork {
+		for _, header := range ev.NewChain {
+			if err := p.recordVotes(header); err != nil {
+				p.logger.Error("failed to record price votes", "block", header.Number, "err", err)
+			}
+		}
+	}
+
+	if !p.blockMustBeProcessed(ev) {
+		return
+	}
+
 	isValidator, err := p.isValidator(block)
 	if err != nil {
 		p.logger.Error("failed to check if node is validator", "err", err)
@@ -156,7 +190,7 @@ func (p *PriceOracle) handleEvent(ev *blockchain.Event) {
 		return
 	}
 
-	if !isValidator || !p.blockMustBeProcessed(ev) {
+	if !isValidator {
 		return
 	}
 
@@ -180,27 +214,45 @@ func (p *PriceOracle) handleEvent(ev *blockchain.Event) {
 
 // shouldExecuteVote verifies that the validator should vote
 func (p *PriceOracle) shouldExecuteVote(header *types.Header) (bool, error) {
+	dayNumber := calcDayNumber(header.Timestamp)
+
 	// check if the current time is in the voting window
 	if !isVotingTime(header.Timestamp) {
 		p.logger.Debug("Not currently in voting time window")
 
+		if isVotingWindowClosed(header.Timestamp) {
+			if err := p.expirePendingVote(dayNumber); err != nil {
+				return false, err
+			}
+		}
+
 		return false, nil
 	}
 
+	dayVote, err := p.voteStore.getVote(dayNumber)
+	if err != nil {
+		return false, fmt.Errorf("get vote state: %w", err)
+	}
+
 	// check if there is a need to execute the vote
-	if p.hasExecutedForDay(header) {
+	if dayVote != nil && dayVote.isDone() {
+		return false, nil
+	}
+
+	// wait for the backoff period of the previous failed vote to pass
+	if dayVote != nil && header.Timestamp < dayVote.NextAttempt {
+		p.logger.Debug("waiting to retry the vote", "day", dayNumber, "nextAttempt", dayVote.NextAttempt)
+
 		return false, nil
 	}
 
 	// initialize the system state for the given header
-	state, err := p.stateProvider.GetPriceOracleState(header, p.account)
+	state, err := p.stateProvider.GetPriceOracleState(header, p.key)
 	if err != nil {
 		return false, fmt.Errorf("get system state: %w", err)
 	}
 
 	// then check if the contract is in a proper state to vote
-	dayNumber := calcDayNumber(header.Timestamp)
-
 	shouldVote, falseReason, err := state.shouldVote(dayNumber)
 	if err != nil {
 		return false, err
@@ -210,7 +262,13 @@ func (p *PriceOracle) shouldExecuteVote(header *types.Header) (bool, error) {
 		p.logger.Debug("should not vote", "reason", falseReason)
 
 		if falseReason == "PRICE_ALREADY_SET" {
-			hasExecutedForDay[dayNumber] = true
+			if _, err := p.voteStore.updateVote(dayNumber, func(vote *DayVote) {
+				if !vote.isDone() {
+					vote.Status = VoteSkipped
+				}
+			}); err != nil {
+				return false, fmt.Errorf("update vote state: %w", err)
+			}
 		}
 
 		return false, nil
@@ -229,7 +287,7 @@ func (p *PriceOracle) isValidator(block *types.Header) (bool, error) {
 		)
 	}
 
-	return currentValidators.ContainsNodeID(p.account.Address().String()), nil
+	return currentValidators.ContainsNodeID(p.key.Address().String()), nil
 }
 
 // 1. Skip checking older blocks to ensure bulk synchronization remains fast.
@@ -243,51 +301,171 @@ func (p *PriceOracle) blockMustBeProcessed(ev *blockchain.Event) bool {
 		block.Number >= p.blockchain.CurrentHeader().Number && (ev.Type != blockchain.EventFork)
 }
 
-func (p *PriceOracle) hasExecutedForDay(header *types.Header) bool {
-	return hasExecutedForDay[calcDayNumber(header.Timestamp)]
-}
-
-// executeVote get the price from the price feed and votes
+// executeVote get the price from the price feed and votes.
+// The outcome of the vote is stored, so a failed vote is retried with backoff on a later block
 func (p *PriceOracle) executeVote(header *types.Header) error {
+	dayNumber := calcDayNumber(header.Timestamp)
+
 	price, err := p.priceFeed.GetPrice(header)
 	if err != nil {
-		return fmt.Errorf("get price: %w", err)
+		err = fmt.Errorf("get price: %w", err)
+		p.recordVoteAttempt(dayNumber, header.Timestamp, nil, nil, err)
+
+		return err
+	}
+
+	receipt, err := p.vote(price)
+	if err != nil {
+		err = fmt.Errorf("failed to vote: %w", err)
+	}
+
+	p.recordVoteAttempt(dayNumber, header.Timestamp, price, receipt, err)
+
+	return err
+}
+
+// recordVoteAttempt stores the outcome of a vote attempt
+func (p *PriceOracle) recordVoteAttempt(
+	dayNumber, timestamp uint64,
+	price *big.Int,
+	receipt *ethgo.Receipt,
+	voteErr error,
+) {
+	_, err := p.voteStore.updateVote(dayNumber, func(vote *DayVote) {
+		vote.Attempts++
+		vote.LastAttempt = timestamp
+
+		if price != nil {
+			vote.Price = price
+		}
+
+		if receipt != nil {
+			status := receipt.Status
+			vote.TxHash = types.Hash(receipt.TransactionHash)
+			vote.ReceiptStatus = &status
+		}
+
+		if voteErr == nil {
+			vote.Status = VoteConfirmed
+			vote.LastError = ""
+			vote.NextAttempt = 0
+
+			return
+		}
+
+		vote.Status = VotePending
+		vote.LastError = voteErr.Error()
+		vote.NextAttempt = timestamp + calcRetryDelay(vote.Attempts)
+	})
+	if err != nil {
+		p.logger.Error("failed to store vote state", "day", dayNumber, "err", err)
 	}
+}
 
-	err = p.vote(price)
+// expirePendingVote marks the pending vote of the given day as expired
+func (p *PriceOracle) expirePendingVote(dayNumber uint64) error {
+	vote, err := p.voteStore.getVote(dayNumber)
 	if err != nil {
-		return fmt.Errorf("vote: failed %w", err)
+		return fmt.Errorf("get vote state: %w", err)
 	}
 
-	hasExecutedForDay[calcDayNumber(header.Timestamp)] = true
+	if vote == nil || vote.Status != VotePending {
+		return nil
+	}
+
+	p.logger.Warn("voting window closed before the vote succeeded", "day", dayNumber, "attempts", vote.Attempts)
+
+	_, err = p.voteStore.updateVote(dayNumber, func(vote *DayVote) {
+		vote.Status = VoteExpired
+		vote.NextAttempt = 0
+	})
+
+	return err
+}
+
+// recordVotes stores the PriceVoted events emitted in the given block
+func (p *PriceOracle) recordVotes(header *types.Header) error {
+	receipts, err := p.blockchain.GetReceiptsByHash(header.Hash)
+	if err != nil {
+		return err
+	}
+
+	for _, receipt := range receipts {
+		if receipt.Status == nil || *receipt.Status != types.ReceiptSuccess {
+			continue
+		}
+
+		for _, log := range receipt.Logs {
+			if log.Address != contracts.PriceOracleContract {
+				continue
+			}
+
+			var event contractsapi.PriceVotedEvent
+
+			doesMatch, err := event.ParseLog(convertLog(log))
+			if err != nil {
+				return fmt.Errorf("failed to parse log: %w", err)
+			}
+
+			if !doesMatch {
+				continue
+			}
+
+			validatorVote := &ValidatorVote{
+				Validator:   event.Validator,
+				Price:       event.Price,
+				BlockNumber: header.Number,
+				TxHash:      receipt.TxHash,
+			}
+
+			isOwnVote := event.Validator == types.Address(p.key.Address())
+
+			if _, err := p.voteStore.updateVote(event.Day.Uint64(), func(vote *DayVote) {
+				vote.addVote(validatorVote)
+
+				// our vote may be included even if waiting for its receipt failed
+				if isOwnVote && vote.Status != VoteConfirmed {
+					status := uint64(types.ReceiptSuccess)
+					vote.Status = VoteConfirmed
+					vote.Price = event.Price
+					vote.TxHash = receipt.TxHash
+					vote.ReceiptStatus = &status
+					vote.LastError = ""
+					vote.NextAttempt = 0
+				}
+			}); err != nil {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
 
-func (p *PriceOracle) vote(price *big.Int) error {
+func (p *PriceOracle) vote(price *big.Int) (*ethgo.Receipt, error) {
 	voteFn := &contractsapi.VotePriceOracleFn{
 		Price: price,
 	}
 
 	input, err := voteFn.EncodeAbi()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	txn := &ethgo.Transaction{
-		From:  p.account.Ecdsa.Address(),
+		From:  p.key.Address(),
 		Input: input,
 		To:    (*ethgo.Address)(&contracts.PriceOracleContract),
 		Gas:   1000000,
 	}
 
-	receipt, err := p.txRelayer.SendTransaction(txn, p.account.Ecdsa)
+	receipt, err := p.txRelayer.SendTransaction(txn, p.key)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if receipt.Status != uint64(types.ReceiptSuccess) {
-		return errors.New("vote transaction failed")
+		return receipt, errors.New("vote transaction failed")
 	}
 
 	result := &voteResult{}
@@ -297,7 +475,7 @@ func (p *PriceOracle) vote(price *big.Int) error {
 		if priceVotedEventABI.Match(log) {
 			event, err := priceVotedEventABI.ParseLog(log)
 			if err != nil {
-				return fmt.Errorf("failed to parse log: %w", err)
+				return receipt, fmt.Errorf("failed to parse log: %w", err)
 			}
 
 			result.price = event["price"].(*big.Int).String()                     //nolint:forcetypeassert
@@ -309,7 +487,7 @@ func (p *PriceOracle) vote(price *big.Int) error {
 	}
 
 	if !foundVoteLog {
-		return fmt.Errorf(
+		return receipt, fmt.Errorf(
 			"could not find an appropriate log in the receipt that validates the vote has happened",
 		)
 	}
@@ -321,7 +499,7 @@ func (p *PriceOracle) vote(price *big.Int) error {
 		result.day,
 	)
 
-	return nil
+	return receipt, nil
 }
 
 const (
@@ -330,6 +508,11 @@ const (
 	dailyVotingStartTime = uint64(36 * 60)                       // 36 minutes in seconds
 	dailyVotingEndTime   = dailyVotingStartTime + uint64(3*3600) // 3 hours in seconds
 	secondsInADay        = uint64(86400)
+
+	// voteRetryBaseDelay is the delay (in seconds) before the first retry of a failed vote.
+	// It doubles with every failed attempt, up to voteRetryMaxDelay
+	voteRetryBaseDelay = uint64(30)
+	voteRetryMaxDelay  = uint64(15 * 60)
 )
 
 func isVotingTime(timestamp uint64) bool {
@@ -340,21 +523,51 @@ func isVotingTime(timestamp uint64) bool {
 	return secondsInDay >= dailyVotingStartTime && secondsInDay < dailyVotingEndTime
 }
 
+// isVotingWindowClosed checks if the voting window of the day has already ended
+func isVotingWindowClosed(timestamp uint64) bool {
+	return timestamp%secondsInADay >= dailyVotingEndTime
+}
+
+// calcRetryDelay returns the delay (in seconds) before the next vote attempt
+// after the given number of failed attempts
+func calcRetryDelay(attempts uint64) uint64 {
+	delay := voteRetryBaseDelay
+
+	for i := uint64(1); i < attempts && delay < voteRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > voteRetryMaxDelay {
+		return voteRetryMaxDelay
+	}
+
+	return delay
+}
+
 // isBlockOlderThan checks if the block is older than the given number of minutes
 func isBlockOlderThan(header *types.Header, minutes int64) bool {
 	return time.Now().UTC().Unix()-int64(header.Timestamp) > minutes*60 //nolint:gosec
 }
 
 func calcDayNumber(timestamp uint64) uint64 {
-	if calculatedDayNumber[timestamp] != 0 {
-		return calculatedDayNumber[timestamp]
+	return timestamp / secondsInADay
+}
+
+// convertLog converts types.Log to ethgo.Log
+func convertLog(log *types.Log) *ethgo.Log {
+	l := &ethgo.Log{
+		Address: ethgo.Address(log.Address),
+		Data:    make([]byte, len(log.Data)),
+		Topics:  make([]ethgo.Hash, len(log.Topics)),
 	}
 
-	// Calculate the current day number
-	dayNumber := timestamp / secondsInADay
-	calculatedDayNumber[timestamp] = dayNumber
+	copy(l.Data, log.Data)
+
+	for i, topic := range log.Topics {
+		l.Topics[i] = ethgo.Hash(topic)
+	}
 
-	return dayNumber
+	return l
 }
 
 func getVoteTxRelayer(rpcEndpoint string) (txrelayer.TxRelayer, error) {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/mock"
@@ -18,8 +20,17 @@ import (
 	"github.com/umbracle/ethgo"
 )
 
-func setup() {
-	hasExecutedForDay = make(map[uint64]bool)
+func newTestVoteStore(t *testing.T) *VoteStore {
+	t.Helper()
+
+	store, err := newVoteStore(filepath.Join(t.TempDir(), voteStoreFileName))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store.close())
+	})
+
+	return store
 }
 
 func TestIsValidator(t *testing.T) {
@@ -39,7 +50,7 @@ func TestIsValidator(t *testing.T) {
 		name                string
 		block               *types.Header
 		validators          validator.AccountSet
-		account             *wallet.Account
+		key                 ethgo.Key
 		getValidatorsError  error
 		expectedIsValidator bool
 		expectedError       error
@@ -48,7 +59,7 @@ func TestIsValidator(t *testing.T) {
 			name:                "valid validator",
 			block:               block,
 			validators:          validatorSet,
-			account:             validators.GetValidator("B").Account,
+			key:                 validators.GetValidator("B").Account.Ecdsa,
 			getValidatorsError:  nil,
 			expectedIsValidator: true,
 			expectedError:       nil,
@@ -57,7 +68,7 @@ func TestIsValidator(t *testing.T) {
 			name:                "not a validator",
 			block:               block,
 			validators:          validatorSet,
-			account:             validator.NewTestValidator(t, "X", 1000).Account,
+			key:                 validator.NewTestValidator(t, "X", 1000).Account.Ecdsa,
 			getValidatorsError:  nil,
 			expectedIsValidator: false,
 			expectedError:       nil,
@@ -84,7 +95,7 @@ func TestIsValidator(t *testing.T) {
 
 			priceOracle := &PriceOracle{
 				polybftBackend: mockPolybftBackend,
-				account:        tt.account,
+				key:            tt.key,
 			}
 
 			isValidator, err := priceOracle.isValidator(tt.block)
@@ -317,21 +328,23 @@ func TestCalcDayNumber(t *testing.T) {
 func TestShouldExecuteVote(t *testing.T) {
 	mockState := new(MockState)
 	mockStateProvider := new(MockStateProvider)
-	mockAccount := &wallet.Account{}
+	mockKey := wallet.NewEcdsaSigner(&wallet.Key{})
 
 	txRelayer, _ := getVoteTxRelayer("0.0.0.0:8545")
 
 	priceOracle := &PriceOracle{
-		account:       mockAccount,
+		key:           mockKey,
 		txRelayer:     txRelayer,
 		logger:        hclog.NewNullLogger(),
 		stateProvider: mockStateProvider, // Inject the mock state provider
+		voteStore:     newTestVoteStore(t),
 	}
 
 	tests := []struct {
 		name               string
 		header             *types.Header
 		hasExecutedForDay  bool
+		nextAttempt        uint64
 		shouldMockState    bool
 		stateShouldVote    bool
 		stateShouldVoteErr error
@@ -360,6 +373,16 @@ func TestShouldExecuteVote(t *testing.T) {
 			expectedResult:    false,
 			expectedError:     nil,
 		},
+		{
+			name: "Should not vote because the retry backoff has not passed",
+			header: &types.Header{
+				Timestamp: uint64(time.Date(2024, 10, 21, 1, 0, 0, 0, time.UTC).Unix()),
+			},
+			nextAttempt:     uint64(time.Date(2024, 10, 21, 1, 0, 30, 0, time.UTC).Unix()),
+			shouldMockState: false,
+			expectedResult:  false,
+			expectedError:   nil,
+		},
 		{
 			name: "Should not vote based on state",
 			header: &types.Header{
@@ -398,13 +421,21 @@ func TestShouldExecuteVote(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Set the hasExecutedForDay state
+			// Set the vote state for the day
 			dayNumber := calcDayNumber(tt.header.Timestamp)
-			hasExecutedForDay[dayNumber] = tt.hasExecutedForDay
+			_, err := priceOracle.voteStore.updateVote(dayNumber, func(vote *DayVote) {
+				vote.Status = VotePending
+				if tt.hasExecutedForDay {
+					vote.Status = VoteConfirmed
+				}
+
+				vote.NextAttempt = tt.nextAttempt
+			})
+			require.NoError(t, err)
 
 			if tt.shouldMockState {
 				// Mock the GetPriceOracleState and shouldVote methods
-				mockStateProvider.On("GetPriceOracleState", tt.header, mockAccount).
+				mockStateProvider.On("GetPriceOracleState", tt.header, mockKey).
 					Return(mockState, nil).
 					Once()
 				mockState.On("shouldVote", dayNumber).
@@ -435,7 +466,7 @@ func TestVote(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       account.Ecdsa,
 		txRelayer: mockTxRelayer,
 		logger:    hclog.NewNullLogger(),
 	}
@@ -467,7 +498,7 @@ func TestVote(t *testing.T) {
 	mockTxRelayer.On("SendTransaction", mock.Anything, account.Ecdsa).Return(receipt, nil)
 
 	// Call the vote function
-	err = priceOracle.vote(expectedPrice)
+	_, err = priceOracle.vote(expectedPrice)
 
 	// Assert that no error occurred
 	require.NoError(t, err)
@@ -513,7 +544,7 @@ func TestVote_NegativeScenarios(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       account.Ecdsa,
 		txRelayer: mockTxRelayer,
 	}
 
@@ -575,7 +606,7 @@ func TestVote_NegativeScenarios(t *testing.T) {
 				Once()
 
 			// Call the vote function
-			err := priceOracle.vote(expectedPrice)
+			_, err := priceOracle.vote(expectedPrice)
 
 			// Assert that the expected error occurred
 			require.Error(t, err)
@@ -595,10 +626,11 @@ func TestExecuteVote(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       account.Ecdsa,
 		txRelayer: mockTxRelayer,
 		priceFeed: mockPriceFeed,
 		logger:    hclog.NewNullLogger(),
+		voteStore: newTestVoteStore(t),
 	}
 
 	header := &types.Header{Timestamp: 100000}
@@ -668,9 +700,13 @@ func TestExecuteVote(t *testing.T) {
 	// Assert that the appropriate log was found
 	require.True(t, foundVoteLog)
 
-	// Check if the hasExecutedForDay was updated
-	dayNumber := calcDayNumber(header.Timestamp)
-	require.True(t, hasExecutedForDay[dayNumber])
+	// Check if the vote state was updated
+	dayVote, err := priceOracle.voteStore.getVote(calcDayNumber(header.Timestamp))
+	require.NoError(t, err)
+	require.Equal(t, VoteConfirmed, dayVote.Status)
+	require.Equal(t, expectedPrice, dayVote.Price)
+	require.Equal(t, uint64(1), dayVote.Attempts)
+	require.True(t, dayVote.isDone())
 
 	// Assert that the mocks were called as expected
 	mockPriceFeed.AssertExpectations(t)
@@ -678,16 +714,16 @@ func TestExecuteVote(t *testing.T) {
 }
 
 func TestExecuteVote_PriceFeedError(t *testing.T) {
-	setup()
-
 	header := &types.Header{Timestamp: 100000}
 
 	mockPriceFeed := new(MockPriceFeed)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       account.Ecdsa,
 		txRelayer: new(MockTxRelayer), // No need to mock TxRelayer for this test
 		priceFeed: mockPriceFeed,
+		logger:    hclog.NewNullLogger(),
+		voteStore: newTestVoteStore(t),
 	}
 
 	// Mock the GetPrice to return an error
@@ -700,23 +736,28 @@ func TestExecuteVote_PriceFeedError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "price feed error")
 
-	// Check if the hasExecutedForDay not updated
-	require.True(t, len(hasExecutedForDay) == 0)
+	// Check if the failed vote is scheduled for a retry
+	dayVote, err := priceOracle.voteStore.getVote(calcDayNumber(header.Timestamp))
+	require.NoError(t, err)
+	require.Equal(t, VotePending, dayVote.Status)
+	require.Equal(t, uint64(1), dayVote.Attempts)
+	require.Equal(t, header.Timestamp+voteRetryBaseDelay, dayVote.NextAttempt)
+	require.Contains(t, dayVote.LastError, "price feed error")
 
 	// Assert that the mocks were called as expected
 	mockPriceFeed.AssertExpectations(t)
 }
 
 func TestExecuteVote_VoteError(t *testing.T) {
-	setup()
-
 	mockPriceFeed := new(MockPriceFeed)
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       account.Ecdsa,
 		txRelayer: mockTxRelayer,
 		priceFeed: mockPriceFeed,
+		logger:    hclog.NewNullLogger(),
+		voteStore: newTestVoteStore(t),
 	}
 
 	header := &types.Header{Timestamp: 100000}
@@ -734,12 +775,219 @@ func TestExecuteVote_VoteError(t *testing.T) {
 
 	// Assert that an error occurred
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "vote: failed vote error")
+	require.Contains(t, err.Error(), "failed to vote: vote error")
 
-	// Check if the hasExecutedForDay not updated
-	require.True(t, len(hasExecutedForDay) == 0)
+	// Check if the failed vote is scheduled for a retry
+	dayVote, err := priceOracle.voteStore.getVote(calcDayNumber(header.Timestamp))
+	require.NoError(t, err)
+	require.Equal(t, VotePending, dayVote.Status)
+	require.Equal(t, expectedPrice, dayVote.Price)
+	require.False(t, dayVote.isDone())
 
 	// Assert that the mocks were called as expected
 	mockPriceFeed.AssertExpectations(t)
 	mockTxRelayer.AssertExpectations(t)
 }
+
+func TestCalcRetryDelay(t *testing.T) {
+	require.Equal(t, voteRetryBaseDelay, calcRetryDelay(1))
+	require.Equal(t, 2*voteRetryBaseDelay, calcRetryDelay(2))
+	require.Equal(t, 8*voteRetryBaseDelay, calcRetryDelay(4))
+	require.Equal(t, voteRetryMaxDelay, calcRetryDelay(10))
+	require.Equal(t, voteRetryMaxDelay, calcRetryDelay(100))
+}
+
+func TestShouldExecuteVote_ExpiresPendingVote(t *testing.T) {
+	priceOracle := &PriceOracle{
+		logger:    hclog.NewNullLogger(),
+		voteStore: newTestVoteStore(t),
+	}
+
+	windowClosed := &types.Header{
+		Timestamp: uint64(time.Date(2024, 10, 21, 4, 0, 0, 0, time.UTC).Unix()),
+	}
+	dayNumber := calcDayNumber(windowClosed.Timestamp)
+
+	_, err := priceOracle.voteStore.updateVote(dayNumber, func(vote *DayVote) {
+		vote.Status = VotePending
+		vote.Attempts = 3
+		vote.NextAttempt = windowClosed.Timestamp - 60
+	})
+	require.NoError(t, err)
+
+	should, err := priceOracle.shouldExecuteVote(windowClosed)
+	require.NoError(t, err)
+	require.False(t, should)
+
+	dayVote, err := priceOracle.voteStore.getVote(dayNumber)
+	require.NoError(t, err)
+	require.Equal(t, VoteExpired, dayVote.Status)
+	require.Equal(t, uint64(3), dayVote.Attempts)
+	require.Zero(t, dayVote.NextAttempt)
+}
+
+func TestRecordVotes(t *testing.T) {
+	mockBlockchainBackend := new(MockBlockchainBackend)
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B"})
+	ownAccount := validators.GetValidator("A").Account
+	otherAccount := validators.GetValidator("B").Account
+
+	priceOracle := &PriceOracle{
+		logger:     hclog.NewNullLogger(),
+		blockchain: mockBlockchainBackend,
+		key:        ownAccount.Ecdsa,
+		voteStore:  newTestVoteStore(t),
+	}
+
+	newVoteLog := func(validator types.Address, price int64) *types.Log {
+		event := &contractsapi.PriceVotedEvent{
+			Price:     big.NewInt(price),
+			Validator: validator,
+			Day:       big.NewInt(20000),
+		}
+
+		data, err := event.Encode()
+		require.NoError(t, err)
+
+		return &types.Log{
+			Address: contracts.PriceOracleContract,
+			Topics:  []types.Hash{types.Hash(event.Sig())},
+			Data:    data,
+		}
+	}
+
+	success := types.ReceiptSuccess
+	failed := types.ReceiptFailed
+	header := &types.Header{Number: 10, Hash: types.StringToHash("0x10")}
+	receipts := []*types.Receipt{
+		{
+			Status: &success,
+			TxHash: types.StringToHash("0x1"),
+			Logs:   []*types.Log{newVoteLog(otherAccount.Address(), 100)},
+		},
+		{
+			Status: &failed,
+			TxHash: types.StringToHash("0x2"),
+			Logs:   []*types.Log{newVoteLog(otherAccount.Address(), 500)},
+		},
+		{
+			Status: &success,
+			TxHash: types.StringToHash("0x3"),
+			Logs:   []*types.Log{newVoteLog(ownAccount.Address(), 101)},
+		},
+	}
+
+	mockBlockchainBackend.On("GetReceiptsByHash", header.Hash).Return(receipts, nil).Once()
+
+	_, err := priceOracle.voteStore.updateVote(20000, func(vote *DayVote) {
+		vote.Status = VotePending
+		vote.LastError = "receipt timeout"
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, priceOracle.recordVotes(header))
+
+	dayVote, err := priceOracle.voteStore.getVote(20000)
+	require.NoError(t, err)
+	require.Len(t, dayVote.Votes, 2)
+	require.Equal(t, otherAccount.Address(), dayVote.Votes[0].Validator)
+	require.Equal(t, big.NewInt(100), dayVote.Votes[0].Price)
+	require.Equal(t, uint64(10), dayVote.Votes[0].BlockNumber)
+
+	// the own vote is confirmed by the event, even if the receipt was not received
+	require.Equal(t, VoteConfirmed, dayVote.Status)
+	require.Equal(t, types.StringToHash("0x3"), dayVote.TxHash)
+	require.Equal(t, big.NewInt(101), dayVote.Price)
+	require.Empty(t, dayVote.LastError)
+
+	mockBlockchainBackend.AssertExpectations(t)
+}
+
+func TestHandleEvent_RecordsVotesOfSyncedBlocks(t *testing.T) {
+	mockBlockchainBackend := new(MockBlockchainBackend)
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B"})
+	otherAccount := validators.GetValidator("B").Account
+
+	priceOracle := &PriceOracle{
+		logger:     hclog.NewNullLogger(),
+		blockchain: mockBlockchainBackend,
+		key:        validators.GetValidator("A").Account.Ecdsa,
+		voteStore:  newTestVoteStore(t),
+	}
+
+	event := &contractsapi.PriceVotedEvent{
+		Price:     big.NewInt(100),
+		Validator: otherAccount.Address(),
+		Day:       big.NewInt(20000),
+	}
+
+	data, err := event.Encode()
+	require.NoError(t, err)
+
+	success := types.ReceiptSuccess
+
+	// the blocks received during the sync are too old to vote on, but their votes are recorded
+	oldTimestamp := uint64(time.Now().Add(-time.Hour).UTC().Unix())
+	headers := []*types.Header{
+		{Number: 10, Hash: types.StringToHash("0x10"), Timestamp: oldTimestamp},
+		{Number: 11, Hash: types.StringToHash("0x11"), Timestamp: oldTimestamp},
+	}
+
+	mockBlockchainBackend.On("GetReceiptsByHash", headers[0].Hash).Return([]*types.Receipt{}, nil).Once()
+	mockBlockchainBackend.On("GetReceiptsByHash", headers[1].Hash).Return([]*types.Receipt{
+		{
+			Status: &success,
+			TxHash: types.StringToHash("0x1"),
+			Logs: []*types.Log{{
+				Address: contracts.PriceOracleContract,
+				Topics:  []types.Hash{types.Hash(event.Sig())},
+				Data:    data,
+			}},
+		},
+	}, nil).Once()
+
+	priceOracle.handleEvent(&blockchain.Event{NewChain: headers, Type: blockchain.EventHead})
+
+	dayVote, err := priceOracle.voteStore.getVote(20000)
+	require.NoError(t, err)
+	require.Len(t, dayVote.Votes, 1)
+	require.Equal(t, uint64(11), dayVote.Votes[0].BlockNumber)
+
+	// the votes of the fork blocks are not recorded
+	priceOracle.handleEvent(&blockchain.Event{NewChain: headers[1:], Type: blockchain.EventFork})
+
+	mockBlockchainBackend.AssertExpectations(t)
+}
+
+func TestGetPendingVote(t *testing.T) {
+	mockBlockchainBackend := new(MockBlockchainBackend)
+	header := &types.Header{
+		Timestamp: uint64(time.Date(2024, 10, 21, 1, 0, 0, 0, time.UTC).Unix()),
+	}
+
+	mockBlockchainBackend.On("CurrentHeader").Return(header)
+
+	priceOracle := &PriceOracle{
+		blockchain: mockBlockchainBackend,
+		voteStore:  newTestVoteStore(t),
+	}
+
+	pending, err := priceOracle.GetPendingVote()
+	require.NoError(t, err)
+	require.Nil(t, pending)
+
+	_, err = priceOracle.voteStore.updateVote(calcDayNumber(header.Timestamp), func(vote *DayVote) {
+		vote.Status = VotePending
+		vote.Attempts = 1
+	})
+	require.NoError(t, err)
+
+	pending, err = priceOracle.GetPendingVote()
+	require.NoError(t, err)
+	require.NotNil(t, pending)
+	require.Equal(t, uint64(1), pending.Attempts)
+
+	lastVote, err := priceOracle.voteStore.getLastVote()
+	require.NoError(t, err)
+	require.Equal(t, pending, lastVote)
+}
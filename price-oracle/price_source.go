@@ -0,0 +1,433 @@
+package priceoracle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const (
+	// pricePrecision is the number of decimals of the prices submitted to the price oracle
+	pricePrecision = 8
+
+	coinGeckoDefaultURL     = "https://api.coingecko.com/api/v3/coins/hydra/history"
+	coinMarketCapDefaultURL = "https://pro-api.coinmarketcap.com/v2/cryptocurrency/quotes/historical" +
+		"?symbol=HYDRA&interval=daily&count=1&time_end=" + priceDatePlaceholder
+	coinMarketCapPricePath = "data.HYDRA.0.quotes.0.quote.USD.price"
+
+	// priceDatePlaceholder is replaced in the source URLs with the price date (yyyy-mm-dd)
+	priceDatePlaceholder = "{date}"
+	// priceTimestampPlaceholder is replaced in the source URLs with the unix timestamp
+	// of the end of the price date
+	priceTimestampPlaceholder = "{timestamp}"
+)
+
+var (
+	errPriceSourceTypeUnknown = errors.New("unknown price source type")
+	errPriceSourceURLMissing  = errors.New("price source url is not set")
+	errPriceSourcePathMissing = errors.New("price source json path is not set")
+	errPriceSourceFileMissing = errors.New("price source file is not set")
+	errPriceSourceDateMissing = errors.New("price source url must contain the " +
+		priceDatePlaceholder + " or " + priceTimestampPlaceholder + " placeholder")
+)
+
+type PriceSourceType string
+
+const (
+	// CoinGecko fetches the previous day price from the CoinGecko history API
+	CoinGecko PriceSourceType = "coingecko"
+
+	// CoinMarketCap fetches the previous day price from the CoinMarketCap historical quotes API
+	CoinMarketCap PriceSourceType = "coinmarketcap"
+
+	// JSONHTTP fetches a JSON document from an arbitrary HTTP endpoint
+	// and reads the price from the configured JSON path. The URL must contain
+	// the price date placeholder, so every source reports the price of the same day
+	JSONHTTP PriceSourceType = "json-http"
+
+	// LocalFile reads the price from a local file, regardless of the price date. Intended for testing
+	LocalFile PriceSourceType = "file"
+)
+
+// PriceSource is a single provider of the HYDRA price
+type PriceSource interface {
+	// Name returns the unique name of the source, used for reporting
+	Name() string
+	// FetchPrice returns the USD price per 1 HYDRA of the given UTC day with 8 decimals precision
+	FetchPrice(date time.Time) (*big.Int, error)
+}
+
+// PriceSourceConfig is the configuration of a single price source
+type PriceSourceConfig struct {
+	// Type is the type of the source
+	Type PriceSourceType `json:"type" yaml:"type"`
+	// Name is the name of the source. Defaults to the source type
+	Name string `json:"name" yaml:"name"`
+	// URL overrides the default endpoint of the source (required for json-http).
+	// The {date} and {timestamp} placeholders are replaced with the price date
+	URL string `json:"url" yaml:"url"`
+	// JSONPath is the dot separated path of the price in the JSON response,
+	// e.g. "data.0.quote.USD.price" (required for json-http, optional for file)
+	JSONPath string `json:"json_path" yaml:"json_path"`
+	// APIKey overrides the API key read from the secrets manager configuration
+	APIKey string `json:"api_key" yaml:"api_key"`
+	// Headers are additional HTTP headers sent with the request
+	Headers map[string]string `json:"headers" yaml:"headers"`
+	// File is the path of the file read by the file source
+	File string `json:"file" yaml:"file"`
+}
+
+// PriceSourceFactory creates a price source from its configuration.
+// extra holds the secrets manager configuration extra data (API keys)
+type PriceSourceFactory func(config *PriceSourceConfig, extra map[string]interface{}) (PriceSource, error)
+
+var (
+	// priceSourceFactories holds the factories of the supported price source types
+	priceSourceFactories = map[PriceSourceType]PriceSourceFactory{
+		CoinGecko:     newCoinGeckoSource,
+		CoinMarketCap: newCoinMarketCapSource,
+		JSONHTTP:      newJSONHTTPSource,
+		LocalFile:     newFileSource,
+	}
+	priceSourceFactoriesLock sync.RWMutex
+)
+
+// RegisterPriceSource registers (or replaces) the factory of the given price source type [Thread safe]
+func RegisterPriceSource(sourceType PriceSourceType, factory PriceSourceFactory) {
+	priceSourceFactoriesLock.Lock()
+	defer priceSourceFactoriesLock.Unlock()
+
+	priceSourceFactories[sourceType] = factory
+}
+
+func newPriceSource(config *PriceSourceConfig, extra map[string]interface{}) (PriceSource, error) {
+	if config == nil {
+		return nil, errPriceSourceTypeUnknown
+	}
+
+	priceSourceFactoriesLock.RLock()
+	factory, ok := priceSourceFactories[config.Type]
+	priceSourceFactoriesLock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", errPriceSourceTypeUnknown, config.Type)
+	}
+
+	return factory(config, extra)
+}
+
+// priceFeedConfigFromExtra reads the price feed configuration from the secrets manager
+// configuration extra data. If it is not present, a single CoinGecko source is configured
+func priceFeedConfigFromExtra(extra map[string]interface{}) (*PriceFeedConfig, error) {
+	raw, ok := extra[secrets.PriceFeed]
+	if !ok {
+		return &PriceFeedConfig{
+			Sources: []*PriceSourceConfig{{Type: CoinGecko}},
+		}, nil
+	}
+
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s configuration: %w", secrets.PriceFeed, err)
+	}
+
+	config := &PriceFeedConfig{}
+	if err := json.Unmarshal(rawJSON, config); err != nil {
+		return nil, fmt.Errorf("invalid %s configuration: %w", secrets.PriceFeed, err)
+	}
+
+	return config, nil
+}
+
+// sourceName returns the configured source name or the source type if not set
+func sourceName(config *PriceSourceConfig) string {
+	if config.Name != "" {
+		return config.Name
+	}
+
+	return string(config.Type)
+}
+
+// apiKeyFromExtra returns the API key from the source config,
+// or if not set, the one stored under the given secrets extra key
+func apiKeyFromExtra(config *PriceSourceConfig, extra map[string]interface{}, key string) (string, error) {
+	if config.APIKey != "" {
+		return config.APIKey, nil
+	}
+
+	apiKey, ok := extra[key].(string)
+	if !ok {
+		return "", fmt.Errorf(key + " is not a string")
+	}
+
+	return apiKey, nil
+}
+
+type coinGeckoSource struct {
+	name   string
+	url    string
+	apiKey string
+}
+
+func newCoinGeckoSource(config *PriceSourceConfig, extra map[string]interface{}) (PriceSource, error) {
+	apiKey, err := apiKeyFromExtra(config, extra, secrets.CoinGeckoAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	url := config.URL
+	if url == "" {
+		url = coinGeckoDefaultURL
+	}
+
+	return &coinGeckoSource{name: sourceName(config), url: url, apiKey: apiKey}, nil
+}
+
+func (c *coinGeckoSource) Name() string {
+	return c.name
+}
+
+func (c *coinGeckoSource) FetchPrice(date time.Time) (*big.Int, error) {
+	return getCoingeckoPrice(c.url, c.apiKey, date)
+}
+
+type PriceDataCoinGecko struct {
+	ID         string `json:"id"`
+	Symbol     string `json:"symbol"`
+	Name       string `json:"name"`
+	MarketData struct {
+		CurrentPrice struct {
+			USD float64 `json:"usd"`
+		} `json:"current_price"`
+	} `json:"market_data"`
+}
+
+// getCoingeckoPrice fetches the price of the Hydra cryptocurrency from the CoinGecko history API.
+// It returns a big.Int representing the average price for the given day.
+func getCoingeckoPrice(url string, apiKey string, date time.Time) (*big.Int, error) {
+	apiURL := fmt.Sprintf(`%s?date=%s`, url, date.UTC().Format("02-01-2006"))
+
+	req, err := common.GenerateThirdPartyJSONRequest(apiURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the key in the header
+	req.Header.Add("x-cg-demo-api-key", apiKey)
+
+	body, err := common.FetchData(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var priceData PriceDataCoinGecko
+
+	err = json.Unmarshal(body, &priceData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	price := priceData.MarketData.CurrentPrice.USD
+
+	return common.ConvertFloatToBigInt(price, pricePrecision)
+}
+
+// priceDate returns the UTC day before the given timestamp, the price of which is voted for
+func priceDate(timestamp time.Time) time.Time {
+	year, month, day := timestamp.UTC().AddDate(0, 0, -1).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// formatPriceURL replaces the price date placeholders in the source URL
+func formatPriceURL(url string, date time.Time) string {
+	endOfDay := date.UTC().AddDate(0, 0, 1).Add(-time.Second)
+
+	return strings.NewReplacer(
+		priceDatePlaceholder, date.UTC().Format("2006-01-02"),
+		priceTimestampPlaceholder, strconv.FormatInt(endOfDay.Unix(), 10),
+	).Replace(url)
+}
+
+// hasPriceDate checks the source URL contains a price date placeholder
+func hasPriceDate(url string) bool {
+	return strings.Contains(url, priceDatePlaceholder) || strings.Contains(url, priceTimestampPlaceholder)
+}
+
+// jsonHTTPSource fetches a JSON document over HTTP and reads the price from a JSON path
+type jsonHTTPSource struct {
+	name     string
+	url      string
+	jsonPath string
+	headers  map[string]string
+}
+
+func newJSONHTTPSource(config *PriceSourceConfig, _ map[string]interface{}) (PriceSource, error) {
+	if config.URL == "" {
+		return nil, errPriceSourceURLMissing
+	}
+
+	if !hasPriceDate(config.URL) {
+		return nil, errPriceSourceDateMissing
+	}
+
+	if config.JSONPath == "" {
+		return nil, errPriceSourcePathMissing
+	}
+
+	return &jsonHTTPSource{
+		name:     sourceName(config),
+		url:      config.URL,
+		jsonPath: config.JSONPath,
+		headers:  config.Headers,
+	}, nil
+}
+
+func newCoinMarketCapSource(config *PriceSourceConfig, extra map[string]interface{}) (PriceSource, error) {
+	apiKey, err := apiKeyFromExtra(config, extra, secrets.CoinMarketCapAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	url := config.URL
+	if url == "" {
+		url = coinMarketCapDefaultURL
+	}
+
+	if !hasPriceDate(url) {
+		return nil, errPriceSourceDateMissing
+	}
+
+	headers := map[string]string{"X-CMC_PRO_API_KEY": apiKey}
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+
+	return &jsonHTTPSource{
+		name:     sourceName(config),
+		url:      url,
+		jsonPath: coinMarketCapPricePath,
+		headers:  headers,
+	}, nil
+}
+
+func (j *jsonHTTPSource) Name() string {
+	return j.name
+}
+
+func (j *jsonHTTPSource) FetchPrice(date time.Time) (*big.Int, error) {
+	req, err := common.GenerateThirdPartyJSONRequest(formatPriceURL(j.url, date))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range j.headers {
+		req.Header.Add(key, value)
+	}
+
+	body, err := common.FetchData(req)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := extractJSONPrice(body, j.jsonPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ConvertFloatToBigInt(price, pricePrecision)
+}
+
+// fileSource reads the price from a local file, either as a plain decimal number
+// or, if a JSON path is configured, from a JSON document
+type fileSource struct {
+	name     string
+	file     string
+	jsonPath string
+}
+
+func newFileSource(config *PriceSourceConfig, _ map[string]interface{}) (PriceSource, error) {
+	if config.File == "" {
+		return nil, errPriceSourceFileMissing
+	}
+
+	return &fileSource{
+		name:     sourceName(config),
+		file:     config.File,
+		jsonPath: config.JSONPath,
+	}, nil
+}
+
+func (f *fileSource) Name() string {
+	return f.name
+}
+
+func (f *fileSource) FetchPrice(_ time.Time) (*big.Int, error) {
+	content, err := os.ReadFile(f.file)
+	if err != nil {
+		return nil, err
+	}
+
+	var price float64
+
+	if f.jsonPath != "" {
+		price, err = extractJSONPrice(content, f.jsonPath)
+	} else {
+		price, err = strconv.ParseFloat(strings.TrimSpace(string(content)), 64)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ConvertFloatToBigInt(price, pricePrecision)
+}
+
+// extractJSONPrice reads the value at the given dot separated path from a JSON document.
+// Numeric path segments index arrays. The value may be either a JSON number or a numeric string
+func extractJSONPrice(body []byte, path string) (float64, error) {
+	var value interface{}
+
+	if err := json.Unmarshal(body, &value); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			next, ok := node[segment]
+			if !ok {
+				return 0, fmt.Errorf("json path %s: key %s not found", path, segment)
+			}
+
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return 0, fmt.Errorf("json path %s: invalid array index %s", path, segment)
+			}
+
+			value = node[index]
+		default:
+			return 0, fmt.Errorf("json path %s: cannot descend into %s", path, segment)
+		}
+	}
+
+	switch price := value.(type) {
+	case float64:
+		return price, nil
+	case string:
+		return strconv.ParseFloat(price, 64)
+	default:
+		return 0, fmt.Errorf("json path %s: value is not a number", path)
+	}
+}
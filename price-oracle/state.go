@@ -5,7 +5,6 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo"
@@ -29,14 +28,14 @@ func newPriceOracleState(
 	systemState polybft.SystemState,
 	priceOracleAddr types.Address,
 	provider contract.Provider,
-	validatorAccount *wallet.Account,
+	validatorKey ethgo.Key,
 ) PriceOracleState {
 	s := &priceOracleState{systemState, nil}
 
 	s.priceOracleContract = contract.NewContract(
 		ethgo.Address(priceOracleAddr),
 		contractsapi.PriceOracle.Abi, contract.WithProvider(provider),
-		contract.WithSender(validatorAccount.Ecdsa),
+		contract.WithSender(validatorKey),
 	)
 
 	return s
@@ -70,7 +69,7 @@ func (p priceOracleState) shouldVote(
 type PriceOracleStateProvider interface {
 	GetPriceOracleState(
 		header *types.Header,
-		validatorAccount *wallet.Account,
+		validatorKey ethgo.Key,
 	) (PriceOracleState, error)
 }
 
@@ -84,7 +83,7 @@ func NewPriceOracleStateProvider(blockchain blockchainBackend) PriceOracleStateP
 
 func (p priceOracleStateProvider) GetPriceOracleState(
 	header *types.Header,
-	validatorAccount *wallet.Account,
+	validatorKey ethgo.Key,
 ) (PriceOracleState, error) {
 	provider, err := p.blockchain.GetStateProviderForBlock(header)
 	if err != nil {
@@ -95,6 +94,6 @@ func (p priceOracleStateProvider) GetPriceOracleState(
 		p.blockchain.GetSystemState(provider),
 		contracts.PriceOracleContract,
 		provider,
-		validatorAccount,
+		validatorKey,
 	), nil
 }
@@ -0,0 +1,83 @@
+package priceoracle
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Status describes the current state of the price oracle of this node
+type Status struct {
+	// Validator is the address of the validator account used for voting
+	Validator types.Address
+	// IsValidator indicates if the account is in the validator set at the latest block
+	IsValidator bool
+	// BlockNumber is the latest block number
+	BlockNumber uint64
+	// Day is the day number of the latest block
+	Day uint64
+	// VotingWindowStart and VotingWindowEnd are the unix timestamps of the day's voting window
+	VotingWindowStart uint64
+	VotingWindowEnd   uint64
+	// InVotingWindow indicates if the latest block is in the voting window
+	InVotingWindow bool
+	// Today is the vote state of the current day (nil if there is none yet)
+	Today *DayVote
+	// LastVote is the latest stored vote state (nil if there is none)
+	LastVote *DayVote
+}
+
+// GetStatus returns the current state of the price oracle
+func (p *PriceOracle) GetStatus() (*Status, error) {
+	header := p.blockchain.CurrentHeader()
+	day := calcDayNumber(header.Timestamp)
+
+	isValidator, err := p.isValidator(header)
+	if err != nil {
+		return nil, err
+	}
+
+	today, err := p.voteStore.getVote(day)
+	if err != nil {
+		return nil, fmt.Errorf("get vote state: %w", err)
+	}
+
+	lastVote, err := p.voteStore.getLastVote()
+	if err != nil {
+		return nil, fmt.Errorf("get vote state: %w", err)
+	}
+
+	dayStart := day * secondsInADay
+
+	return &Status{
+		Validator:         types.Address(p.key.Address()),
+		IsValidator:       isValidator,
+		BlockNumber:       header.Number,
+		Day:               day,
+		VotingWindowStart: dayStart + dailyVotingStartTime,
+		VotingWindowEnd:   dayStart + dailyVotingEndTime,
+		InVotingWindow:    isVotingTime(header.Timestamp),
+		Today:             today,
+		LastVote:          lastVote,
+	}, nil
+}
+
+// GetVotes returns the vote state of the given day, or nil if there is none
+func (p *PriceOracle) GetVotes(day uint64) (*DayVote, error) {
+	return p.voteStore.getVote(day)
+}
+
+// GetPendingVote returns the vote state of the current day if the vote
+// failed and is waiting to be retried, otherwise nil
+func (p *PriceOracle) GetPendingVote() (*DayVote, error) {
+	vote, err := p.voteStore.getVote(calcDayNumber(p.blockchain.CurrentHeader().Timestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	if vote == nil || vote.Status != VotePending {
+		return nil, nil
+	}
+
+	return vote, nil
+}
@@ -0,0 +1,185 @@
+package priceoracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// voteStoreFileName is the name of the bolt db file holding the vote history
+const voteStoreFileName = "priceOracleState.db"
+
+var (
+	// bucket to store the per-day vote state
+	priceOracleVotesBucket = []byte("priceOracleVotes")
+)
+
+/*
+Bolt DB schema:
+
+priceOracleVotes/
+|--> dayNumber -> *DayVote (json marshalled)
+*/
+
+// VoteStatus is the state of the validator's own vote for a given day
+type VoteStatus string
+
+const (
+	// VotePending means the vote failed and it will be retried
+	VotePending VoteStatus = "pending"
+	// VoteConfirmed means the vote transaction was included and emitted PriceVoted
+	VoteConfirmed VoteStatus = "confirmed"
+	// VoteSkipped means the price for the day was already set, so no vote was needed
+	VoteSkipped VoteStatus = "skipped"
+	// VoteExpired means the voting window closed before the vote succeeded
+	VoteExpired VoteStatus = "expired"
+)
+
+// ValidatorVote is a PriceVoted event observed on chain
+type ValidatorVote struct {
+	Validator   types.Address `json:"validator"`
+	Price       *big.Int      `json:"price"`
+	BlockNumber uint64        `json:"blockNumber"`
+	TxHash      types.Hash    `json:"txHash"`
+}
+
+// DayVote holds the vote state of a single day
+type DayVote struct {
+	Day    uint64     `json:"day"`
+	Status VoteStatus `json:"status"`
+	// Price is the last price submitted (or attempted to be submitted) by this validator
+	Price *big.Int `json:"price"`
+	// TxHash is the hash of the vote transaction of this validator
+	TxHash types.Hash `json:"txHash"`
+	// ReceiptStatus is the status of the vote transaction receipt, if one was received
+	ReceiptStatus *uint64 `json:"receiptStatus"`
+	// Attempts is the number of vote attempts made for the day
+	Attempts uint64 `json:"attempts"`
+	// LastError is the error of the last failed attempt
+	LastError string `json:"lastError"`
+	// LastAttempt is the block timestamp of the last attempt
+	LastAttempt uint64 `json:"lastAttempt"`
+	// NextAttempt is the earliest block timestamp at which a failed vote is retried
+	NextAttempt uint64 `json:"nextAttempt"`
+	// Votes are the PriceVoted events seen on chain for the day
+	Votes []*ValidatorVote `json:"votes"`
+}
+
+// isDone returns true if there is no need to vote again for the day
+func (d *DayVote) isDone() bool {
+	return d.Status == VoteConfirmed || d.Status == VoteSkipped || d.Status == VoteExpired
+}
+
+// addVote adds the given validator vote, replacing a previously seen vote of the same validator
+func (d *DayVote) addVote(vote *ValidatorVote) {
+	for i, v := range d.Votes {
+		if v.Validator == vote.Validator {
+			d.Votes[i] = vote
+
+			return
+		}
+	}
+
+	d.Votes = append(d.Votes, vote)
+}
+
+// VoteStore persists the price oracle vote state
+type VoteStore struct {
+	db *bolt.DB
+}
+
+// newVoteStore opens (or creates) the vote store at the given path
+func newVoteStore(path string) (*VoteStore, error) {
+	db, err := bolt.Open(path, 0666, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &VoteStore{db: db}
+
+	if err := db.Update(s.initialize); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *VoteStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(priceOracleVotesBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(priceOracleVotesBucket), err)
+	}
+
+	return nil
+}
+
+// close closes the underlying db
+func (s *VoteStore) close() error {
+	return s.db.Close()
+}
+
+// getVote returns the vote state for the given day, or nil if there is none
+func (s *VoteStore) getVote(day uint64) (*DayVote, error) {
+	var vote *DayVote
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(priceOracleVotesBucket).Get(common.EncodeUint64ToBytes(day))
+		if v != nil {
+			return json.Unmarshal(v, &vote)
+		}
+
+		return nil
+	})
+
+	return vote, err
+}
+
+// getLastVote returns the vote state of the latest day in the store, or nil if it is empty
+func (s *VoteStore) getLastVote() (*DayVote, error) {
+	var vote *DayVote
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		_, v := tx.Bucket(priceOracleVotesBucket).Cursor().Last()
+		if v != nil {
+			return json.Unmarshal(v, &vote)
+		}
+
+		return nil
+	})
+
+	return vote, err
+}
+
+// updateVote applies the update function to the vote state of the given day
+// (a new state is created if there is none) and stores the result
+func (s *VoteStore) updateVote(day uint64, updateFn func(vote *DayVote)) (*DayVote, error) {
+	var vote *DayVote
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(priceOracleVotesBucket)
+		key := common.EncodeUint64ToBytes(day)
+
+		if v := bucket.Get(key); v != nil {
+			if err := json.Unmarshal(v, &vote); err != nil {
+				return err
+			}
+		} else {
+			vote = &DayVote{Day: day}
+		}
+
+		updateFn(vote)
+
+		raw, err := json.Marshal(vote)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, raw)
+	})
+
+	return vote, err
+}
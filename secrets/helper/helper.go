@@ -19,6 +19,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -87,6 +88,19 @@ func setupGCPSSM(
 	)
 }
 
+// setupRemoteSigner is a helper method for boilerplate remote signer secrets manager setup.
+// The path of the secrets stored locally is taken from the configuration
+func setupRemoteSigner(
+	secretsConfig *secrets.SecretsManagerConfig,
+) (secrets.SecretsManager, error) {
+	return remotesigner.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+		},
+	)
+}
+
 // InitECDSAValidatorKey creates new ECDSA key and set as a validator key
 func InitECDSAValidatorKey(secretsManager secrets.SecretsManager) (types.Address, error) {
 	if secretsManager.HasSecret(secrets.ValidatorKey) {
@@ -275,6 +289,13 @@ func InitCloudSecretsManager(
 		}
 
 		secretsManager = GCPSSM
+	case secrets.RemoteSigner:
+		remoteSigner, err := setupRemoteSigner(secretsConfig)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = remoteSigner
 	default:
 		return secretsManager, errors.New("unsupported secrets manager")
 	}
@@ -0,0 +1,178 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+const (
+	// unixSocketScheme is the URL scheme of the remote signer reached over the Unix socket
+	unixSocketScheme = "unix://"
+
+	// unixSocketBaseURL is the base URL of the requests sent over the Unix socket
+	unixSocketBaseURL = "http://remote-signer"
+
+	defaultRequestTimeout = 10 * time.Second
+)
+
+// Client sends the requests to the remote signer over HTTP or Unix socket
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates the client of the remote signer.
+// The URL is either an HTTP(S) URL or unix:///path/to/socket
+func NewClient(url, token string) (*Client, error) {
+	if url == "" {
+		return nil, errors.New("no remote signer URL specified")
+	}
+
+	httpClient := &http.Client{Timeout: defaultRequestTimeout}
+
+	if strings.HasPrefix(url, unixSocketScheme) {
+		socketPath := strings.TrimPrefix(url, unixSocketScheme)
+		dialer := &net.Dialer{}
+
+		httpClient.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		}
+
+		url = unixSocketBaseURL
+	} else if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("invalid remote signer URL '%s'", url)
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(url, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Upcheck checks if the remote signer is running
+func (c *Client) Upcheck() error {
+	return c.do(http.MethodGet, upcheckEndpoint, nil, nil)
+}
+
+// ECDSAPublicKeys returns the hex encoded ECDSA public keys held by the remote signer
+func (c *Client) ECDSAPublicKeys() ([]string, error) {
+	var keys []string
+
+	if err := c.do(http.MethodGet, ecdsaPublicKeysPath, nil, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// BLSPublicKeys returns the hex encoded BLS public keys held by the remote signer
+func (c *Client) BLSPublicKeys() ([]string, error) {
+	var keys []string
+
+	if err := c.do(http.MethodGet, blsPublicKeysPath, nil, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// SignECDSA signs the data with the ECDSA key of the provided address
+func (c *Client) SignECDSA(address string, req *ECDSASignRequest) ([]byte, error) {
+	return c.sign(ecdsaSignEndpointPath+address, req)
+}
+
+// SignBLS signs the data with the BLS key of the provided public key
+func (c *Client) SignBLS(publicKey string, req *BLSSignRequest) ([]byte, error) {
+	return c.sign(blsSignEndpointPath+publicKey, req)
+}
+
+func (c *Client) sign(path string, req interface{}) ([]byte, error) {
+	var resp *SignResponse
+
+	if err := c.do(http.MethodPost, path, req, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, errors.New("empty response of the remote signer")
+	}
+
+	return hex.DecodeHex(resp.Signature)
+}
+
+// do sends the request to the remote signer and decodes the JSON response to the result (if not nil)
+func (c *Client) do(method, path string, body, result interface{}) error {
+	var reqBody io.Reader
+
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reqBody = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach the remote signer: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the remote signer response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+
+		_ = json.Unmarshal(respBody, &errResp)
+
+		if resp.StatusCode == http.StatusPreconditionFailed {
+			return fmt.Errorf("%w: %s", ErrSlashingProtection, errResp.Error)
+		}
+
+		return fmt.Errorf("remote signer request %s failed with status %d: %s", path, resp.StatusCode, errResp.Error)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("failed to decode the remote signer response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// remote-signer is a local stand-in for the web3signer-style remote signer.
+// It holds the validator keys of the local secrets directory (created by the secrets init command)
+// and signs the data sent by the node configured with the remote-signer secrets manager.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	unixSocketScheme = "unix://"
+
+	defaultListenAddr           = "127.0.0.1:9000"
+	defaultSlashingDatabaseName = "remote-signer-slashing-protection.json"
+)
+
+func main() {
+	var (
+		dataDir        string
+		listenAddr     string
+		slashingDBPath string
+		token          string
+		logLevel       string
+	)
+
+	flag.StringVar(&dataDir, "data-dir", "", "the directory of the validator secrets")
+	flag.StringVar(&listenAddr, "listen", defaultListenAddr,
+		"the address to listen on (host:port or unix:///path/to/socket)")
+	flag.StringVar(&slashingDBPath, "slashing-protection-db", "",
+		"the slashing protection database file (default <data-dir>/"+defaultSlashingDatabaseName+")")
+	flag.StringVar(&token, "token", os.Getenv("REMOTE_SIGNER_TOKEN"),
+		"the bearer token required by the requests (REMOTE_SIGNER_TOKEN environment variable)")
+	flag.StringVar(&logLevel, "log-level", "INFO", "the log level")
+	flag.Parse()
+
+	if err := run(dataDir, listenAddr, slashingDBPath, token, logLevel); err != nil {
+		fmt.Fprintf(os.Stderr, "remote signer failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dataDir, listenAddr, slashingDBPath, token, logLevel string) error {
+	if dataDir == "" {
+		return errors.New("the data directory is required")
+	}
+
+	if slashingDBPath == "" {
+		slashingDBPath = filepath.Join(dataDir, defaultSlashingDatabaseName)
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "remote-signer",
+		Level: hclog.LevelFromString(logLevel),
+	})
+
+	secretsManager, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: logger,
+		Extra:  map[string]interface{}{secrets.Path: dataDir},
+	})
+	if err != nil {
+		return err
+	}
+
+	server, err := remotesigner.NewServer(logger, secretsManager, slashingDBPath, token)
+	if err != nil {
+		return err
+	}
+
+	listener, err := listen(listenAddr)
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler:           server,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	logger.Info("remote signer started", "listen", listenAddr)
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-signalCh:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return httpServer.Shutdown(ctx)
+}
+
+// listen listens on the TCP address or the Unix socket, which is accessible only by the owner
+func listen(addr string) (net.Listener, error) {
+	if !strings.HasPrefix(addr, unixSocketScheme) {
+		return net.Listen("tcp", addr)
+	}
+
+	socketPath := strings.TrimPrefix(addr, unixSocketScheme)
+
+	// remove the socket left by the previous run
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(socketPath, 0600); err != nil {
+		listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
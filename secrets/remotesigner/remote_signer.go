@@ -0,0 +1,138 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/hashicorp/go-hclog"
+)
+
+// RemoteSignerSecretsManager is a SecretsManager which keeps the validator keys
+// in the external signer process. The node never reads the validator private keys,
+// it sends the data to be signed to the remote signer instead.
+// The other secrets (e.g. the network key) are stored locally on disk
+type RemoteSignerSecretsManager struct {
+	// Logger object
+	logger hclog.Logger
+
+	// client of the remote signer
+	client *Client
+
+	// signer signs with the validator keys held by the remote signer
+	signer *Signer
+
+	// local stores the secrets which are not held by the remote signer
+	local secrets.SecretsManager
+}
+
+// SecretsManagerFactory implements the factory method
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	if config == nil {
+		return nil, errors.New("no config specified for remote signer secrets manager")
+	}
+
+	client, err := NewClient(config.ServerURL, config.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// the path of the local secrets is set by the node (data dir),
+	// otherwise it is taken from the configuration
+	path, ok := params.Extra[secrets.Path]
+	if !ok {
+		path, ok = config.Extra[secrets.Path]
+	}
+
+	if !ok {
+		return nil, errors.New("no path specified for remote signer secrets manager")
+	}
+
+	localManager, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: params.Logger,
+		Extra:  map[string]interface{}{secrets.Path: path},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	remoteSignerManager := &RemoteSignerSecretsManager{
+		logger: params.Logger.Named(string(secrets.RemoteSigner)),
+		client: client,
+		local:  localManager,
+	}
+
+	if err := remoteSignerManager.Setup(); err != nil {
+		return nil, err
+	}
+
+	return remoteSignerManager, nil
+}
+
+// Setup checks that the remote signer is running and reads the validator public keys
+func (r *RemoteSignerSecretsManager) Setup() error {
+	if err := r.client.Upcheck(); err != nil {
+		return err
+	}
+
+	signer, err := NewSigner(r.client)
+	if err != nil {
+		return fmt.Errorf("failed to read the validator keys of the remote signer: %w", err)
+	}
+
+	r.signer = signer
+
+	r.logger.Info("remote signer connected", "address", signer.Address())
+
+	return nil
+}
+
+// Signer returns the signer of the validator keys held by the remote signer
+func (r *RemoteSignerSecretsManager) Signer() *Signer {
+	return r.signer
+}
+
+// GetSecret gets the secret from the local storage, the validator keys are never returned
+func (r *RemoteSignerSecretsManager) GetSecret(name string) ([]byte, error) {
+	if isValidatorKey(name) {
+		return nil, ErrRemoteSignerKey
+	}
+
+	return r.local.GetSecret(name)
+}
+
+// SetSecret saves the secret to the local storage, the validator keys can't be set
+func (r *RemoteSignerSecretsManager) SetSecret(name string, value []byte) error {
+	if isValidatorKey(name) {
+		return ErrRemoteSignerKey
+	}
+
+	return r.local.SetSecret(name, value)
+}
+
+// HasSecret checks if the secret is present, the validator keys are always held by the remote signer
+func (r *RemoteSignerSecretsManager) HasSecret(name string) bool {
+	if isValidatorKey(name) {
+		return true
+	}
+
+	return r.local.HasSecret(name)
+}
+
+// RemoveSecret removes the secret from the local storage, the validator keys can't be removed
+func (r *RemoteSignerSecretsManager) RemoveSecret(name string) error {
+	if isValidatorKey(name) {
+		return ErrRemoteSignerKey
+	}
+
+	return r.local.RemoveSecret(name)
+}
+
+// isValidatorKey returns true if the secret is the validator key held by the remote signer
+func isValidatorKey(name string) bool {
+	return name == secrets.ValidatorKey || name == secrets.ValidatorBLSKey
+}
@@ -0,0 +1,292 @@
+package remotesigner
+
+import (
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	polybftsigner "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// newTestServer creates the signer of the new validator keys stored in the temporary directory
+func newTestServer(t *testing.T, slashingDBPath, token string) (*Server, types.Address) {
+	t.Helper()
+
+	secretsManager, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+	})
+	require.NoError(t, err)
+
+	ecdsaKey, ecdsaEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorKey, ecdsaEncoded))
+
+	blsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	blsRaw, err := blsKey.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, secretsManager.SetSecret(secrets.ValidatorBLSKey, blsRaw))
+
+	server, err := NewServer(hclog.NewNullLogger(), secretsManager, slashingDBPath, token)
+	require.NoError(t, err)
+
+	return server, crypto.PubKeyToAddress(&ecdsaKey.PublicKey)
+}
+
+func newTestSecretsManager(t *testing.T, url, token string) *RemoteSignerSecretsManager {
+	t.Helper()
+
+	secretsManager, err := SecretsManagerFactory(
+		&secrets.SecretsManagerConfig{Type: secrets.RemoteSigner, ServerURL: url, Token: token},
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+		},
+	)
+	require.NoError(t, err)
+
+	return secretsManager.(*RemoteSignerSecretsManager) //nolint:forcetypeassert
+}
+
+func commitMessage(t *testing.T, from types.Address, height, round uint64, proposalHash types.Hash) []byte {
+	t.Helper()
+
+	raw, err := protobuf.Marshal(&proto.Message{
+		View: &proto.View{Height: height, Round: round},
+		From: from.Bytes(),
+		Type: proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{CommitData: &proto.CommitMessage{
+			ProposalHash: proposalHash.Bytes(),
+		}},
+	})
+	require.NoError(t, err)
+
+	return raw
+}
+
+func testTransaction(from types.Address) *ethgo.Transaction {
+	to := ethgo.Address(types.StringToAddress("2"))
+
+	return &ethgo.Transaction{
+		From:     ethgo.Address(from),
+		To:       &to,
+		Nonce:    1,
+		GasPrice: 10,
+		Gas:      21000,
+		Value:    big.NewInt(1),
+	}
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	server, address := newTestServer(t, filepath.Join(t.TempDir(), "slashing.json"), "")
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	secretsManager := newTestSecretsManager(t, httpServer.URL, "")
+	signer := secretsManager.Signer()
+
+	require.Equal(t, address, types.Address(signer.Address()))
+
+	// the validator keys are never returned, the other secrets are stored locally
+	require.True(t, secretsManager.HasSecret(secrets.ValidatorKey))
+
+	_, err := secretsManager.GetSecret(secrets.ValidatorBLSKey)
+	require.ErrorIs(t, err, ErrRemoteSignerKey)
+	require.ErrorIs(t, secretsManager.SetSecret(secrets.ValidatorKey, []byte("key")), ErrRemoteSignerKey)
+
+	require.False(t, secretsManager.HasSecret(secrets.NetworkKey))
+	require.NoError(t, secretsManager.SetSecret(secrets.NetworkKey, []byte("network key")))
+
+	networkKey, err := secretsManager.GetSecret(secrets.NetworkKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("network key"), networkKey)
+
+	// the signatures are verified by the signer, so only the errors are checked
+	digest := types.StringToHash("1")
+
+	_, err = signer.SignTransaction(testTransaction(address), 100)
+	require.NoError(t, err)
+
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 1, 0, digest))
+	require.NoError(t, err)
+
+	_, err = signer.SignWithDomain(digest.Bytes(), polybftsigner.DomainCommonSigning)
+	require.NoError(t, err)
+
+	_, err = signer.SignCommittedSeal(digest.Bytes(), polybftsigner.DomainCheckpointManager, 1, 0)
+	require.NoError(t, err)
+
+	// the IBFT message of the other sender is refused
+	_, err = signer.SignIBFTMessage(commitMessage(t, types.StringToAddress("2"), 2, 0, digest))
+	require.ErrorContains(t, err, "the IBFT message sender is not the validator")
+
+	// the committed seals can't be signed without the slashing protection
+	_, err = signer.SignWithDomain(digest.Bytes(), polybftsigner.DomainCheckpointManager)
+	require.ErrorContains(t, err, "the committed seal domain requires the COMMITTED_SEAL type")
+}
+
+func TestRemoteSigner_SignTransaction(t *testing.T) {
+	t.Parallel()
+
+	server, address := newTestServer(t, filepath.Join(t.TempDir(), "slashing.json"), "")
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	signer := newTestSecretsManager(t, httpServer.URL, "").Signer()
+
+	legacyTx := testTransaction(address)
+
+	dynamicFeeTx := testTransaction(address)
+	dynamicFeeTx.Type = ethgo.TransactionDynamicFee
+	dynamicFeeTx.GasPrice = 0
+	dynamicFeeTx.MaxFeePerGas = big.NewInt(20)
+	dynamicFeeTx.MaxPriorityFeePerGas = big.NewInt(2)
+
+	for _, tx := range []*ethgo.Transaction{legacyTx, dynamicFeeTx} {
+		signed, err := signer.SignTransaction(tx, 100)
+		require.NoError(t, err)
+
+		sender, err := wallet.NewEIP155Signer(100).RecoverSender(signed)
+		require.NoError(t, err)
+		require.Equal(t, ethgo.Address(address), sender)
+
+		// the provided transaction is not modified
+		require.Empty(t, tx.V)
+	}
+
+	client, err := NewClient(httpServer.URL, "")
+	require.NoError(t, err)
+
+	// the bare digests are refused, the signer computes the signing hash itself
+	_, err = client.SignECDSA(address.String(), &ECDSASignRequest{
+		Type:    TransactionSignType,
+		Data:    types.StringToHash("1").String(),
+		ChainID: 100,
+	})
+	require.ErrorContains(t, err, "invalid transaction")
+
+	data, _, err := encodeUnsignedTransaction(legacyTx, 100)
+	require.NoError(t, err)
+
+	// the chain ID is required
+	_, err = client.SignECDSA(address.String(), &ECDSASignRequest{
+		Type: TransactionSignType,
+		Data: hex.EncodeToHex(data),
+	})
+	require.ErrorContains(t, err, "the transaction chain ID is required")
+
+	// the typed transaction of the other chain is refused
+	data, _, err = encodeUnsignedTransaction(dynamicFeeTx, 200)
+	require.NoError(t, err)
+
+	_, err = client.SignECDSA(address.String(), &ECDSASignRequest{
+		Type:    TransactionSignType,
+		Data:    hex.EncodeToHex(data),
+		ChainID: 100,
+	})
+	require.ErrorContains(t, err, "the transaction chain ID does not match")
+}
+
+func TestRemoteSigner_SlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	slashingDBPath := filepath.Join(t.TempDir(), "slashing.json")
+	server, address := newTestServer(t, slashingDBPath, "")
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	signer := newTestSecretsManager(t, httpServer.URL, "").Signer()
+
+	hash1, hash2 := types.StringToHash("1"), types.StringToHash("2")
+
+	_, err := signer.SignIBFTMessage(commitMessage(t, address, 10, 0, hash1))
+	require.NoError(t, err)
+
+	// the same proposal can be signed again
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 10, 0, hash1))
+	require.NoError(t, err)
+
+	// the other proposal can be signed in the other round
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 10, 1, hash2))
+	require.NoError(t, err)
+
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 10, 0, hash2))
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	_, err = signer.SignCommittedSeal(hash1.Bytes(), polybftsigner.DomainCheckpointManager, 10, 0)
+	require.NoError(t, err)
+
+	_, err = signer.SignCommittedSeal(hash2.Bytes(), polybftsigner.DomainCheckpointManager, 10, 0)
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	// the records are kept after the signer restart
+	protection, err := newSlashingProtection(slashingDBPath)
+	require.NoError(t, err)
+
+	server.protection = protection
+
+	_, err = signer.SignCommittedSeal(hash2.Bytes(), polybftsigner.DomainCheckpointManager, 10, 0)
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	// the heights far below the highest signed height are refused
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 10+slashingProtectionHeights+1, 0, hash1))
+	require.NoError(t, err)
+
+	_, err = signer.SignIBFTMessage(commitMessage(t, address, 10, 2, hash1))
+	require.ErrorIs(t, err, ErrSlashingProtection)
+	require.ErrorContains(t, err, "is below the low watermark")
+}
+
+func TestRemoteSigner_UnixSocketAndToken(t *testing.T) {
+	t.Parallel()
+
+	server, address := newTestServer(t, filepath.Join(t.TempDir(), "slashing.json"), "secret")
+
+	socketPath := filepath.Join(t.TempDir(), "signer.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	httpServer := &http.Server{Handler: server} //nolint:gosec
+	defer httpServer.Close()
+
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Error(err)
+		}
+	}()
+
+	signer := newTestSecretsManager(t, "unix://"+socketPath, "secret").Signer()
+	require.Equal(t, address, types.Address(signer.Address()))
+
+	_, err = signer.SignTransaction(testTransaction(address), 100)
+	require.NoError(t, err)
+
+	// the requests without the token are refused
+	client, err := NewClient("unix://"+socketPath, "")
+	require.NoError(t, err)
+	require.ErrorContains(t, client.Upcheck(), "status 401")
+}
@@ -0,0 +1,328 @@
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	polybftsigner "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	hexhelper "github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxRequestSize is the maximum size of the sign request body
+	maxRequestSize = 1 << 20
+
+	// committedSealKind is the slashing protection record kind of the committed seals
+	committedSealKind = "COMMITTED_SEAL"
+)
+
+// Server is the signer which holds the validator keys and signs the data sent by the node.
+// It refuses to sign the consensus messages which conflict with the ones already signed
+type Server struct {
+	logger hclog.Logger
+
+	// token is the bearer token required by the requests, if set
+	token string
+
+	ecdsaKey       *wallet.Key
+	ecdsaPublicKey string
+	address        types.Address
+
+	blsKey       *bls.PrivateKey
+	blsPublicKey string
+
+	protection *slashingProtection
+}
+
+// NewServer creates the signer of the validator keys stored in the secrets manager.
+// The signed consensus messages are recorded in the slashing protection database file
+func NewServer(
+	logger hclog.Logger,
+	secretsManager secrets.SecretsManager,
+	slashingProtectionPath string,
+	token string,
+) (*Server, error) {
+	encodedKey, err := secretsManager.GetSecret(secrets.ValidatorKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ecdsa key: %w", err)
+	}
+
+	ecdsaRaw, err := hex.DecodeString(string(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ecdsa key: %w", err)
+	}
+
+	ecdsaKey, err := wallet.NewWalletFromPrivKey(ecdsaRaw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ecdsa key: %w", err)
+	}
+
+	encodedBLSKey, err := secretsManager.GetSecret(secrets.ValidatorBLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve bls key: %w", err)
+	}
+
+	blsKey, err := bls.UnmarshalPrivateKey(encodedBLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve bls key: %w", err)
+	}
+
+	protection, err := newSlashingProtection(slashingProtectionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := wallet.ParsePrivateKey(ecdsaRaw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ecdsa key: %w", err)
+	}
+
+	return &Server{
+		logger:         logger,
+		token:          token,
+		ecdsaKey:       ecdsaKey,
+		ecdsaPublicKey: hexhelper.EncodeToHex(crypto.MarshalPublicKey(&privateKey.PublicKey)),
+		address:        types.Address(ecdsaKey.Address()),
+		blsKey:         blsKey,
+		blsPublicKey:   hexhelper.EncodeToHex(blsKey.PublicKey().Marshal()),
+		protection:     protection,
+	}, nil
+}
+
+// ServeHTTP handles the requests of the remote signer API
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.isAuthorized(r) {
+		writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+
+		return
+	}
+
+	path := r.URL.Path
+
+	switch {
+	case path == upcheckEndpoint && r.Method == http.MethodGet:
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("OK"))
+	case path == ecdsaPublicKeysPath && r.Method == http.MethodGet:
+		writeJSON(w, []string{s.ecdsaPublicKey})
+	case path == blsPublicKeysPath && r.Method == http.MethodGet:
+		writeJSON(w, []string{s.blsPublicKey})
+	case strings.HasPrefix(path, ecdsaSignEndpointPath) && r.Method == http.MethodPost:
+		identifier := strings.TrimPrefix(path, ecdsaSignEndpointPath)
+		if types.StringToAddress(identifier) != s.address {
+			writeError(w, http.StatusNotFound, fmt.Errorf("unknown ECDSA key %s", identifier))
+
+			return
+		}
+
+		var req ECDSASignRequest
+		if err := decodeRequest(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+
+			return
+		}
+
+		s.writeSignature(w, req.Type, func() ([]byte, error) { return s.signECDSA(&req) })
+	case strings.HasPrefix(path, blsSignEndpointPath) && r.Method == http.MethodPost:
+		identifier := strings.TrimPrefix(path, blsSignEndpointPath)
+		if !strings.EqualFold(identifier, s.blsPublicKey) {
+			writeError(w, http.StatusNotFound, fmt.Errorf("unknown BLS key %s", identifier))
+
+			return
+		}
+
+		var req BLSSignRequest
+		if err := decodeRequest(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+
+			return
+		}
+
+		s.writeSignature(w, req.Type, func() ([]byte, error) { return s.signBLS(&req) })
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown endpoint %s %s", r.Method, path))
+	}
+}
+
+// writeSignature signs the request and writes the signature or the error to the response
+func (s *Server) writeSignature(w http.ResponseWriter, signType SignType, sign func() ([]byte, error)) {
+	signature, err := sign()
+	if err != nil {
+		var slashingErr *slashingProtectionError
+
+		switch {
+		case errors.As(err, &slashingErr):
+			s.logger.Warn("refused to sign because of the slashing protection", "type", signType, "reason", err)
+			writeError(w, http.StatusPreconditionFailed, err)
+		case errors.Is(err, errInvalidSignRequest):
+			writeError(w, http.StatusBadRequest, err)
+		default:
+			s.logger.Error("failed to sign", "type", signType, "err", err)
+			writeError(w, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	s.logger.Debug("signed", "type", signType)
+
+	writeJSON(w, &SignResponse{Signature: hexhelper.EncodeToHex(signature)})
+}
+
+var errInvalidSignRequest = errors.New("invalid sign request")
+
+func (s *Server) signECDSA(req *ECDSASignRequest) ([]byte, error) {
+	data, err := hexhelper.DecodeHex(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid data: %w", errInvalidSignRequest, err)
+	}
+
+	switch req.Type {
+	case TransactionSignType:
+		tx, err := decodeUnsignedTransaction(data, req.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		key := &recordingKey{Key: s.ecdsaKey}
+		if _, err := wallet.NewEIP155Signer(req.ChainID).SignTx(tx, key); err != nil {
+			return nil, err
+		}
+
+		return key.signature, nil
+	case IBFTMessageSignType:
+		if err := s.checkIBFTMessage(data); err != nil {
+			return nil, err
+		}
+
+		return s.ecdsaKey.Sign(crypto.Keccak256(data))
+	default:
+		return nil, fmt.Errorf("%w: unknown ECDSA sign type '%s'", errInvalidSignRequest, req.Type)
+	}
+}
+
+// checkIBFTMessage decodes the IBFT message and checks the proposal hash
+// of the PREPREPARE, PREPARE and COMMIT messages against the slashing protection records
+func (s *Server) checkIBFTMessage(data []byte) error {
+	var msg proto.Message
+	if err := protobuf.Unmarshal(data, &msg); err != nil {
+		return fmt.Errorf("%w: invalid IBFT message: %w", errInvalidSignRequest, err)
+	}
+
+	if len(msg.Signature) != 0 {
+		return fmt.Errorf("%w: the IBFT message is already signed", errInvalidSignRequest)
+	}
+
+	if !bytes.Equal(msg.From, s.address.Bytes()) {
+		return fmt.Errorf("%w: the IBFT message sender is not the validator", errInvalidSignRequest)
+	}
+
+	if msg.View == nil {
+		return fmt.Errorf("%w: the IBFT message has no view", errInvalidSignRequest)
+	}
+
+	var proposalHash []byte
+
+	switch msg.Type {
+	case proto.MessageType_PREPREPARE:
+		proposalHash = msg.GetPreprepareData().GetProposalHash()
+	case proto.MessageType_PREPARE:
+		proposalHash = msg.GetPrepareData().GetProposalHash()
+	case proto.MessageType_COMMIT:
+		proposalHash = msg.GetCommitData().GetProposalHash()
+	default:
+		// the round change messages don't vote for a proposal
+		return nil
+	}
+
+	return s.protection.checkAndRecord(msg.Type.String(), msg.View.Height, msg.View.Round, proposalHash)
+}
+
+func (s *Server) signBLS(req *BLSSignRequest) ([]byte, error) {
+	signingRoot, err := hexhelper.DecodeHex(req.SigningRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signing root: %w", errInvalidSignRequest, err)
+	}
+
+	domain, err := hexhelper.DecodeHex(req.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid domain: %w", errInvalidSignRequest, err)
+	}
+
+	isCommittedSealDomain := bytes.Equal(domain, polybftsigner.DomainCheckpointManager)
+
+	switch req.Type {
+	case MessageSignType:
+		// otherwise the committed seals could be signed without the slashing protection
+		if isCommittedSealDomain {
+			return nil, fmt.Errorf("%w: the committed seal domain requires the %s type",
+				errInvalidSignRequest, CommittedSealSignType)
+		}
+	case CommittedSealSignType:
+		if !isCommittedSealDomain {
+			return nil, fmt.Errorf("%w: invalid committed seal domain", errInvalidSignRequest)
+		}
+
+		if len(signingRoot) != types.HashLength {
+			return nil, fmt.Errorf("%w: the proposal hash must be %d bytes", errInvalidSignRequest, types.HashLength)
+		}
+
+		if err := s.protection.checkAndRecord(committedSealKind, req.Height, req.Round, signingRoot); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown BLS sign type '%s'", errInvalidSignRequest, req.Type)
+	}
+
+	signature, err := s.blsKey.Sign(signingRoot, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
+
+// isAuthorized checks the bearer token of the request, if the token is required
+func (s *Server) isAuthorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func decodeRequest(r *http.Request, req interface{}) error {
+	if err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxRequestSize)).Decode(req); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&errorResponse{Error: err.Error()})
+}
@@ -0,0 +1,176 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+// Signer signs with the validator keys held by the remote signer.
+// The signatures returned by the remote signer are verified against the validator public keys
+type Signer struct {
+	client       *Client
+	address      types.Address
+	blsPublicKey *bls.PublicKey
+}
+
+// NewSigner creates the signer of the validator keys held by the remote signer.
+// The remote signer must hold exactly one ECDSA and one BLS key
+func NewSigner(client *Client) (*Signer, error) {
+	ecdsaKeys, err := client.ECDSAPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	blsKeys, err := client.BLSPublicKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ecdsaKeys) != 1 || len(blsKeys) != 1 {
+		return nil, fmt.Errorf("the remote signer must hold one ECDSA and one BLS key, found %d and %d",
+			len(ecdsaKeys), len(blsKeys))
+	}
+
+	ecdsaRaw, err := hex.DecodeHex(ecdsaKeys[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+	}
+
+	ecdsaKey, err := crypto.ParsePublicKey(ecdsaRaw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+	}
+
+	blsRaw, err := hex.DecodeHex(blsKeys[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS public key: %w", err)
+	}
+
+	blsKey, err := bls.UnmarshalPublicKey(blsRaw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS public key: %w", err)
+	}
+
+	return &Signer{
+		client:       client,
+		address:      crypto.PubKeyToAddress(ecdsaKey),
+		blsPublicKey: blsKey,
+	}, nil
+}
+
+// Address returns the address of the validator ECDSA key
+func (s *Signer) Address() ethgo.Address {
+	return ethgo.Address(s.address)
+}
+
+// BLSPublicKey returns the validator BLS public key
+func (s *Signer) BLSPublicKey() *bls.PublicKey {
+	return s.blsPublicKey
+}
+
+// SignTransaction sends the unsigned transaction to the remote signer, which signs its EIP-155
+// signing hash with the ECDSA key, and returns the signed transaction
+func (s *Signer) SignTransaction(tx *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error) {
+	data, unsigned, err := encodeUnsignedTransaction(tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode transaction: %w", err)
+	}
+
+	signature, err := s.client.SignECDSA(s.address.String(), &ECDSASignRequest{
+		Type:    TransactionSignType,
+		Data:    hex.EncodeToHex(data),
+		ChainID: chainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txSigner := wallet.NewEIP155Signer(chainID)
+
+	signed, err := txSigner.SignTx(unsigned, &signatureKey{address: s.Address(), signature: signature})
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := txSigner.RecoverSender(signed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+
+	if sender != s.Address() {
+		return nil, errors.New("the remote signer signed with an unexpected ECDSA key")
+	}
+
+	return signed, nil
+}
+
+// SignIBFTMessage signs the marshaled IBFT message with the ECDSA key
+func (s *Signer) SignIBFTMessage(msgRaw []byte) ([]byte, error) {
+	return s.signECDSA(IBFTMessageSignType, msgRaw, crypto.Keccak256(msgRaw))
+}
+
+// SignWithDomain signs the digest with the BLS key and the provided domain
+func (s *Signer) SignWithDomain(digest, domain []byte) ([]byte, error) {
+	return s.signBLS(&BLSSignRequest{
+		Type:        MessageSignType,
+		SigningRoot: hex.EncodeToHex(digest),
+		Domain:      hex.EncodeToHex(domain),
+	}, digest, domain)
+}
+
+// SignCommittedSeal signs the proposal hash with the BLS key and the provided domain at the provided height and round
+func (s *Signer) SignCommittedSeal(proposalHash, domain []byte, height, round uint64) ([]byte, error) {
+	return s.signBLS(&BLSSignRequest{
+		Type:        CommittedSealSignType,
+		SigningRoot: hex.EncodeToHex(proposalHash),
+		Domain:      hex.EncodeToHex(domain),
+		Height:      height,
+		Round:       round,
+	}, proposalHash, domain)
+}
+
+func (s *Signer) signECDSA(signType SignType, data, hash []byte) ([]byte, error) {
+	signature, err := s.client.SignECDSA(s.address.String(), &ECDSASignRequest{
+		Type: signType,
+		Data: hex.EncodeToHex(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := crypto.RecoverPubkey(signature, hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+
+	if crypto.PubKeyToAddress(pub) != s.address {
+		return nil, errors.New("the remote signer signed with an unexpected ECDSA key")
+	}
+
+	return signature, nil
+}
+
+func (s *Signer) signBLS(req *BLSSignRequest, digest, domain []byte) ([]byte, error) {
+	signature, err := s.client.SignBLS(hex.EncodeToHex(s.blsPublicKey.Marshal()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	blsSignature, err := bls.UnmarshalSignature(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+
+	if !blsSignature.Verify(s.blsPublicKey, digest, domain) {
+		return nil, errors.New("the remote signer signed with an unexpected BLS key")
+	}
+
+	return signature, nil
+}
@@ -0,0 +1,184 @@
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// slashingProtectionHeights is the number of heights below the highest signed height
+// for which the signed proposal hashes are kept. The signer refuses to sign at the lower heights
+const slashingProtectionHeights = 256
+
+// slashingProtectionError is returned when the data conflicts with the data already signed
+type slashingProtectionError struct {
+	reason string
+}
+
+func (e *slashingProtectionError) Error() string {
+	return e.reason
+}
+
+// slashingRecord is the proposal hash signed by the validator at the height and round
+type slashingRecord struct {
+	Kind         string `json:"kind"`
+	Height       uint64 `json:"height"`
+	Round        uint64 `json:"round"`
+	ProposalHash string `json:"proposalHash"`
+}
+
+// slashingProtectionData is the content of the slashing protection database file
+type slashingProtectionData struct {
+	LowWatermark uint64            `json:"lowWatermark"`
+	Records      []*slashingRecord `json:"records"`
+}
+
+type slashingKey struct {
+	kind   string
+	height uint64
+	round  uint64
+}
+
+// slashingProtection keeps the proposal hashes signed at each height and round,
+// so the validator never signs two different proposals in the same view (double signing).
+// The records are persisted to the file, before the signature is returned
+type slashingProtection struct {
+	lock sync.Mutex
+
+	path string
+
+	// lowWatermark is the lowest height the signer signs at
+	lowWatermark uint64
+
+	records map[slashingKey][]byte
+}
+
+// newSlashingProtection loads the slashing protection database from the file, if it exists
+func newSlashingProtection(path string) (*slashingProtection, error) {
+	s := &slashingProtection{
+		path:    path,
+		records: make(map[slashingKey][]byte),
+	}
+
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read slashing protection database: %w", err)
+	}
+
+	var data slashingProtectionData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode slashing protection database: %w", err)
+	}
+
+	s.lowWatermark = data.LowWatermark
+
+	for _, r := range data.Records {
+		proposalHash, err := hex.DecodeHex(r.ProposalHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slashing protection record: %w", err)
+		}
+
+		s.records[slashingKey{kind: r.Kind, height: r.Height, round: r.Round}] = proposalHash
+	}
+
+	return s, nil
+}
+
+// checkAndRecord records the proposal hash signed at the height and round.
+// It fails if a different proposal hash is already signed at the same height and round,
+// or if the height is below the low watermark
+func (s *slashingProtection) checkAndRecord(kind string, height, round uint64, proposalHash []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if height < s.lowWatermark {
+		return &slashingProtectionError{
+			reason: fmt.Sprintf("%s at height %d is below the low watermark %d", kind, height, s.lowWatermark),
+		}
+	}
+
+	key := slashingKey{kind: kind, height: height, round: round}
+
+	if signedHash, ok := s.records[key]; ok {
+		if bytes.Equal(signedHash, proposalHash) {
+			return nil
+		}
+
+		return &slashingProtectionError{
+			reason: fmt.Sprintf("%s at height %d and round %d conflicts with the signed proposal %s",
+				kind, height, round, hex.EncodeToHex(signedHash)),
+		}
+	}
+
+	s.records[key] = proposalHash
+
+	lowWatermark := s.lowWatermark
+	if height > slashingProtectionHeights && height-slashingProtectionHeights > lowWatermark {
+		lowWatermark = height - slashingProtectionHeights
+	}
+
+	if err := s.persist(lowWatermark); err != nil {
+		// the signature is not returned, so the record is not kept
+		delete(s.records, key)
+
+		return err
+	}
+
+	if lowWatermark > s.lowWatermark {
+		s.lowWatermark = lowWatermark
+
+		for k := range s.records {
+			if k.height < lowWatermark {
+				delete(s.records, k)
+			}
+		}
+	}
+
+	return nil
+}
+
+// persist writes the records at or above the low watermark to the file
+func (s *slashingProtection) persist(lowWatermark uint64) error {
+	data := &slashingProtectionData{
+		LowWatermark: lowWatermark,
+		Records:      make([]*slashingRecord, 0, len(s.records)),
+	}
+
+	for k, proposalHash := range s.records {
+		if k.height < lowWatermark {
+			continue
+		}
+
+		data.Records = append(data.Records, &slashingRecord{
+			Kind:         k.kind,
+			Height:       k.height,
+			Round:        k.round,
+			ProposalHash: hex.EncodeToHex(proposalHash),
+		})
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	// the database is replaced at once, so it is never left partially written
+	tmpPath := s.path + ".tmp"
+
+	if err := common.SaveFileSafe(tmpPath, raw, 0600); err != nil {
+		return fmt.Errorf("failed to write slashing protection database: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to write slashing protection database: %w", err)
+	}
+
+	return nil
+}
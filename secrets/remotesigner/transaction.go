@@ -0,0 +1,90 @@
+package remotesigner
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/umbracle/ethgo"
+)
+
+// signatureLength is the length of the ECDSA signature with the recovery ID
+const signatureLength = 65
+
+// encodeUnsignedTransaction returns the RLP encoding of the transaction without the signature,
+// which is sent to the remote signer
+func encodeUnsignedTransaction(tx *ethgo.Transaction, chainID uint64) ([]byte, *ethgo.Transaction, error) {
+	unsigned := tx.Copy()
+	unsigned.V, unsigned.R, unsigned.S = nil, nil, nil
+
+	if unsigned.Type != ethgo.TransactionLegacy {
+		unsigned.ChainID = new(big.Int).SetUint64(chainID)
+	}
+
+	data, err := unsigned.MarshalRLPTo(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, unsigned, nil
+}
+
+// decodeUnsignedTransaction decodes the unsigned transaction sent by the node and checks it is
+// signed for the given chain, so the signer never signs a digest it has not computed itself
+func decodeUnsignedTransaction(data []byte, chainID uint64) (*ethgo.Transaction, error) {
+	if chainID == 0 {
+		return nil, fmt.Errorf("%w: the transaction chain ID is required", errInvalidSignRequest)
+	}
+
+	tx := &ethgo.Transaction{}
+	if err := tx.UnmarshalRLP(data); err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction: %w", errInvalidSignRequest, err)
+	}
+
+	if len(tx.V) != 0 || len(tx.R) != 0 || len(tx.S) != 0 {
+		return nil, fmt.Errorf("%w: the transaction is already signed", errInvalidSignRequest)
+	}
+
+	if tx.Type != ethgo.TransactionLegacy && (tx.ChainID == nil || !tx.ChainID.IsUint64() ||
+		tx.ChainID.Uint64() != chainID) {
+		return nil, fmt.Errorf("%w: the transaction chain ID does not match", errInvalidSignRequest)
+	}
+
+	return tx, nil
+}
+
+// recordingKey signs the transaction signing hash computed by the EIP-155 signer
+// and records the signature returned to the node
+type recordingKey struct {
+	ethgo.Key
+	signature []byte
+}
+
+func (k *recordingKey) Sign(hash []byte) ([]byte, error) {
+	signature, err := k.Key.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	k.signature = signature
+
+	return signature, nil
+}
+
+// signatureKey returns the signature received from the remote signer,
+// so the EIP-155 signer can set it into the transaction
+type signatureKey struct {
+	address   ethgo.Address
+	signature []byte
+}
+
+func (k *signatureKey) Address() ethgo.Address {
+	return k.address
+}
+
+func (k *signatureKey) Sign([]byte) ([]byte, error) {
+	if len(k.signature) != signatureLength {
+		return nil, fmt.Errorf("invalid remote signer signature length %d", len(k.signature))
+	}
+
+	return k.signature, nil
+}
@@ -0,0 +1,72 @@
+package remotesigner
+
+import (
+	"errors"
+)
+
+// Define the endpoints of the remote signer, which follow the web3signer API
+const (
+	upcheckEndpoint       = "/upcheck"
+	ecdsaPublicKeysPath   = "/api/v1/eth1/publicKeys"
+	ecdsaSignEndpointPath = "/api/v1/eth1/sign/"
+	blsPublicKeysPath     = "/api/v1/eth2/publicKeys"
+	blsSignEndpointPath   = "/api/v1/eth2/sign/"
+)
+
+// SignType is the type of the data signed by the remote signer
+type SignType string
+
+const (
+	// TransactionSignType is the RLP encoded unsigned transaction, whose EIP-155 signing hash
+	// is computed and signed with the ECDSA key by the remote signer
+	TransactionSignType SignType = "TRANSACTION"
+
+	// IBFTMessageSignType is the marshaled IBFT message (without the signature) signed with the ECDSA key
+	IBFTMessageSignType SignType = "IBFT_MESSAGE"
+
+	// MessageSignType is a digest signed with the BLS key and the provided domain
+	MessageSignType SignType = "MESSAGE"
+
+	// CommittedSealSignType is the proposal hash signed with the BLS key at the provided height and round
+	CommittedSealSignType SignType = "COMMITTED_SEAL"
+)
+
+var (
+	// ErrSlashingProtection is returned when the signer refuses to sign the data,
+	// because it conflicts with the data already signed at the same height and round
+	ErrSlashingProtection = errors.New("the remote signer refused to sign because of the slashing protection")
+
+	// ErrRemoteSignerKey is returned when the validator keys are requested from the remote signer secrets manager
+	ErrRemoteSignerKey = errors.New("the validator keys are held by the remote signer")
+)
+
+// ECDSASignRequest is the body of the ECDSA sign request
+type ECDSASignRequest struct {
+	Type SignType `json:"type"`
+	// Data is the hex encoded unsigned transaction or the marshaled IBFT message
+	Data string `json:"data"`
+	// ChainID is the chain ID of the transaction signing hash
+	ChainID uint64 `json:"chainId,omitempty"`
+}
+
+// BLSSignRequest is the body of the BLS sign request
+type BLSSignRequest struct {
+	Type SignType `json:"type"`
+	// SigningRoot is the hex encoded digest (or the proposal hash of the committed seal)
+	SigningRoot string `json:"signingRoot"`
+	// Domain is the hex encoded BLS domain
+	Domain string `json:"domain"`
+	// Height and Round are the view of the committed seal
+	Height uint64 `json:"height,omitempty"`
+	Round  uint64 `json:"round,omitempty"`
+}
+
+// SignResponse is the body of the sign response
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// errorResponse is the body of the failed request
+type errorResponse struct {
+	Error string `json:"error"`
+}
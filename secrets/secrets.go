@@ -37,6 +37,12 @@ const (
 
 	// CoinGeckoAPIKey is the API key for the coingecko endpoints
 	CoinGeckoAPIKey = "coingecko-api-key" //nolint:gosec
+
+	// CoinMarketCapAPIKey is the API key for the coinmarketcap endpoints
+	CoinMarketCapAPIKey = "coinmarketcap-api-key" //nolint:gosec
+
+	// PriceFeed is the price oracle price feed configuration (sources, quorum and max spread)
+	PriceFeed = "price-feed"
 )
 
 // Define constant file names for the local StorageManager
@@ -75,6 +81,9 @@ const (
 
 	// Encrypted Local pertains to the local FS but the data is encrypted with password
 	EncryptedLocal SecretsManagerType = "encrypted-local"
+
+	// RemoteSigner pertains to the external signer process holding the validator keys
+	RemoteSigner SecretsManagerType = "remote-signer"
 )
 
 // SecretsManager defines the base public interface that all
@@ -120,5 +129,5 @@ type SecretsManagerFactory func(
 // SupportedServiceManager checks if the passed in service manager type is supported
 func SupportedServiceManager(service SecretsManagerType) bool {
 	return service == HashicorpVault || service == AWSSSM ||
-		service == Local || service == GCPSSM || service == EncryptedLocal
+		service == Local || service == GCPSSM || service == EncryptedLocal || service == RemoteSigner
 }
@@ -32,6 +32,11 @@ func TestSupportedServiceManager(t *testing.T) {
 			GCPSSM,
 			true,
 		},
+		{
+			"Valid remote signer secrets manager",
+			RemoteSigner,
+			true,
+		},
 		{
 			"Invalid secrets manager",
 			"MarsSecretsManager",
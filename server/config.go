@@ -8,6 +8,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
+	priceoracle "github.com/0xPolygon/polygon-edge/price-oracle"
 	"github.com/0xPolygon/polygon-edge/secrets"
 )
 
@@ -25,6 +26,7 @@ type Config struct {
 	PriceLimit         uint64
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
+	TxPoolJournal      bool
 
 	Telemetry *Telemetry
 	Network   *network.Config
@@ -46,6 +48,24 @@ type Config struct {
 
 	NumBlockConfirmations uint64
 	MetricsInterval       time.Duration
+
+	PriceFeed *priceoracle.PriceFeedConfig
+
+	// PruneStateRetainBlocks is the number of latest blocks whose state is kept (0 disables pruning)
+	PruneStateRetainBlocks uint64
+	// PruneStateInterval is the number of blocks between two state pruning runs
+	PruneStateInterval uint64
+
+	// StateSync enables downloading the state of a recent block instead of executing all the blocks
+	StateSync bool
+
+	// RewardHistory enables the indexing of the rewards distributed at the end of each epoch
+	RewardHistory bool
+
+	// MonitorWebhook is the url which receives the validator monitor alerts
+	MonitorWebhook string
+	// MonitorMissedBlocksThreshold is the number of the consecutive missed blocks which triggers the alert
+	MonitorMissedBlocksThreshold uint64
 }
 
 // Telemetry holds the config details for metric services
@@ -1531,3 +1531,664 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ServerStatus_BlockValidationError{}
+
+// Validate checks the field values on PeersBanRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PeersBanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersBanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PeersBanRequestMultiError, or
+// nil if none found.
+func (m *PeersBanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersBanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PeersBanRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PeersBanRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]{1,}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return PeersBanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersBanRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersBanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PeersBanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersBanRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersBanRequestMultiError) AllErrors() []error { return m }
+
+// PeersBanRequestValidationError is the validation error returned by
+// PeersBanRequest.Validate if the designated constraints aren't met.
+type PeersBanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersBanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersBanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersBanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersBanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersBanRequestValidationError) ErrorName() string {
+	return "PeersBanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersBanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersBanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersBanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersBanRequestValidationError{}
+
+var _PeersBanRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9]{1,}$")
+
+// Validate checks the field values on PeersBanResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersBanResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersBanResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PeersBanResponseMultiError, or nil if none found.
+func (m *PeersBanResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersBanResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return PeersBanResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersBanResponseMultiError is an error wrapping multiple validation errors
+// returned by PeersBanResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PeersBanResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersBanResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersBanResponseMultiError) AllErrors() []error { return m }
+
+// PeersBanResponseValidationError is the validation error returned by
+// PeersBanResponse.Validate if the designated constraints aren't met.
+type PeersBanResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersBanResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersBanResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersBanResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersBanResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersBanResponseValidationError) ErrorName() string { return "PeersBanResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeersBanResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersBanResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersBanResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersBanResponseValidationError{}
+
+// Validate checks the field values on PeersUnbanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersUnbanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersUnbanRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PeersUnbanRequestMultiError, or nil if none found.
+func (m *PeersUnbanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersUnbanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PeersUnbanRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PeersUnbanRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]{1,}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PeersUnbanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersUnbanRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersUnbanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PeersUnbanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersUnbanRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersUnbanRequestMultiError) AllErrors() []error { return m }
+
+// PeersUnbanRequestValidationError is the validation error returned by
+// PeersUnbanRequest.Validate if the designated constraints aren't met.
+type PeersUnbanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersUnbanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersUnbanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersUnbanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersUnbanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersUnbanRequestValidationError) ErrorName() string {
+	return "PeersUnbanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersUnbanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersUnbanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersUnbanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersUnbanRequestValidationError{}
+
+var _PeersUnbanRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9]{1,}$")
+
+// Validate checks the field values on PeersUnbanResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersUnbanResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersUnbanResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PeersUnbanResponseMultiError, or nil if none found.
+func (m *PeersUnbanResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersUnbanResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return PeersUnbanResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersUnbanResponseMultiError is an error wrapping multiple validation errors
+// returned by PeersUnbanResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PeersUnbanResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersUnbanResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersUnbanResponseMultiError) AllErrors() []error { return m }
+
+// PeersUnbanResponseValidationError is the validation error returned by
+// PeersUnbanResponse.Validate if the designated constraints aren't met.
+type PeersUnbanResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersUnbanResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersUnbanResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersUnbanResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersUnbanResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersUnbanResponseValidationError) ErrorName() string {
+	return "PeersUnbanResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersUnbanResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersUnbanResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersUnbanResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersUnbanResponseValidationError{}
+
+// Validate checks the field values on PeersTrustRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersTrustRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersTrustRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PeersTrustRequestMultiError, or nil if none found.
+func (m *PeersTrustRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersTrustRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PeersTrustRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PeersTrustRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]{1,}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Revoke
+
+	if len(errors) > 0 {
+		return PeersTrustRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersTrustRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersTrustRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PeersTrustRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersTrustRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersTrustRequestMultiError) AllErrors() []error { return m }
+
+// PeersTrustRequestValidationError is the validation error returned by
+// PeersTrustRequest.Validate if the designated constraints aren't met.
+type PeersTrustRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersTrustRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersTrustRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersTrustRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersTrustRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersTrustRequestValidationError) ErrorName() string {
+	return "PeersTrustRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersTrustRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersTrustRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersTrustRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersTrustRequestValidationError{}
+
+var _PeersTrustRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9]{1,}$")
+
+// Validate checks the field values on PeersTrustResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersTrustResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersTrustResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// PeersTrustResponseMultiError, or nil if none found.
+func (m *PeersTrustResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersTrustResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return PeersTrustResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersTrustResponseMultiError is an error wrapping multiple validation errors
+// returned by PeersTrustResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PeersTrustResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersTrustResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersTrustResponseMultiError) AllErrors() []error { return m }
+
+// PeersTrustResponseValidationError is the validation error returned by
+// PeersTrustResponse.Validate if the designated constraints aren't met.
+type PeersTrustResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersTrustResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersTrustResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersTrustResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersTrustResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersTrustResponseValidationError) ErrorName() string {
+	return "PeersTrustResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersTrustResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersTrustResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersTrustResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersTrustResponseValidationError{}
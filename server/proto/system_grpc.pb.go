@@ -37,6 +37,12 @@ type SystemClient interface {
 	BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (System_ExportClient, error)
+	// PeersBan disconnects a peer and bans it for the duration
+	PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*PeersBanResponse, error)
+	// PeersUnban removes the ban of a peer
+	PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*PeersUnbanResponse, error)
+	// PeersTrust marks a peer as trusted, or revokes the trust
+	PeersTrust(ctx context.Context, in *PeersTrustRequest, opts ...grpc.CallOption) (*PeersTrustResponse, error)
 }
 
 type systemClient struct {
@@ -156,6 +162,33 @@ func (x *systemExportClient) Recv() (*ExportEvent, error) {
 	return m, nil
 }
 
+func (c *systemClient) PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*PeersBanResponse, error) {
+	out := new(PeersBanResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*PeersUnbanResponse, error) {
+	out := new(PeersUnbanResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersUnban", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersTrust(ctx context.Context, in *PeersTrustRequest, opts ...grpc.CallOption) (*PeersTrustResponse, error) {
+	out := new(PeersTrustResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersTrust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemServer is the server API for System service.
 // All implementations must embed UnimplementedSystemServer
 // for forward compatibility
@@ -174,6 +207,12 @@ type SystemServer interface {
 	BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(*ExportRequest, System_ExportServer) error
+	// PeersBan disconnects a peer and bans it for the duration
+	PeersBan(context.Context, *PeersBanRequest) (*PeersBanResponse, error)
+	// PeersUnban removes the ban of a peer
+	PeersUnban(context.Context, *PeersUnbanRequest) (*PeersUnbanResponse, error)
+	// PeersTrust marks a peer as trusted, or revokes the trust
+	PeersTrust(context.Context, *PeersTrustRequest) (*PeersTrustResponse, error)
 	mustEmbedUnimplementedSystemServer()
 }
 
@@ -202,6 +241,15 @@ func (UnimplementedSystemServer) BlockByNumber(context.Context, *BlockByNumberRe
 func (UnimplementedSystemServer) Export(*ExportRequest, System_ExportServer) error {
 	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSystemServer) PeersBan(context.Context, *PeersBanRequest) (*PeersBanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersBan not implemented")
+}
+func (UnimplementedSystemServer) PeersUnban(context.Context, *PeersUnbanRequest) (*PeersUnbanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersUnban not implemented")
+}
+func (UnimplementedSystemServer) PeersTrust(context.Context, *PeersTrustRequest) (*PeersTrustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersTrust not implemented")
+}
 func (UnimplementedSystemServer) mustEmbedUnimplementedSystemServer() {}
 
 // UnsafeSystemServer may be embedded to opt out of forward compatibility for this service.
@@ -347,6 +395,60 @@ func (x *systemExportServer) Send(m *ExportEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _System_PeersBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersBan(ctx, req.(*PeersBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersUnban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersUnbanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersUnban(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersUnban",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersUnban(ctx, req.(*PeersUnbanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersTrustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersTrust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersTrust(ctx, req.(*PeersTrustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // System_ServiceDesc is the grpc.ServiceDesc for System service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +476,18 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockByNumber",
 			Handler:    _System_BlockByNumber_Handler,
 		},
+		{
+			MethodName: "PeersBan",
+			Handler:    _System_PeersBan_Handler,
+		},
+		{
+			MethodName: "PeersUnban",
+			Handler:    _System_PeersUnban_Handler,
+		},
+		{
+			MethodName: "PeersTrust",
+			Handler:    _System_PeersTrust_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
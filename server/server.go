@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -92,6 +93,12 @@ type Server struct {
 
 	// core price oracle module
 	priceOracle *priceoracle.PriceOracle
+
+	// statePruner removes the old state (nil on archive nodes)
+	statePruner *statePruner
+
+	// bloomIndexer indexes the logs blooms of the blocks for the log queries
+	bloomIndexer *blockchain.BloomIndexer
 }
 
 // newFileLogger returns logger instance that writes all logs to a specified file.
@@ -159,6 +166,8 @@ func NewServer(config *Config) (*Server, error) {
 	var dirPaths = []string{
 		"blockchain",
 		"trie",
+		"txpool",
+		"libp2p",
 	}
 
 	// Generate all the paths in the dataDir
@@ -206,6 +215,18 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	var pruningStorage *itrie.PruningStorage
+
+	if m.config.PruneStateRetainBlocks > 0 {
+		prunableStorage, ok := stateStorage.(itrie.PrunableStorage)
+		if !ok {
+			return nil, errors.New("state storage does not support pruning")
+		}
+
+		pruningStorage = itrie.NewPruningStorage(prunableStorage)
+		stateStorage = pruningStorage
+	}
+
 	m.stateStorage = stateStorage
 
 	st := itrie.NewState(stateStorage)
@@ -263,6 +284,12 @@ func NewServer(config *Config) (*Server, error) {
 			return nil, err
 		}
 
+		if polyBFTConfig.InitialStateDump != nil {
+			if err := importInitialState(&polyBFTConfig, stateStorage, logger); err != nil {
+				return nil, err
+			}
+		}
+
 		if polyBFTConfig.InitialTrieRoot != types.ZeroHash {
 			checkedInitialTrieRoot, err := itrie.HashChecker(polyBFTConfig.InitialTrieRoot.Bytes(), stateStorage)
 			if err != nil {
@@ -291,13 +318,17 @@ func NewServer(config *Config) (*Server, error) {
 	// compute the genesis root state
 	config.Chain.Genesis.StateRoot = genesisRoot
 
-	// Use the london signer with eip-155 as a fallback one
+	// Use the london signer with berlin and eip-155 signers as the fallback ones
 	var signer crypto.TxSigner = crypto.NewLondonSigner(
 		uint64(m.config.Chain.Params.ChainID), //nolint:gosec
 		config.Chain.Params.Forks.IsActive(chain.Homestead, 0),
-		crypto.NewEIP155Signer(
+		crypto.NewBerlinSigner(
 			uint64(m.config.Chain.Params.ChainID), //nolint:gosec
 			config.Chain.Params.Forks.IsActive(chain.Homestead, 0),
+			crypto.NewEIP155Signer(
+				uint64(m.config.Chain.Params.ChainID), //nolint:gosec
+				config.Chain.Params.Forks.IsActive(chain.Homestead, 0),
+			),
 		),
 	)
 
@@ -347,6 +378,17 @@ func NewServer(config *Config) (*Server, error) {
 			Blockchain: m.blockchain,
 		}
 
+		txpoolConfig := &txpool.Config{
+			MaxSlots:           m.config.MaxSlots,
+			PriceLimit:         m.config.PriceLimit,
+			MaxAccountEnqueued: m.config.MaxAccountEnqueued,
+			ChainID:            big.NewInt(m.config.Chain.Params.ChainID),
+		}
+
+		if m.config.TxPoolJournal && m.config.DataDir != "" {
+			txpoolConfig.JournalPath = filepath.Join(m.config.DataDir, "txpool", "transactions.rlp")
+		}
+
 		// start transaction pool
 		m.txpool, err = txpool.NewTxPool(
 			logger,
@@ -354,12 +396,7 @@ func NewServer(config *Config) (*Server, error) {
 			hub,
 			m.grpcServer,
 			m.network,
-			&txpool.Config{
-				MaxSlots:           m.config.MaxSlots,
-				PriceLimit:         m.config.PriceLimit,
-				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
-				ChainID:            big.NewInt(m.config.Chain.Params.ChainID),
-			},
+			txpoolConfig,
 		)
 		if err != nil {
 			return nil, err
@@ -389,6 +426,29 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// create the bloom bits indexer (before the jsonrpc server, which queries the index)
+	m.bloomIndexer, err = blockchain.NewBloomIndexer(m.logger, m.blockchain, blockchain.BloomBitsSectionSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// create price oracle instance
+	// (before the jsonrpc server, which exposes the price oracle state)
+	m.priceOracle, err = priceoracle.NewPriceOracle(
+		m.logger,
+		m.blockchain,
+		m.executor,
+		m.consensus,
+		m.config.JSONRPC.JSONRPCAddr.String(),
+		m.secretsManager,
+		m.config.SecretsManager,
+		m.config.PriceFeed,
+		filepath.Join(m.config.DataDir, "price-oracle"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// setup and start grpc server
 	if err := m.setupGRPC(); err != nil {
 		return nil, err
@@ -408,20 +468,6 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
-	// create price oracle instance
-	m.priceOracle, err = priceoracle.NewPriceOracle(
-		m.logger,
-		m.blockchain,
-		m.executor,
-		m.consensus,
-		m.config.JSONRPC.JSONRPCAddr.String(),
-		m.secretsManager,
-		m.config.SecretsManager,
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	// start consensus
 	if err := m.consensus.Start(); err != nil {
 		return nil, err
@@ -430,11 +476,31 @@ func NewServer(config *Config) (*Server, error) {
 	m.txpool.SetBaseFee(m.blockchain.Header())
 	m.txpool.Start()
 
+	m.bloomIndexer.Start()
+
 	// start price oracle
 	if err := m.priceOracle.Start(); err != nil {
 		return nil, err
 	}
 
+	if pruningStorage != nil {
+		// the genesis state is needed on every startup, so it is never pruned
+		pinnedRoots := []types.Hash{genesisRoot}
+		if initialStateRoot != types.ZeroHash {
+			pinnedRoots = append(pinnedRoots, initialStateRoot)
+		}
+
+		m.statePruner = newStatePruner(
+			m.logger,
+			pruningStorage,
+			m.blockchain,
+			m.config.PruneStateRetainBlocks,
+			m.config.PruneStateInterval,
+			pinnedRoots,
+		)
+		m.statePruner.start()
+	}
+
 	return m, nil
 }
 
@@ -464,6 +530,52 @@ func (s *Server) restoreChain() error {
 	return nil
 }
 
+// importInitialState imports the state dump embedded into the genesis into the trie,
+// unless the trie already has the initial state root. The dump is verified against
+// the genesis checksum and root before anything is written into the trie
+func importInitialState(
+	config *consensusPolyBFT.PolyBFTConfig,
+	stateStorage itrie.Storage,
+	logger hclog.Logger,
+) error {
+	if _, ok, err := stateStorage.Get(config.InitialTrieRoot.Bytes()); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	dumpConfig := config.InitialStateDump
+
+	logger.Info("Importing initial state", "root", config.InitialTrieRoot)
+
+	dump, err := itrie.ImportState(bytes.NewReader(dumpConfig.Data), stateStorage, func(dump *itrie.StateDump) error {
+		if dump.Checksum != dumpConfig.Checksum {
+			return fmt.Errorf(
+				"the initial state dump checksum %s doesn't match the genesis checksum %s",
+				dump.Checksum,
+				dumpConfig.Checksum,
+			)
+		}
+
+		if dump.Root != config.InitialTrieRoot {
+			return fmt.Errorf(
+				"the initial state dump root %s doesn't match the genesis root %s",
+				dump.Root,
+				config.InitialTrieRoot,
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import the initial state dump: %w", err)
+	}
+
+	logger.Info("Initial state imported", "accounts", dump.Accounts, "codes", dump.Codes)
+
+	return nil
+}
+
 type txpoolHub struct {
 	state state.State
 	*blockchain.Blockchain
@@ -527,9 +639,10 @@ func (s *Server) setupSecretsManager() error {
 		Logger: s.logger,
 	}
 
-	if secretsManagerType == secrets.Local || secretsManagerType == secrets.EncryptedLocal {
+	if secretsManagerType == secrets.Local || secretsManagerType == secrets.EncryptedLocal ||
+		secretsManagerType == secrets.RemoteSigner {
 		// Only the base directory is required for
-		// the local secrets manager
+		// the local secrets manager (the remote signer stores the secrets other than the validator keys locally)
 		secretsManagerParams.Extra = map[string]interface{}{
 			secrets.Path: s.config.DataDir,
 		}
@@ -592,18 +705,23 @@ func (s *Server) setupConsensus() error {
 
 	consensus, err := engine(
 		&consensus.Params{
-			Context:               context.Background(),
-			Config:                config,
-			TxPool:                s.txpool,
-			Network:               s.network,
-			Blockchain:            s.blockchain,
-			Executor:              s.executor,
-			Grpc:                  s.grpcServer,
-			Logger:                s.logger,
-			SecretsManager:        s.secretsManager,
-			BlockTime:             uint64(blockTime.Seconds()),
-			NumBlockConfirmations: s.config.NumBlockConfirmations,
-			MetricsInterval:       s.config.MetricsInterval,
+			Context:                      context.Background(),
+			Config:                       config,
+			TxPool:                       s.txpool,
+			Network:                      s.network,
+			Blockchain:                   s.blockchain,
+			Executor:                     s.executor,
+			Grpc:                         s.grpcServer,
+			Logger:                       s.logger,
+			SecretsManager:               s.secretsManager,
+			BlockTime:                    uint64(blockTime.Seconds()),
+			NumBlockConfirmations:        s.config.NumBlockConfirmations,
+			MetricsInterval:              s.config.MetricsInterval,
+			StateStorage:                 s.stateStorage,
+			StateSync:                    s.config.StateSync,
+			RewardHistory:                s.config.RewardHistory,
+			MonitorWebhook:               s.config.MonitorWebhook,
+			MonitorMissedBlocksThreshold: s.config.MonitorMissedBlocksThreshold,
 		},
 	)
 
@@ -644,7 +762,10 @@ func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, err
 
 type jsonRPCHub struct {
 	state              state.State
+	stateStorage       itrie.Storage
 	restoreProgression *progress.ProgressionWrapper
+	priceOracle        *priceoracle.PriceOracle
+	bloomIndexer       *blockchain.BloomIndexer
 
 	*blockchain.Blockchain
 	*txpool.TxPool
@@ -659,6 +780,284 @@ func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
 
+func (j *jsonRPCHub) BloomBitsSections() (uint64, uint64) {
+	return j.bloomIndexer.SectionSize(), j.bloomIndexer.Sections()
+}
+
+func (j *jsonRPCHub) GetBloomBits(bit uint, section uint64) ([]byte, bool) {
+	return j.bloomIndexer.GetBloomBits(bit, section)
+}
+
+// polybft returns the polybft consensus, which provides the data specific to the Hydragon consensus
+func (j *jsonRPCHub) polybft() (*consensusPolyBFT.Polybft, error) {
+	polybft, ok := j.GetConsensus().(*consensusPolyBFT.Polybft)
+	if !ok {
+		return nil, errors.New("the method is supported by the polybft consensus only")
+	}
+
+	return polybft, nil
+}
+
+// GetFinality returns the committed seals of the given block and the validators which signed it
+func (j *jsonRPCHub) GetFinality(header *types.Header) (*jsonrpc.Finality, error) {
+	polybft, err := j.polybft()
+	if err != nil {
+		return nil, err
+	}
+
+	// the genesis block is final without the committed seals
+	if header.Number == 0 {
+		return &jsonrpc.Finality{}, nil
+	}
+
+	extra, err := consensusPolyBFT.GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return nil, err
+	}
+
+	if extra.Committed == nil {
+		return nil, fmt.Errorf("block %d has no committed seals", header.Number)
+	}
+
+	validators, err := polybft.GetValidators(header.Number-1, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the validators of block %d: %w", header.Number, err)
+	}
+
+	signers, err := validators.GetFilteredValidators(extra.Committed.Bitmap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.Finality{
+		Bitmap:              extra.Committed.Bitmap,
+		AggregatedSignature: extra.Committed.AggregatedSignature,
+		Signers:             signers.GetAddresses(),
+	}, nil
+}
+
+// GetStakingValidator returns the staking state of the validator at the given block
+func (j *jsonRPCHub) GetStakingValidator(header *types.Header, addr types.Address) (*jsonrpc.StakingValidator, error) {
+	stakingState, err := j.stakingState(header)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := stakingState.GetValidator(addr)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	return &jsonrpc.StakingValidator{
+		Address:             v.Address,
+		BlsKey:              v.BlsKey.Marshal(),
+		Stake:               v.Stake,
+		DelegatedStake:      v.DelegatedStake,
+		TotalStake:          v.TotalStake,
+		Commission:          v.Commission,
+		WithdrawableRewards: v.WithdrawableRewards,
+		VotingPower:         v.VotingPower,
+		Status:              v.Status,
+		IsActive:            v.IsActive,
+		IsBanned:            v.IsBanned,
+		IsBanInitiated:      v.IsBanInitiated,
+	}, nil
+}
+
+// GetEpochValidators returns the validator set of the epoch, as seen at the given block
+func (j *jsonRPCHub) GetEpochValidators(header *types.Header, epoch uint64) ([]*jsonrpc.EpochValidator, error) {
+	polybft, err := j.polybft()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := polybft.GetEpochValidators(header, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*jsonrpc.EpochValidator, len(validators))
+
+	for i, v := range validators {
+		res[i] = &jsonrpc.EpochValidator{
+			Address:     v.Address,
+			BlsKey:      v.BlsKey.Marshal(),
+			VotingPower: v.VotingPower,
+		}
+	}
+
+	return res, nil
+}
+
+// GetDelegations returns the delegations of the delegator at the given block
+func (j *jsonRPCHub) GetDelegations(header *types.Header, delegator types.Address) ([]*jsonrpc.Delegation, error) {
+	stakingState, err := j.stakingState(header)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := stakingState.GetDelegations(delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDelegations(delegations), nil
+}
+
+// GetPendingRewards returns the rewards of the account which are not claimed yet at the given block
+func (j *jsonRPCHub) GetPendingRewards(header *types.Header, addr types.Address) (*jsonrpc.PendingRewards, error) {
+	stakingState, err := j.stakingState(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := stakingState.GetPendingRewards(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.PendingRewards{
+		Staking:     rewards.Staking,
+		Commission:  rewards.Commission,
+		Delegations: toDelegations(rewards.Delegations),
+	}, nil
+}
+
+// GetAPR returns the APR of the staking rewards at the given block
+func (j *jsonRPCHub) GetAPR(header *types.Header) (*jsonrpc.APR, error) {
+	stakingState, err := j.stakingState(header)
+	if err != nil {
+		return nil, err
+	}
+
+	apr, err := stakingState.GetAPR()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.APR{
+		Base:        apr.Base,
+		Max:         apr.Max,
+		RSIBonus:    apr.RSIBonus,
+		MacroFactor: apr.MacroFactor,
+		Denominator: apr.Denominator,
+	}, nil
+}
+
+// GetRewardHistory returns the indexed rewards of the epochs in the given range (inclusive)
+func (j *jsonRPCHub) GetRewardHistory(fromEpoch, toEpoch uint64) ([]*jsonrpc.EpochRewards, error) {
+	polybft, err := j.polybft()
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := polybft.GetRewardHistory(fromEpoch, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*jsonrpc.EpochRewards, len(history))
+
+	for i, r := range history {
+		uptime := make([]*jsonrpc.ValidatorUptime, len(r.Uptime))
+		for k, u := range r.Uptime {
+			uptime[k] = &jsonrpc.ValidatorUptime{Validator: u.Validator, SignedBlocks: u.SignedBlocks}
+		}
+
+		res[i] = &jsonrpc.EpochRewards{
+			Epoch:             r.Epoch,
+			StartBlock:        r.StartBlock,
+			EndBlock:          r.EndBlock,
+			Uptime:            uptime,
+			StakingRewards:    toAccountRewards(r.StakingRewards),
+			DelegatorRewards:  toAccountRewards(r.DelegatorRewards),
+			RewardWalletFund:  r.RewardWalletFund,
+			VaultFunded:       r.VaultFunded,
+			DAOIncentive:      r.DAOIncentive,
+			FeeHandlerBalance: r.FeeHandlerBalance,
+			FeeHandlerIncome:  r.FeeHandlerIncome,
+		}
+	}
+
+	return res, nil
+}
+
+// GetValidatorHealth returns the liveness statistics of the validators collected since the node started
+func (j *jsonRPCHub) GetValidatorHealth() (*jsonrpc.ValidatorHealthReport, error) {
+	polybft, err := j.polybft()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, doubleSigns, blockNumber := polybft.GetValidatorHealth()
+
+	res := &jsonrpc.ValidatorHealthReport{
+		BlockNumber: blockNumber,
+		Validators:  make([]*jsonrpc.ValidatorHealth, len(validators)),
+		DoubleSigns: make([]*jsonrpc.DoubleSignEvidence, len(doubleSigns)),
+	}
+
+	for i, v := range validators {
+		res.Validators[i] = &jsonrpc.ValidatorHealth{
+			Address:                 v.Address,
+			SignedBlocks:            v.SignedBlocks,
+			MissedBlocks:            v.MissedBlocks,
+			ConsecutiveMissedBlocks: v.ConsecutiveMissedBlocks,
+			LastSignedBlock:         v.LastSignedBlock,
+			ProposedBlocks:          v.ProposedBlocks,
+			MissedProposals:         v.MissedProposals,
+			DoubleSigns:             v.DoubleSigns,
+		}
+	}
+
+	for i, e := range doubleSigns {
+		res.DoubleSigns[i] = &jsonrpc.DoubleSignEvidence{
+			Validator:      e.Validator,
+			Height:         e.Height,
+			Round:          e.Round,
+			ProposalHashes: e.ProposalHashes,
+		}
+	}
+
+	return res, nil
+}
+
+// stakingState returns the staking state of the chain at the given block
+func (j *jsonRPCHub) stakingState(header *types.Header) (*consensusPolyBFT.StakingState, error) {
+	polybft, err := j.polybft()
+	if err != nil {
+		return nil, err
+	}
+
+	return polybft.GetStakingState(header)
+}
+
+func toDelegations(delegations []*consensusPolyBFT.Delegation) []*jsonrpc.Delegation {
+	res := make([]*jsonrpc.Delegation, len(delegations))
+
+	for i, d := range delegations {
+		res[i] = &jsonrpc.Delegation{
+			Validator:  d.Validator,
+			Amount:     d.Amount,
+			Reward:     d.Reward,
+			IsVesting:  d.IsVesting,
+			VestingEnd: d.VestingEnd,
+		}
+	}
+
+	return res
+}
+
+func toAccountRewards(rewards []*consensusPolyBFT.AccountReward) []*jsonrpc.AccountReward {
+	res := make([]*jsonrpc.AccountReward, len(rewards))
+
+	for i, r := range rewards {
+		res[i] = &jsonrpc.AccountReward{Account: r.Account, Amount: r.Amount}
+	}
+
+	return res
+}
+
 func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
 	acct, err := getAccountImpl(j.state, root, addr)
 	if err != nil {
@@ -673,6 +1072,75 @@ func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.A
 	return account, nil
 }
 
+// GetOracleStatus returns the current state of the price oracle
+func (j *jsonRPCHub) GetOracleStatus() (*jsonrpc.OracleStatus, error) {
+	status, err := j.priceOracle.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.OracleStatus{
+		Validator:         status.Validator,
+		IsValidator:       status.IsValidator,
+		BlockNumber:       status.BlockNumber,
+		Day:               status.Day,
+		VotingWindowStart: status.VotingWindowStart,
+		VotingWindowEnd:   status.VotingWindowEnd,
+		InVotingWindow:    status.InVotingWindow,
+		Today:             toOracleDayVote(status.Today),
+		LastVote:          toOracleDayVote(status.LastVote),
+	}, nil
+}
+
+// GetOracleVotes returns the price oracle vote state of the given day
+func (j *jsonRPCHub) GetOracleVotes(day uint64) (*jsonrpc.OracleDayVote, error) {
+	vote, err := j.priceOracle.GetVotes(day)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOracleDayVote(vote), nil
+}
+
+// GetOraclePendingVote returns the price oracle vote of the current day waiting to be retried
+func (j *jsonRPCHub) GetOraclePendingVote() (*jsonrpc.OracleDayVote, error) {
+	vote, err := j.priceOracle.GetPendingVote()
+	if err != nil {
+		return nil, err
+	}
+
+	return toOracleDayVote(vote), nil
+}
+
+func toOracleDayVote(vote *priceoracle.DayVote) *jsonrpc.OracleDayVote {
+	if vote == nil {
+		return nil
+	}
+
+	votes := make([]*jsonrpc.OracleVote, len(vote.Votes))
+	for i, v := range vote.Votes {
+		votes[i] = &jsonrpc.OracleVote{
+			Validator:   v.Validator,
+			Price:       v.Price,
+			BlockNumber: v.BlockNumber,
+			TxHash:      v.TxHash,
+		}
+	}
+
+	return &jsonrpc.OracleDayVote{
+		Day:           vote.Day,
+		Status:        string(vote.Status),
+		Price:         vote.Price,
+		TxHash:        vote.TxHash,
+		ReceiptStatus: vote.ReceiptStatus,
+		Attempts:      vote.Attempts,
+		LastError:     vote.LastError,
+		LastAttempt:   vote.LastAttempt,
+		NextAttempt:   vote.NextAttempt,
+		Votes:         votes,
+	}
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return j.Executor.GetForksInTime(blockNumber)
@@ -708,6 +1176,60 @@ func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error
 	return code, nil
 }
 
+func (j *jsonRPCHub) GetProof(
+	root types.Hash,
+	addr types.Address,
+	storageKeys []types.Hash,
+) (*jsonrpc.AccountProof, error) {
+	account, err := getAccountImpl(j.state, root, addr)
+	if err != nil {
+		if !errors.Is(err, jsonrpc.ErrStateNotFound) {
+			return nil, err
+		}
+
+		// the proof of a non-existing account shows its absence from the state trie
+		account = &state.Account{
+			Balance:  big.NewInt(0),
+			CodeHash: types.EmptyCodeHash.Bytes(),
+			Root:     types.EmptyRootHash,
+		}
+	}
+
+	accountProof, err := itrie.Prove(root, crypto.Keccak256(addr.Bytes()), j.stateStorage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prove account %s: %w", addr, err)
+	}
+
+	res := &jsonrpc.AccountProof{
+		Balance:      account.Balance,
+		Nonce:        account.Nonce,
+		CodeHash:     types.BytesToHash(account.CodeHash),
+		StorageHash:  account.Root,
+		AccountProof: accountProof,
+		StorageProof: make([]*jsonrpc.StorageProof, len(storageKeys)),
+	}
+
+	snap, err := j.state.NewSnapshotAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range storageKeys {
+		proof, err := itrie.Prove(account.Root, crypto.Keccak256(key.Bytes()), j.stateStorage)
+		if err != nil {
+			return nil, fmt.Errorf("unable to prove storage slot %s of account %s: %w", key, addr, err)
+		}
+
+		res.StorageProof[i] = &jsonrpc.StorageProof{
+			Key:   key,
+			Value: snap.GetStorage(addr, account.Root, key).Bytes(),
+			Proof: proof,
+		}
+	}
+
+	return res, nil
+}
+
 func (j *jsonRPCHub) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
@@ -737,6 +1259,40 @@ func (j *jsonRPCHub) ApplyTxn(
 	return
 }
 
+// NewSimulation returns the transition on top of the state of the given header,
+// in which the transactions are simulated one after another
+func (j *jsonRPCHub) NewSimulation(header *types.Header) (*state.Transition, error) {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.BeginTxn(header.StateRoot, header, blockCreator)
+}
+
+// ApplyTxnWithTracer applies a transaction object to the blockchain, tracing its execution
+func (j *jsonRPCHub) ApplyTxnWithTracer(
+	header *types.Header,
+	txn *types.Transaction,
+	tracer tracer.Tracer,
+	nonPayable bool,
+) (*runtime.ExecutionResult, error) {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	transition.SetNonPayable(nonPayable)
+	transition.SetTracer(tracer)
+
+	return transition.Apply(txn)
+}
+
 // TraceBlock traces all transactions in the given block and returns all results
 func (j *jsonRPCHub) TraceBlock(
 	block *types.Block,
@@ -877,6 +1433,7 @@ func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 func (s *Server) setupJSONRPC() error {
 	hub := &jsonRPCHub{
 		state:              s.state,
+		stateStorage:       s.stateStorage,
 		restoreProgression: s.restoreProgression,
 		Blockchain:         s.blockchain,
 		TxPool:             s.txpool,
@@ -885,6 +1442,8 @@ func (s *Server) setupJSONRPC() error {
 		Server:             s.network,
 		BridgeDataProvider: s.consensus.GetBridgeProvider(),
 		GasStore:           s.gasHelper,
+		priceOracle:        s.priceOracle,
+		bloomIndexer:       s.bloomIndexer,
 	}
 
 	conf := &jsonrpc.Config{
@@ -943,6 +1502,16 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 
 // Close closes the Minimal server (blockchain, networking, consensus)
 func (s *Server) Close() {
+	// Stop the state pruning before closing the blockchain and the state storage
+	if s.statePruner != nil {
+		s.statePruner.close()
+	}
+
+	// Stop the bloom bits indexing before closing the blockchain
+	if s.bloomIndexer != nil {
+		s.bloomIndexer.Close()
+	}
+
 	// Close the blockchain layer
 	if err := s.blockchain.Close(); err != nil {
 		s.logger.Error("failed to close blockchain", "err", err.Error())
@@ -1031,7 +1600,7 @@ func initForkManager(engineName string, config *chain.Chain) error {
 	// Register forks
 	for name, f := range *config.Params.Forks {
 		// check if fork is not supported by current edge version
-		if _, found := (*chain.AllForksEnabled)[name]; !found {
+		if !chain.IsSupportedFork(name) {
 			return fmt.Errorf("fork is not available: %s", name)
 		}
 
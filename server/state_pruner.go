@@ -0,0 +1,153 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const statePrunerMetrics = "state_pruner"
+
+// statePruner periodically removes the state which is older than the retained number of blocks
+type statePruner struct {
+	logger     hclog.Logger
+	storage    *itrie.PruningStorage
+	blockchain *blockchain.Blockchain
+
+	// retainBlocks is the number of latest blocks whose state is kept
+	retainBlocks uint64
+	// interval is the number of blocks between two pruning runs
+	interval uint64
+	// pinnedRoots are the state roots which are never pruned (genesis state)
+	pinnedRoots []types.Hash
+
+	running atomic.Bool
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+}
+
+func newStatePruner(
+	logger hclog.Logger,
+	storage *itrie.PruningStorage,
+	blockchain *blockchain.Blockchain,
+	retainBlocks, interval uint64,
+	pinnedRoots []types.Hash,
+) *statePruner {
+	return &statePruner{
+		logger:       logger.Named("state_pruner"),
+		storage:      storage,
+		blockchain:   blockchain,
+		retainBlocks: retainBlocks,
+		interval:     interval,
+		pinnedRoots:  pinnedRoots,
+		closeCh:      make(chan struct{}),
+	}
+}
+
+// start starts listening for new blocks and prunes the state every interval blocks
+func (p *statePruner) start() {
+	sub := p.blockchain.SubscribeEvents()
+
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+		defer p.blockchain.UnsubscribeEvents(sub)
+
+		eventCh := sub.GetEventCh()
+
+		for {
+			select {
+			case <-p.closeCh:
+				return
+			case ev := <-eventCh:
+				if ev == nil || len(ev.NewChain) == 0 {
+					continue
+				}
+
+				// the state of the blocks built on top of the new head is kept by the next pruning
+				p.storage.Checkpoint()
+
+				head := ev.NewChain[len(ev.NewChain)-1].Number
+				if head%p.interval == 0 {
+					p.prune(head)
+				}
+			}
+		}
+	}()
+}
+
+// prune starts a pruning run in the background, unless one is already running
+func (p *statePruner) prune(head uint64) {
+	if !p.running.CompareAndSwap(false, true) {
+		p.logger.Debug("previous state pruning is still running, skipping", "block", head)
+
+		return
+	}
+
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+		defer p.running.Store(false)
+
+		p.logger.Info("state pruning started", "block", head)
+
+		result, err := p.storage.Prune(func() []types.Hash {
+			return p.retainedRoots(head)
+		})
+		if err != nil {
+			p.logger.Error("state pruning failed", "block", head, "err", err)
+
+			return
+		}
+
+		p.logger.Info("state pruning finished",
+			"block", head,
+			"retained roots", result.RetainedRoots,
+			"retained nodes", result.RetainedNodes,
+			"removed nodes", result.RemovedNodes,
+			"removed code", result.RemovedCode,
+			"freed bytes", result.FreedBytes,
+			"duration", result.Duration,
+		)
+
+		metrics.IncrCounter([]string{statePrunerMetrics, "freed_bytes"}, float32(result.FreedBytes))
+		metrics.IncrCounter([]string{statePrunerMetrics, "removed_nodes"}, float32(result.RemovedNodes))
+		metrics.SetGauge([]string{statePrunerMetrics, "retained_nodes"}, float32(result.RetainedNodes))
+		metrics.SetGauge([]string{statePrunerMetrics, "duration_seconds"}, float32(result.Duration.Seconds()))
+	}()
+}
+
+// retainedRoots returns the state roots of the retained blocks and the pinned roots
+func (p *statePruner) retainedRoots(head uint64) []types.Hash {
+	roots := append([]types.Hash{}, p.pinnedRoots...)
+
+	from := uint64(0)
+	if head >= p.retainBlocks {
+		from = head - p.retainBlocks + 1
+	}
+
+	for n := from; n <= head; n++ {
+		header, ok := p.blockchain.GetHeaderByNumber(n)
+		if !ok {
+			continue
+		}
+
+		roots = append(roots, header.StateRoot)
+	}
+
+	return roots
+}
+
+// close stops the pruner and waits for the running pruning to finish
+func (p *statePruner) close() {
+	close(p.closeCh)
+	p.wg.Wait()
+}
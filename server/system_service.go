@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/network/common"
@@ -116,6 +117,68 @@ func (s *systemService) PeersStatus(ctx context.Context, req *proto.PeersStatusR
 	return peer, nil
 }
 
+// PeersBan implements the 'peers ban' operator service
+func (s *systemService) PeersBan(_ context.Context, req *proto.PeersBanRequest) (*proto.PeersBanResponse, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := s.server.config.Network.PeerBanDuration
+	if req.Duration != 0 {
+		duration = time.Duration(req.Duration) * time.Second
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "banned by the operator"
+	}
+
+	s.server.network.BanPeer(peerID, duration, reason)
+
+	return &proto.PeersBanResponse{
+		Message: fmt.Sprintf("Peer banned for %s", duration),
+	}, nil
+}
+
+// PeersUnban implements the 'peers unban' operator service
+func (s *systemService) PeersUnban(_ context.Context, req *proto.PeersUnbanRequest) (*proto.PeersUnbanResponse, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.server.network.UnbanPeer(peerID) {
+		return &proto.PeersUnbanResponse{
+			Message: "Peer is not banned",
+		}, nil
+	}
+
+	return &proto.PeersUnbanResponse{
+		Message: "Peer unbanned",
+	}, nil
+}
+
+// PeersTrust implements the 'peers trust' operator service
+func (s *systemService) PeersTrust(_ context.Context, req *proto.PeersTrustRequest) (*proto.PeersTrustResponse, error) {
+	peerID, err := peer.Decode(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.network.TrustPeer(peerID, !req.Revoke)
+
+	if req.Revoke {
+		return &proto.PeersTrustResponse{
+			Message: "Peer trust revoked",
+		}, nil
+	}
+
+	return &proto.PeersTrustResponse{
+		Message: "Peer marked as trusted",
+	}, nil
+}
+
 // getPeer returns a specific proto.Peer using the peer ID
 func (s *systemService) getPeer(id peer.ID) (*proto.Peer, error) {
 	protocols, err := s.server.network.GetProtocols(id)
@@ -187,8 +250,8 @@ func (s *systemService) Export(req *proto.ExportRequest, stream proto.System_Exp
 	}
 
 	if req.To != 0 {
-		if from >= req.To {
-			return errors.New("to must be greater than from")
+		if from > req.To {
+			return errors.New("to must be greater than or equal to from")
 		}
 
 		to = &req.To
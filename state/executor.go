@@ -26,6 +26,9 @@ const (
 
 	TxGas                 uint64 = 21000 // Per transaction not creating a contract
 	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
+
+	TxAccessListAddressGas    uint64 = 2400 // Per address specified in the access list
+	TxAccessListStorageKeyGas uint64 = 1900 // Per storage key specified in the access list
 )
 
 // GetHashByNumber returns the hash function of a block number
@@ -212,6 +215,7 @@ func (e *Executor) BeginTxn(
 		getHash:  e.GetHash(header),
 		auxState: e.state,
 		config:   forkConfig,
+		forks:    e.config.Forks,
 		gasPool:  uint64(txCtx.GasLimit), //nolint:gosec
 
 		receipts: []*types.Receipt{},
@@ -260,6 +264,7 @@ type Transition struct {
 	snap     Snapshot
 
 	config  chain.ForksInTime
+	forks   *chain.Forks
 	state   *Txn
 	getHash GetHashByNumber
 	ctx     runtime.TxContext
@@ -324,6 +329,41 @@ func (t *Transition) WithStateOverride(override types.StateOverride) error {
 	return nil
 }
 
+// WithBlockOverride overrides the block context of the next transactions.
+// The gas pool is refilled to the block gas limit, as for a new block,
+// and the forks are the ones active at the overridden block number
+func (t *Transition) WithBlockOverride(override *types.BlockOverride) {
+	if override.Number != nil {
+		t.ctx.Number = int64(*override.Number) //nolint:gosec
+
+		if t.forks != nil {
+			t.config = t.forks.At(*override.Number)
+		}
+	}
+
+	if override.Timestamp != nil {
+		t.ctx.Timestamp = int64(*override.Timestamp) //nolint:gosec
+	}
+
+	if override.GasLimit != nil {
+		t.ctx.GasLimit = int64(*override.GasLimit) //nolint:gosec
+	}
+
+	if override.Coinbase != nil {
+		t.ctx.Coinbase = *override.Coinbase
+	}
+
+	if override.Difficulty != nil {
+		t.ctx.Difficulty = *override.Difficulty
+	}
+
+	if override.BaseFee != nil {
+		t.ctx.BaseFee = new(big.Int).Set(override.BaseFee)
+	}
+
+	t.gasPool = uint64(t.ctx.GasLimit) //nolint:gosec
+}
+
 func (t *Transition) TotalGas() uint64 {
 	return t.totalGas
 }
@@ -339,7 +379,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	var err error
 
 	if txn.From == emptyFrom &&
-		(txn.Type == types.LegacyTx || txn.Type == types.DynamicFeeTx) {
+		(txn.Type == types.LegacyTx || txn.Type == types.DynamicFeeTx || txn.Type == types.AccessListTx) {
 		// Decrypt the from address
 		signer := crypto.NewSigner(t.config, uint64(t.ctx.ChainID)) //nolint:gosec
 
@@ -349,6 +389,39 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		}
 	}
 
+	_, err = t.WriteWithResult(txn)
+
+	return err
+}
+
+// checkAccessList rejects the access list transactions, and the access list of any other
+// transaction type, before the berlin hardfork, so they are never executed with the EIP-2930 semantics
+func (t *Transition) checkAccessList(txn *types.Transaction) error {
+	if t.config.Berlin {
+		return nil
+	}
+
+	if txn.Type == types.AccessListTx {
+		return NewTransitionApplicationError(
+			fmt.Errorf("%w: type %d rejected, berlin hardfork is not enabled", types.ErrTxTypeNotSupported, txn.Type),
+			false,
+		)
+	}
+
+	if len(txn.AccessList) > 0 {
+		return NewTransitionApplicationError(ErrAccessListBeforeBerlin, false)
+	}
+
+	return nil
+}
+
+// WriteWithResult applies the transaction of the known sender, adds its receipt
+// to the receipts of the transition and returns its execution result
+func (t *Transition) WriteWithResult(txn *types.Transaction) (*runtime.ExecutionResult, error) {
+	if err := t.checkAccessList(txn); err != nil {
+		return nil, err
+	}
+
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
@@ -361,7 +434,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	if e != nil {
 		t.logger.Error("failed to apply tx", "err", e)
 
-		return e
+		return nil, e
 	}
 
 	t.totalGas += result.GasUsed
@@ -377,7 +450,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 
 	// The suicided accounts are set as deleted for the next iteration
 	if err := t.state.CleanDeleteObjects(true); err != nil {
-		return fmt.Errorf("failed to clean deleted objects: %w", err)
+		return nil, fmt.Errorf("failed to clean deleted objects: %w", err)
 	}
 
 	if result.Failed() {
@@ -396,7 +469,7 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	receipt.LogsBloom = types.CreateBloom([]*types.Receipt{receipt})
 	t.receipts = append(t.receipts, receipt)
 
-	return nil
+	return result, nil
 }
 
 // Commit commits the final result
@@ -519,6 +592,9 @@ var (
 
 	// ErrNonceUintOverflow is returned if uint64 overflow happens
 	ErrNonceUintOverflow = errors.New("nonce uint64 overflow")
+
+	// ErrAccessListBeforeBerlin is returned if the transaction has an access list before the berlin hardfork
+	ErrAccessListBeforeBerlin = errors.New("access list rejected, berlin hardfork is not enabled")
 )
 
 type TransitionApplicationError struct {
@@ -552,6 +628,8 @@ func NewGasLimitReachedTransitionApplicationError(
 func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, error) {
 	var err error
 
+	t.captureTxPrepare(msg)
+
 	if msg.Type == types.StateTx {
 		err = checkAndProcessStateTx(msg)
 	} else {
@@ -584,7 +662,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	}
 
 	// 4. there is no overflow when calculating intrinsic gas
-	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul)
+	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul, t.config.Berlin)
 	if err != nil {
 		return nil, NewTransitionApplicationError(err, false)
 	}
@@ -599,6 +677,14 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	gasPrice := msg.GetGasPrice(t.ctx.BaseFee.Uint64())
 	value := new(big.Int).Set(msg.Value)
 
+	if t.config.Berlin {
+		t.prepareAccessList(msg)
+	}
+
+	if t.config.Cancun {
+		t.state.ClearTransientStorage()
+	}
+
 	// set the specific transaction fields in the context
 	t.ctx.GasPrice = types.BytesToHash(gasPrice.Bytes())
 	t.ctx.Origin = msg.From
@@ -626,10 +712,6 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	refund := t.state.GetRefund()
 	result.UpdateGasUsed(msg.Gas, refund)
 
-	if t.ctx.Tracer != nil {
-		t.ctx.Tracer.TxEnd(result.GasLeft)
-	}
-
 	// Refund the sender
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), gasPrice)
 	t.state.AddBalance(msg.From, remaining)
@@ -663,9 +745,38 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	// return gas to the pool
 	t.addGasPool(result.GasLeft)
 
+	// the tracers see the state after the refund and the fees
+	if t.ctx.Tracer != nil {
+		t.ctx.Tracer.TxEnd(result.GasLeft)
+	}
+
 	return result, nil
 }
 
+// prepareAccessList initializes the access list of the transaction (EIP-2929) with the sender,
+// the recipient, the active precompiles and the entries of the transaction access list (EIP-2930)
+func (t *Transition) prepareAccessList(msg *types.Transaction) {
+	t.state.ClearAccessList()
+
+	t.state.AddAddressToAccessList(msg.From)
+
+	if msg.To != nil {
+		t.state.AddAddressToAccessList(*msg.To)
+	}
+
+	for _, addr := range t.precompiles.Addresses(&t.config) {
+		t.state.AddAddressToAccessList(addr)
+	}
+
+	for _, tuple := range msg.AccessList {
+		t.state.AddAddressToAccessList(tuple.Address)
+
+		for _, key := range tuple.StorageKeys {
+			t.state.AddSlotToAccessList(tuple.Address, key)
+		}
+	}
+}
+
 func (t *Transition) Create2(
 	caller types.Address,
 	code []byte,
@@ -831,6 +942,11 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		return &runtime.ExecutionResult{Err: err}
 	}
 
+	// The created address is warm even if the creation fails (EIP-2929)
+	if t.config.Berlin {
+		t.state.AddAddressToAccessList(c.Address)
+	}
+
 	// Check if there is a collision and the address already exists
 	if t.hasCodeOrNonce(c.Address) {
 		return &runtime.ExecutionResult{
@@ -861,7 +977,7 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 
 	var result *runtime.ExecutionResult
 
-	t.captureCallStart(c, evm.CREATE)
+	t.captureCallStart(c, runtime.Create)
 
 	defer func() {
 		// pass result to be set later
@@ -1112,7 +1228,31 @@ func (t *Transition) GetRefund() uint64 {
 	return t.state.GetRefund()
 }
 
-func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (uint64, error) {
+func (t *Transition) AddAddressToAccessList(addr types.Address) {
+	t.state.AddAddressToAccessList(addr)
+}
+
+func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	t.state.AddSlotToAccessList(addr, slot)
+}
+
+func (t *Transition) ContainsAccessListAddress(addr types.Address) bool {
+	return t.state.ContainsAccessListAddress(addr)
+}
+
+func (t *Transition) ContainsAccessListSlot(addr types.Address, slot types.Hash) (bool, bool) {
+	return t.state.ContainsAccessListSlot(addr, slot)
+}
+
+func (t *Transition) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return t.state.GetTransientState(addr, key)
+}
+
+func (t *Transition) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {
+	t.state.SetTransientState(addr, key, value)
+}
+
+func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul, isBerlin bool) (uint64, error) {
 	cost := uint64(0)
 
 	// Contract creation is only paid on the homestead fork
@@ -1152,6 +1292,12 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 		cost += zeros * 4
 	}
 
+	// the access list is only paid on the berlin fork (EIP-2930)
+	if isBerlin && len(msg.AccessList) > 0 {
+		cost += uint64(len(msg.AccessList)) * TxAccessListAddressGas
+		cost += uint64(msg.AccessList.StorageKeys()) * TxAccessListStorageKeyGas //nolint:gosec
+	}
+
 	return cost, nil
 }
 
@@ -1230,6 +1376,26 @@ func checkAndProcessStateTx(msg *types.Transaction) error {
 	return nil
 }
 
+// captureTxPrepare calls TxPrepare in Tracer if context has the tracer which reads the state
+func (t *Transition) captureTxPrepare(msg *types.Transaction) {
+	stateTracer, ok := t.ctx.Tracer.(tracer.StateTracer)
+	if !ok {
+		return
+	}
+
+	to := crypto.CreateAddress(msg.From, t.state.GetNonce(msg.From))
+	if msg.To != nil {
+		to = *msg.To
+	}
+
+	stateTracer.TxPrepare(t, []types.Address{
+		msg.From,
+		to,
+		contracts.HydraBurnAddress,
+		contracts.FeeHandlerContract,
+	})
+}
+
 // captureCallStart calls CallStart in Tracer if context has the tracer
 func (t *Transition) captureCallStart(c *runtime.Contract, callType runtime.CallType) {
 	if t.ctx.Tracer == nil {
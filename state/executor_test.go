@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -467,3 +468,231 @@ func TestExecutor_Apply(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionGasCost_AccessList(t *testing.T) {
+	t.Parallel()
+
+	to := types.StringToAddress("2")
+	tx := &types.Transaction{
+		Type: types.AccessListTx,
+		To:   &to,
+		AccessList: types.TxAccessList{
+			{Address: to, StorageKeys: []types.Hash{types.StringToHash("1"), types.StringToHash("2")}},
+			{Address: types.StringToAddress("3")},
+		},
+	}
+
+	cost, err := TransactionGasCost(tx, true, true, true)
+	require.NoError(t, err)
+	require.Equal(t, TxGas+2*TxAccessListAddressGas+2*TxAccessListStorageKeyGas, cost)
+
+	// the access list is not paid before berlin
+	cost, err = TransactionGasCost(tx, true, true, false)
+	require.NoError(t, err)
+	require.Equal(t, TxGas, cost)
+}
+
+func TestTransition_AccessListBeforeBerlin(t *testing.T) {
+	t.Parallel()
+
+	from := types.StringToAddress("0x100")
+	to := types.StringToAddress("0x200")
+
+	txn := newTxn(newStateWithPreState(map[types.Address]*PreState{
+		from: {Balance: 1000000000},
+	}))
+
+	tr := NewTransition(chain.ForksInTime{Byzantium: true, Istanbul: true}, txn.snapshot.(Snapshot), txn) //nolint:forcetypeassert
+	tr.ctx = runtime.TxContext{BaseFee: big.NewInt(0)}
+	tr.gasPool = 1000000
+
+	err := tr.Write(&types.Transaction{
+		Type:     types.AccessListTx,
+		From:     from,
+		To:       &to,
+		Value:    big.NewInt(0),
+		GasPrice: big.NewInt(0),
+		Gas:      100000,
+	})
+	require.ErrorContains(t, err, types.ErrTxTypeNotSupported.Error())
+	require.Empty(t, tr.Receipts())
+
+	// the access list of the dynamic fee transaction is rejected as well
+	err = tr.Write(&types.Transaction{
+		Type:       types.DynamicFeeTx,
+		From:       from,
+		To:         &to,
+		Value:      big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		Gas:        100000,
+		AccessList: types.TxAccessList{{Address: to, StorageKeys: []types.Hash{types.StringToHash("3")}}},
+	})
+	require.ErrorContains(t, err, ErrAccessListBeforeBerlin.Error())
+	require.Empty(t, tr.Receipts())
+
+	// the dynamic fee transaction without the access list is executed
+	require.NoError(t, tr.Write(&types.Transaction{
+		Type:      types.DynamicFeeTx,
+		From:      from,
+		To:        &to,
+		Value:     big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+		GasTipCap: big.NewInt(0),
+		Gas:       100000,
+	}))
+	require.Len(t, tr.Receipts(), 1)
+}
+
+func TestTransition_WithBlockOverride_Forks(t *testing.T) {
+	t.Parallel()
+
+	from := types.StringToAddress("0x100")
+	to := types.StringToAddress("0x200")
+
+	txn := newTxn(newStateWithPreState(map[types.Address]*PreState{
+		from: {Balance: 1000000000},
+	}))
+
+	forks := &chain.Forks{
+		chain.Byzantium: chain.NewFork(0),
+		chain.Istanbul:  chain.NewFork(0),
+		chain.Berlin:    chain.NewFork(10),
+	}
+
+	tr := NewTransition(forks.At(1), txn.snapshot.(Snapshot), txn) //nolint:forcetypeassert
+	tr.forks = forks
+	tr.ctx = runtime.TxContext{BaseFee: big.NewInt(0)}
+	tr.gasPool = 1000000
+
+	newTx := func(nonce uint64) *types.Transaction {
+		return &types.Transaction{
+			Type:       types.AccessListTx,
+			From:       from,
+			To:         &to,
+			Nonce:      nonce,
+			Value:      big.NewInt(0),
+			GasPrice:   big.NewInt(0),
+			Gas:        100000,
+			AccessList: types.TxAccessList{{Address: to}},
+		}
+	}
+
+	// the access list transaction is rejected before berlin, even if the sender is known
+	_, err := tr.WriteWithResult(newTx(0))
+	require.ErrorContains(t, err, types.ErrTxTypeNotSupported.Error())
+
+	gasLimit := uint64(1000000)
+	number := uint64(9)
+
+	tr.WithBlockOverride(&types.BlockOverride{Number: &number, GasLimit: &gasLimit})
+
+	_, err = tr.WriteWithResult(newTx(0))
+	require.ErrorContains(t, err, types.ErrTxTypeNotSupported.Error())
+
+	// the forks active at the overridden block number are applied
+	number = 10
+
+	tr.WithBlockOverride(&types.BlockOverride{Number: &number, GasLimit: &gasLimit})
+	require.True(t, tr.config.Berlin)
+
+	_, err = tr.WriteWithResult(newTx(0))
+	require.NoError(t, err)
+	require.Len(t, tr.Receipts(), 1)
+}
+
+func TestTransition_AccessList(t *testing.T) {
+	t.Parallel()
+
+	from := types.StringToAddress("0x100")
+	to := types.StringToAddress("0x200")
+	slot := types.StringToHash("3")
+
+	txn := newTxn(newStateWithPreState(map[types.Address]*PreState{
+		from: {Balance: 1000000000},
+	}))
+
+	// SLOAD 0x3, POP, STOP
+	txn.SetCode(to, []byte{0x60, 0x03, 0x54, 0x50, 0x00})
+
+	tr := NewTransition(chain.ForksInTime{Byzantium: true, Istanbul: true, Berlin: true}, txn.snapshot.(Snapshot), txn) //nolint:forcetypeassert
+	tr.ctx = runtime.TxContext{BaseFee: big.NewInt(0)}
+	tr.gasPool = 1000000
+
+	newTx := func(nonce uint64, accessList types.TxAccessList) *types.Transaction {
+		return &types.Transaction{
+			Type:       types.AccessListTx,
+			From:       from,
+			To:         &to,
+			Nonce:      nonce,
+			Value:      big.NewInt(0),
+			GasPrice:   big.NewInt(0),
+			Gas:        100000,
+			AccessList: accessList,
+		}
+	}
+
+	// PUSH1 (3) + cold SLOAD (2100 + 100) + POP (2)
+	// PUSH1 + cold SLOAD + POP
+	result, err := tr.Apply(newTx(0, nil))
+	require.NoError(t, err)
+	require.Equal(t, TxGas+3+2200+2, result.GasUsed)
+
+	// the slot from the transaction access list is warm
+	result, err = tr.Apply(newTx(1, types.TxAccessList{{Address: to, StorageKeys: []types.Hash{slot}}}))
+	require.NoError(t, err)
+	require.Equal(t, TxGas+TxAccessListAddressGas+TxAccessListStorageKeyGas+3+100+2, result.GasUsed)
+}
+
+func TestTransition_PrestateTracer(t *testing.T) {
+	t.Parallel()
+
+	from := types.StringToAddress("0x100")
+	to := types.StringToAddress("0x200")
+	slot := types.StringToHash("3")
+
+	txn := newTxn(newStateWithPreState(map[types.Address]*PreState{
+		from: {Balance: 1000000000},
+	}))
+
+	// SSTORE(0x3, 0x1), STOP
+	code := []byte{0x60, 0x01, 0x60, 0x03, 0x55, 0x00}
+	txn.SetCode(to, code)
+
+	tr := NewTransition(chain.ForksInTime{Byzantium: true, Istanbul: true}, txn.snapshot.(Snapshot), txn) //nolint:forcetypeassert
+	tr.ctx = runtime.TxContext{BaseFee: big.NewInt(0)}
+	tr.gasPool = 1000000
+
+	tracer := prestatetracer.NewPrestateTracer(prestatetracer.Config{DiffMode: true})
+	tr.SetTracer(tracer)
+
+	result, err := tr.Apply(&types.Transaction{
+		From:     from,
+		To:       &to,
+		Value:    big.NewInt(5),
+		GasPrice: big.NewInt(10),
+		Gas:      100000,
+	})
+	require.NoError(t, err)
+
+	fees := new(big.Int).SetUint64(result.GasUsed * 10)
+	senderBalance := new(big.Int).Sub(big.NewInt(1000000000-5), fees)
+	halfFees := new(big.Int).Div(fees, big.NewInt(2))
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, &prestatetracer.DiffResult{
+		Pre: map[types.Address]*prestatetracer.Account{
+			from:                         {Balance: "0x3b9aca00"},
+			to:                           {Balance: "0x0", Code: "0x" + hex.EncodeToString(code)},
+			contracts.HydraBurnAddress:   {Balance: "0x0"},
+			contracts.FeeHandlerContract: {Balance: "0x0"},
+		},
+		Post: map[types.Address]*prestatetracer.Account{
+			from:                         {Balance: fmt.Sprintf("%#x", senderBalance), Nonce: 1},
+			to:                           {Balance: "0x5", Storage: map[types.Hash]types.Hash{slot: types.StringToHash("1")}},
+			contracts.HydraBurnAddress:   {Balance: fmt.Sprintf("%#x", halfFees)},
+			contracts.FeeHandlerContract: {Balance: fmt.Sprintf("%#x", new(big.Int).Sub(fees, halfFees))},
+		},
+	}, res)
+}
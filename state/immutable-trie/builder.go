@@ -0,0 +1,89 @@
+package itrie
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// trieBuilderFlushSize is the number of leaves after which the built nodes
+// are written into the storage and released from the memory
+const trieBuilderFlushSize = 10000
+
+// TrieBuilder builds a trie from its leaves and writes its nodes into the storage.
+// It is used to rebuild a trie from leaves received from another node.
+//
+// The nodes are written every trieBuilderFlushSize leaves, so only the nodes on the paths
+// of the recently inserted leaves are kept in memory. When the leaves of a key range are
+// inserted in the key order, the flushes rewrite only the nodes on the path to the last leaf
+type TrieBuilder struct {
+	storage Storage
+	txn     *Txn
+	// pending is the number of leaves inserted since the last flush
+	pending int
+	// flushSize is the number of leaves after which the nodes are flushed
+	flushSize int
+}
+
+// NewTrieBuilder creates a builder of an empty trie
+func NewTrieBuilder(storage Storage) *TrieBuilder {
+	return &TrieBuilder{
+		storage:   storage,
+		txn:       NewTrie().Txn(storage),
+		flushSize: trieBuilderFlushSize,
+	}
+}
+
+// Insert inserts the leaf with the given (already hashed) key into the trie
+func (b *TrieBuilder) Insert(key, value []byte) error {
+	b.txn.Insert(key, value)
+	b.pending++
+
+	if b.pending < b.flushSize {
+		return nil
+	}
+
+	_, err := b.flush()
+
+	return err
+}
+
+// Commit writes the nodes of the trie into the storage and returns its root
+func (b *TrieBuilder) Commit() (types.Hash, error) {
+	return b.flush()
+}
+
+// flush writes the nodes of the trie into the storage and reloads the trie from its root,
+// so the written nodes are released from the memory
+func (b *TrieBuilder) flush() (types.Hash, error) {
+	batch := b.storage.Batch()
+	b.txn.batch = batch
+
+	root, err := b.txn.Hash()
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	if err := batch.Write(); err != nil {
+		return types.ZeroHash, err
+	}
+
+	b.pending = 0
+
+	if b.txn.root == nil {
+		return types.BytesToHash(root), nil
+	}
+
+	node, ok, err := GetNode(root, b.storage)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	if !ok {
+		return types.ZeroHash, fmt.Errorf("%w %x", errMissingTrieNode, root)
+	}
+
+	b.txn = (&Trie{root: node}).Txn(b.storage)
+
+	return types.BytesToHash(root), nil
+}
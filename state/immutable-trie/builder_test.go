@@ -0,0 +1,81 @@
+package itrie
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieBuilder_Flush(t *testing.T) {
+	t.Parallel()
+
+	type leaf struct {
+		key, value []byte
+	}
+
+	leaves := make([]leaf, 1000)
+	for i := range leaves {
+		value := big.NewInt(int64(i + 1)).Bytes()
+		leaves[i] = leaf{key: crypto.Keccak256(value), value: value}
+	}
+
+	build := func(storage Storage, flushSize int, leaves []leaf) []byte {
+		builder := NewTrieBuilder(storage)
+		builder.flushSize = flushSize
+
+		for _, l := range leaves {
+			require.NoError(t, builder.Insert(l.key, l.value))
+		}
+
+		root, err := builder.Commit()
+		require.NoError(t, err)
+
+		return root.Bytes()
+	}
+
+	expected := build(NewMemoryStorage(), len(leaves)+1, leaves)
+
+	t.Run("random order", func(t *testing.T) {
+		t.Parallel()
+
+		storage := NewMemoryStorage()
+		root := build(storage, 50, leaves)
+		require.Equal(t, expected, root)
+
+		checked, err := HashChecker(root, storage)
+		require.NoError(t, err)
+		require.Equal(t, expected, checked.Bytes())
+	})
+
+	t.Run("key order", func(t *testing.T) {
+		t.Parallel()
+
+		sorted := append([]leaf{}, leaves...)
+		sort.Slice(sorted, func(i, j int) bool {
+			return bytes.Compare(sorted[i].key, sorted[j].key) < 0
+		})
+
+		flushed, unflushed := NewMemoryStorage(), NewMemoryStorage()
+		require.Equal(t, expected, build(flushed, 50, sorted))
+		require.Equal(t, expected, build(unflushed, len(sorted)+1, sorted))
+
+		// only the nodes on the paths to the last leaves of the flushes are rewritten
+		countNodes := func(storage Storage) int {
+			count := 0
+
+			require.NoError(t, storage.(PrunableStorage).Iterate(func(_, _ []byte) bool {
+				count++
+
+				return true
+			}))
+
+			return count
+		}
+
+		require.Less(t, countNodes(flushed)-countNodes(unflushed), 20*len(sorted)/50)
+	})
+}
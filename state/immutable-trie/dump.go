@@ -0,0 +1,503 @@
+package itrie
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// The state dump is a stream of records following the magic bytes and the version.
+// Each record is the record kind, the uvarint encoded payload size and the payload.
+// The dump starts with the header record, every account record is followed by its storage
+// records, the code record follows the first account with the code, and the dump ends with
+// the record containing the number of the dumped entries and the checksum of all the preceding bytes
+const (
+	stateDumpMagic = "HYDRASTATE"
+
+	// StateDumpVersion is the version of the state dump format
+	StateDumpVersion uint8 = 1
+
+	// maxDumpRecordSize is the maximum payload size of a state dump record
+	maxDumpRecordSize = 1024 * 1024
+)
+
+const (
+	dumpHeaderRecord byte = iota
+	dumpAccountRecord
+	dumpStorageRecord
+	dumpCodeRecord
+	dumpEndRecord
+)
+
+var (
+	ErrInvalidStateDump      = errors.New("invalid state dump")
+	errStateDumpChecksum     = errors.New("state dump checksum mismatch")
+	errStateDumpRootMismatch = errors.New("state root mismatch")
+)
+
+// StateDump describes the content of the state dump
+type StateDump struct {
+	Root         types.Hash
+	Block        uint64
+	Accounts     uint64
+	StorageSlots uint64
+	Codes        uint64
+	// Checksum is the SHA-256 hash of the dump content preceding the end record
+	Checksum types.Hash
+}
+
+// ExportState writes the accounts, storage and code of the state with the given root
+// to the output as a state dump. The block number is stored in the dump as an information
+func ExportState(root types.Hash, block uint64, storage Storage, output io.Writer) (*StateDump, error) {
+	buffered := bufio.NewWriter(output)
+	w := &stateDumpWriter{
+		output: buffered,
+		hasher: sha256.New(),
+	}
+
+	dump := &StateDump{
+		Root:  root,
+		Block: block,
+	}
+
+	if err := w.write(append([]byte(stateDumpMagic), StateDumpVersion)); err != nil {
+		return nil, err
+	}
+
+	if err := w.writeRecord(dumpHeaderRecord, root.Bytes(), binary.BigEndian.AppendUint64(nil, block)); err != nil {
+		return nil, err
+	}
+
+	seenCodes := make(map[types.Hash]struct{})
+
+	var writeErr error
+
+	iterErr := Iterate(root, storage, nil, func(key, value []byte) bool {
+		writeErr = w.writeAccount(dump, storage, seenCodes, key, value)
+
+		return writeErr == nil
+	})
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	if writeErr != nil {
+		return nil, writeErr
+	}
+
+	dump.Checksum = types.BytesToHash(w.hasher.Sum(nil))
+
+	if err := w.writeRecord(
+		dumpEndRecord,
+		binary.BigEndian.AppendUint64(nil, dump.Accounts),
+		binary.BigEndian.AppendUint64(nil, dump.StorageSlots),
+		binary.BigEndian.AppendUint64(nil, dump.Codes),
+		dump.Checksum.Bytes(),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return nil, err
+	}
+
+	return dump, nil
+}
+
+// ImportState reads the state dump from the input and writes its tries and code into the storage.
+// The whole dump is verified first, and passed to the check function (if set), so nothing is written
+// from a corrupted or unexpected dump. The rebuilt storage tries and the state trie are checked
+// against the roots in the dump while they are written
+func ImportState(input io.ReadSeeker, storage Storage, check func(dump *StateDump) error) (*StateDump, error) {
+	dump, err := readStateDump(input, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if check != nil {
+		if err := check(dump); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return readStateDump(input, storage)
+}
+
+// VerifyStateDump reads the whole state dump and checks its structure and checksum
+// without building the tries
+func VerifyStateDump(input io.Reader) (*StateDump, error) {
+	return readStateDump(input, nil)
+}
+
+// stateDumpWriter writes the state dump records and computes the checksum of the written bytes
+type stateDumpWriter struct {
+	output io.Writer
+	hasher hash.Hash
+}
+
+// write writes the data to the output, the data is included into the checksum
+func (w *stateDumpWriter) write(data []byte) error {
+	if _, err := w.output.Write(data); err != nil {
+		return err
+	}
+
+	_, err := w.hasher.Write(data)
+
+	return err
+}
+
+// writeRecord writes the record with the payload composed of the given parts
+func (w *stateDumpWriter) writeRecord(kind byte, parts ...[]byte) error {
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	write := w.write
+	if kind == dumpEndRecord {
+		// the end record contains the checksum, so it's not included in it
+		write = func(data []byte) error {
+			_, err := w.output.Write(data)
+
+			return err
+		}
+	}
+
+	if err := write(binary.AppendUvarint([]byte{kind}, uint64(size))); err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		if err := write(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeAccount writes the account record followed by its code and storage records
+func (w *stateDumpWriter) writeAccount(
+	dump *StateDump,
+	storage Storage,
+	seenCodes map[types.Hash]struct{},
+	key, value []byte,
+) error {
+	var account state.Account
+	if err := account.UnmarshalRlp(value); err != nil {
+		return fmt.Errorf("can't parse account %x: %w", key, err)
+	}
+
+	if err := w.writeRecord(dumpAccountRecord, key, value); err != nil {
+		return err
+	}
+
+	dump.Accounts++
+
+	if codeHash := types.BytesToHash(account.CodeHash); hasCode(codeHash) {
+		if _, ok := seenCodes[codeHash]; !ok {
+			code, ok := storage.GetCode(codeHash)
+			if !ok {
+				return fmt.Errorf("can't find code %s", codeHash)
+			}
+
+			if err := w.writeRecord(dumpCodeRecord, codeHash.Bytes(), code); err != nil {
+				return err
+			}
+
+			seenCodes[codeHash] = struct{}{}
+			dump.Codes++
+		}
+	}
+
+	if !hasStorage(account.Root) {
+		return nil
+	}
+
+	var writeErr error
+
+	iterErr := Iterate(account.Root, storage, nil, func(key, value []byte) bool {
+		if writeErr = w.writeRecord(dumpStorageRecord, key, value); writeErr == nil {
+			dump.StorageSlots++
+		}
+
+		return writeErr == nil
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+
+	return writeErr
+}
+
+// stateDumpReader reads the state dump records and computes the checksum of the read bytes
+type stateDumpReader struct {
+	input  *bufio.Reader
+	hasher hash.Hash
+}
+
+// read reads exactly len(data) bytes, the data is included into the checksum if hashed is set
+func (r *stateDumpReader) read(data []byte, hashed bool) error {
+	if _, err := io.ReadFull(r.input, data); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("%w: unexpected end of dump", ErrInvalidStateDump)
+		}
+
+		return err
+	}
+
+	if hashed {
+		r.hasher.Write(data)
+	}
+
+	return nil
+}
+
+// readRecord reads the next record and returns its kind and payload
+func (r *stateDumpReader) readRecord() (byte, []byte, error) {
+	kind, err := r.input.ReadByte()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, fmt.Errorf("%w: unexpected end of dump", ErrInvalidStateDump)
+		}
+
+		return 0, nil, err
+	}
+
+	size, err := binary.ReadUvarint(r.input)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: invalid record size: %w", ErrInvalidStateDump, err)
+	}
+
+	if size > maxDumpRecordSize {
+		return 0, nil, fmt.Errorf("%w: record of %d bytes is too large", ErrInvalidStateDump, size)
+	}
+
+	// the end record is not included in the checksum
+	if kind != dumpEndRecord {
+		r.hasher.Write(binary.AppendUvarint([]byte{kind}, size))
+	}
+
+	payload := make([]byte, size)
+	if err := r.read(payload, kind != dumpEndRecord); err != nil {
+		return 0, nil, err
+	}
+
+	return kind, payload, nil
+}
+
+// importedAccount is the account whose storage records are being read
+type importedAccount struct {
+	key     []byte
+	account state.Account
+	storage *TrieBuilder
+}
+
+// readStateDump reads the state dump and writes it into the storage, if the storage is set
+func readStateDump(input io.Reader, storage Storage) (*StateDump, error) {
+	r := &stateDumpReader{
+		input:  bufio.NewReader(input),
+		hasher: sha256.New(),
+	}
+
+	header := make([]byte, len(stateDumpMagic)+1)
+	if err := r.read(header, true); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(header[:len(stateDumpMagic)], []byte(stateDumpMagic)) {
+		return nil, fmt.Errorf("%w: not a state dump", ErrInvalidStateDump)
+	}
+
+	if version := header[len(stateDumpMagic)]; version != StateDumpVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidStateDump, version)
+	}
+
+	kind, payload, err := r.readRecord()
+	if err != nil {
+		return nil, err
+	}
+
+	if kind != dumpHeaderRecord || len(payload) != types.HashLength+8 {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidStateDump)
+	}
+
+	dump := &StateDump{
+		Root:  types.BytesToHash(payload[:types.HashLength]),
+		Block: binary.BigEndian.Uint64(payload[types.HashLength:]),
+	}
+
+	var (
+		accounts      *TrieBuilder
+		current       *importedAccount
+		requiredCodes = make(map[types.Hash]struct{})
+		importedCodes = make(map[types.Hash]struct{})
+	)
+
+	if storage != nil {
+		accounts = NewTrieBuilder(storage)
+	}
+
+	// finishAccount writes the storage trie of the current account
+	finishAccount := func() error {
+		if current == nil || current.storage == nil {
+			return nil
+		}
+
+		root, err := current.storage.Commit()
+		if err != nil {
+			return err
+		}
+
+		if root != current.account.Root {
+			return fmt.Errorf(
+				"%w: storage of account %x, expected %s, got %s",
+				errStateDumpRootMismatch, current.key, current.account.Root, root,
+			)
+		}
+
+		return nil
+	}
+
+	for {
+		kind, payload, err := r.readRecord()
+		if err != nil {
+			return nil, err
+		}
+
+		if kind == dumpEndRecord {
+			if err := finishAccount(); err != nil {
+				return nil, err
+			}
+
+			if err := dump.verifyEnd(payload, r.hasher.Sum(nil)); err != nil {
+				return nil, err
+			}
+
+			break
+		}
+
+		if len(payload) < types.HashLength {
+			return nil, fmt.Errorf("%w: record %d is too short", ErrInvalidStateDump, kind)
+		}
+
+		switch kind {
+		case dumpAccountRecord:
+			if err := finishAccount(); err != nil {
+				return nil, err
+			}
+
+			current = &importedAccount{key: payload[:types.HashLength]}
+			if err := current.account.UnmarshalRlp(payload[types.HashLength:]); err != nil {
+				return nil, fmt.Errorf("%w: can't parse account %x: %w", ErrInvalidStateDump, current.key, err)
+			}
+
+			if codeHash := types.BytesToHash(current.account.CodeHash); hasCode(codeHash) {
+				requiredCodes[codeHash] = struct{}{}
+			}
+
+			if storage != nil {
+				if err := accounts.Insert(current.key, payload[types.HashLength:]); err != nil {
+					return nil, err
+				}
+
+				if hasStorage(current.account.Root) {
+					current.storage = NewTrieBuilder(storage)
+				}
+			}
+
+			dump.Accounts++
+		case dumpStorageRecord:
+			if current == nil || !hasStorage(current.account.Root) {
+				return nil, fmt.Errorf("%w: storage record without account storage", ErrInvalidStateDump)
+			}
+
+			if storage != nil {
+				if err := current.storage.Insert(payload[:types.HashLength], payload[types.HashLength:]); err != nil {
+					return nil, err
+				}
+			}
+
+			dump.StorageSlots++
+		case dumpCodeRecord:
+			codeHash, code := types.BytesToHash(payload[:types.HashLength]), payload[types.HashLength:]
+			if types.BytesToHash(crypto.Keccak256(code)) != codeHash {
+				return nil, fmt.Errorf("%w: code hash mismatch %s", ErrInvalidStateDump, codeHash)
+			}
+
+			if storage != nil {
+				if err := storage.SetCode(codeHash, code); err != nil {
+					return nil, err
+				}
+			}
+
+			importedCodes[codeHash] = struct{}{}
+			dump.Codes++
+		default:
+			return nil, fmt.Errorf("%w: unknown record %d", ErrInvalidStateDump, kind)
+		}
+	}
+
+	for codeHash := range requiredCodes {
+		if _, ok := importedCodes[codeHash]; !ok {
+			return nil, fmt.Errorf("%w: missing code %s", ErrInvalidStateDump, codeHash)
+		}
+	}
+
+	if storage == nil {
+		return dump, nil
+	}
+
+	root, err := accounts.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if root != dump.Root {
+		return nil, fmt.Errorf("%w: expected %s, got %s", errStateDumpRootMismatch, dump.Root, root)
+	}
+
+	return dump, nil
+}
+
+// verifyEnd checks the end record against the read content of the dump
+func (d *StateDump) verifyEnd(payload []byte, checksum []byte) error {
+	if len(payload) != 3*8+types.HashLength {
+		return fmt.Errorf("%w: invalid end record", ErrInvalidStateDump)
+	}
+
+	d.Checksum = types.BytesToHash(payload[3*8:])
+
+	if !bytes.Equal(checksum, d.Checksum.Bytes()) {
+		return errStateDumpChecksum
+	}
+
+	if binary.BigEndian.Uint64(payload[0:8]) != d.Accounts ||
+		binary.BigEndian.Uint64(payload[8:16]) != d.StorageSlots ||
+		binary.BigEndian.Uint64(payload[16:24]) != d.Codes {
+		return fmt.Errorf("%w: number of entries doesn't match the end record", ErrInvalidStateDump)
+	}
+
+	return nil
+}
+
+func hasCode(codeHash types.Hash) bool {
+	return codeHash != types.EmptyCodeHash && codeHash != types.ZeroHash
+}
+
+func hasStorage(root types.Hash) bool {
+	return root != types.EmptyRootHash && root != types.ZeroHash
+}
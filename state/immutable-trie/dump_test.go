@@ -0,0 +1,171 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDumpState commits accounts with balances, shared code and storage into the storage
+func newTestDumpState(t *testing.T, storage Storage) types.Hash {
+	t.Helper()
+
+	code := []byte{0x60, 0x01, 0x60, 0x02}
+	objs := make([]*state.Object, 0, 20)
+
+	for i := 0; i < 20; i++ {
+		obj := &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i + 1)).Bytes()),
+			Balance:  big.NewInt(int64(i * 1000)),
+			Nonce:    uint64(i),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		}
+
+		// every third account is a contract with the same code and its own storage
+		if i%3 == 0 {
+			obj.CodeHash = types.BytesToHash(crypto.Keccak256(code))
+			obj.DirtyCode = true
+			obj.Code = code
+
+			for j := 0; j < 5; j++ {
+				obj.Storage = append(obj.Storage, &state.StorageObject{
+					Key: types.BytesToHash(big.NewInt(int64(j)).Bytes()).Bytes(),
+					Val: big.NewInt(int64(i*100 + j + 1)).Bytes(),
+				})
+			}
+		}
+
+		objs = append(objs, obj)
+	}
+
+	_, root, err := NewState(storage).NewSnapshot().Commit(objs)
+	require.NoError(t, err)
+
+	return types.BytesToHash(root)
+}
+
+func TestStateDump_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	source := NewMemoryStorage()
+	root := newTestDumpState(t, source)
+
+	var buffer bytes.Buffer
+
+	exported, err := ExportState(root, 42, source, &buffer)
+	require.NoError(t, err)
+	require.Equal(t, root, exported.Root)
+	require.Equal(t, uint64(20), exported.Accounts)
+	require.Equal(t, uint64(35), exported.StorageSlots)
+	require.Equal(t, uint64(1), exported.Codes)
+
+	verified, err := VerifyStateDump(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, exported, verified)
+
+	target := NewMemoryStorage()
+
+	imported, err := ImportState(bytes.NewReader(buffer.Bytes()), target, func(dump *StateDump) error {
+		require.Equal(t, exported, dump)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+
+	checkedRoot, err := HashChecker(root.Bytes(), target)
+	require.NoError(t, err)
+	require.Equal(t, root, checkedRoot)
+
+	// the imported state is readable
+	snap, err := NewState(target).NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(4).Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3000), account.Balance)
+
+	code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	require.True(t, ok)
+	require.Equal(t, []byte{0x60, 0x01, 0x60, 0x02}, code)
+
+	value := snap.GetStorage(types.Address{}, account.Root, types.BytesToHash(big.NewInt(2).Bytes()))
+	require.Equal(t, types.BytesToHash(big.NewInt(303).Bytes()), value)
+}
+
+func TestStateDump_Corrupted(t *testing.T) {
+	t.Parallel()
+
+	source := NewMemoryStorage()
+	root := newTestDumpState(t, source)
+
+	var buffer bytes.Buffer
+
+	_, err := ExportState(root, 1, source, &buffer)
+	require.NoError(t, err)
+
+	dump := buffer.Bytes()
+
+	t.Run("flipped byte", func(t *testing.T) {
+		t.Parallel()
+
+		corrupted := bytes.Clone(dump)
+		corrupted[len(corrupted)/2] ^= 0xff
+
+		target := NewMemoryStorage().(PrunableStorage)
+
+		_, err := ImportState(bytes.NewReader(corrupted), target, nil)
+		require.Error(t, err)
+
+		// nothing is written from the corrupted dump
+		requireEmptyStorage(t, target)
+
+		_, err = VerifyStateDump(bytes.NewReader(corrupted))
+		require.Error(t, err)
+	})
+
+	t.Run("rejected by the check", func(t *testing.T) {
+		t.Parallel()
+
+		target := NewMemoryStorage().(PrunableStorage)
+		errUnexpectedDump := errors.New("unexpected dump")
+
+		_, err := ImportState(bytes.NewReader(dump), target, func(*StateDump) error {
+			return errUnexpectedDump
+		})
+		require.ErrorIs(t, err, errUnexpectedDump)
+
+		requireEmptyStorage(t, target)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := VerifyStateDump(bytes.NewReader(dump[:len(dump)-10]))
+		require.ErrorIs(t, err, ErrInvalidStateDump)
+	})
+
+	t.Run("not a dump", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := VerifyStateDump(bytes.NewReader([]byte("some other file content")))
+		require.ErrorIs(t, err, ErrInvalidStateDump)
+	})
+}
+
+func requireEmptyStorage(t *testing.T, storage PrunableStorage) {
+	t.Helper()
+
+	require.NoError(t, storage.Iterate(func(k, _ []byte) bool {
+		t.Errorf("unexpected key %x", k)
+
+		return true
+	}))
+}
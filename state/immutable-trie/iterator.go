@@ -0,0 +1,154 @@
+package itrie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// IterateFn is called for every leaf of the trie. Iteration stops if it returns false
+type IterateFn func(key, value []byte) bool
+
+// Iterate walks the leaves of the trie with the given root in key order, starting
+// from the first key which is not lower than start, and calls fn with each key and value.
+// The key and value passed to fn are copies, so they can be retained
+func Iterate(root types.Hash, storage Storage, start []byte, fn IterateFn) error {
+	if root == types.EmptyRootHash || root == types.ZeroHash {
+		return nil
+	}
+
+	startNibbles := bytesToHexNibbles(start)
+
+	it := &trieIterator{
+		storage: storage,
+		start:   startNibbles[:len(startNibbles)-1],
+		fn:      fn,
+	}
+
+	_, err := it.iterateHash(root.Bytes(), nil)
+
+	return err
+}
+
+type trieIterator struct {
+	storage Storage
+	start   []byte
+	fn      IterateFn
+}
+
+// iterateHash loads the node with the given hash and iterates its leaves.
+// It returns false if the iteration was stopped
+func (it *trieIterator) iterateHash(hash []byte, path []byte) (bool, error) {
+	data, ok, err := it.storage.Get(hash)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok || len(data) == 0 {
+		return false, fmt.Errorf("%w %x", errMissingTrieNode, hash)
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return false, err
+	}
+
+	return it.iterateNode(v, path)
+}
+
+func (it *trieIterator) iterateNode(v *fastrlp.Value, path []byte) (bool, error) {
+	if v.Type() == fastrlp.TypeBytes {
+		switch len(v.Raw()) {
+		case 0:
+			return true, nil
+		case types.HashLength:
+			return it.iterateHash(v.Raw(), path)
+		default:
+			return false, fmt.Errorf("%w: invalid reference length %d", errInvalidTrieNode, len(v.Raw()))
+		}
+	}
+
+	switch v.Elems() {
+	case 2:
+		keyElem := v.Get(0)
+		if keyElem.Type() != fastrlp.TypeBytes {
+			return false, fmt.Errorf("%w: short key expected to be bytes", errInvalidTrieNode)
+		}
+
+		key := decodeCompact(keyElem.Raw())
+
+		if hasTerminator(key) {
+			return it.emit(joinNibbles(path, key[:len(key)-1]), v.Get(1).Raw())
+		}
+
+		childPath := joinNibbles(path, key)
+		if it.isBeforeStart(childPath) {
+			return true, nil
+		}
+
+		return it.iterateNode(v.Get(1), childPath)
+
+	case 17:
+		if value := v.Get(16).Raw(); len(value) != 0 {
+			if cont, err := it.emit(path, value); !cont || err != nil {
+				return cont, err
+			}
+		}
+
+		for i := 0; i < 16; i++ {
+			childPath := joinNibbles(path, []byte{byte(i)})
+			if it.isBeforeStart(childPath) {
+				continue
+			}
+
+			if cont, err := it.iterateNode(v.Get(i), childPath); !cont || err != nil {
+				return cont, err
+			}
+		}
+
+		return true, nil
+
+	default:
+		return false, fmt.Errorf("%w: node has incorrect number of leafs", errInvalidTrieNode)
+	}
+}
+
+// emit calls the iterate function for the leaf with the given key nibbles
+func (it *trieIterator) emit(keyNibbles []byte, value []byte) (bool, error) {
+	if bytes.Compare(keyNibbles, it.start) < 0 {
+		return true, nil
+	}
+
+	if len(keyNibbles)%2 != 0 {
+		return false, fmt.Errorf("%w: odd key length", errInvalidTrieNode)
+	}
+
+	key := make([]byte, len(keyNibbles)/2)
+	for i := range key {
+		key[i] = keyNibbles[2*i]<<4 | keyNibbles[2*i+1]
+	}
+
+	return it.fn(key, append([]byte(nil), value...)), nil
+}
+
+// isBeforeStart returns true if all the keys with the given prefix are lower than the start key
+func (it *trieIterator) isBeforeStart(prefix []byte) bool {
+	n := len(prefix)
+	if n > len(it.start) {
+		n = len(it.start)
+	}
+
+	return bytes.Compare(prefix[:n], it.start[:n]) < 0
+}
+
+func joinNibbles(a, b []byte) []byte {
+	res := make([]byte, 0, len(a)+len(b))
+	res = append(res, a...)
+
+	return append(res, b...)
+}
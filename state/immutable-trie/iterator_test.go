@@ -0,0 +1,76 @@
+package itrie
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	batch := storage.Batch()
+	txn := NewTrie().Txn(storage)
+	txn.batch = batch
+
+	keys := make([][]byte, 0, 300)
+	values := map[string][]byte{}
+
+	for i := 0; i < 300; i++ {
+		key := crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+		value := []byte{byte(i), 0x1}
+
+		txn.Insert(key, value)
+
+		keys = append(keys, key)
+		values[string(key)] = value
+	}
+
+	root, err := txn.Hash()
+	require.NoError(t, err)
+	require.NoError(t, batch.Write())
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	collect := func(start []byte, limit int) [][]byte {
+		res := [][]byte{}
+
+		require.NoError(t, Iterate(types.BytesToHash(root), storage, start, func(key, value []byte) bool {
+			require.Equal(t, values[string(key)], value)
+
+			res = append(res, key)
+
+			return len(res) < limit
+		}))
+
+		return res
+	}
+
+	// all keys in order
+	require.Equal(t, keys, collect(nil, len(keys)+1))
+
+	// starting from an existing key
+	require.Equal(t, keys[100:150], collect(keys[100], 50))
+
+	// starting between two keys
+	start := types.BytesToHash(new(big.Int).Add(new(big.Int).SetBytes(keys[200]), big.NewInt(1)).Bytes()).Bytes()
+	require.Equal(t, keys[201:], collect(start, len(keys)))
+
+	// starting after the last key
+	require.Empty(t, collect(bytes.Repeat([]byte{0xff}, 32), len(keys)))
+
+	// empty trie
+	require.NoError(t, Iterate(types.EmptyRootHash, storage, nil, func(key, value []byte) bool {
+		t.Fatal("unexpected leaf")
+
+		return false
+	}))
+}
@@ -0,0 +1,166 @@
+package itrie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var (
+	errMissingTrieNode = errors.New("missing trie node")
+	errInvalidTrieNode = errors.New("invalid trie node")
+)
+
+// nodeResolver returns the RLP encoded node with the given hash
+type nodeResolver func(hash []byte) ([]byte, error)
+
+// Prove returns the merkle proof of the given key in the trie with the given root.
+// The proof is the list of RLP encoded nodes on the path from the root to the key.
+// Nodes shorter than 32 bytes are embedded in their parent, so they are not listed separately.
+// If the key is not in the trie, the returned proof is a proof of absence.
+func Prove(root types.Hash, key []byte, storage Storage) ([][]byte, error) {
+	proof := [][]byte{}
+
+	if root == types.EmptyRootHash || root == types.ZeroHash {
+		return proof, nil
+	}
+
+	resolve := func(hash []byte) ([]byte, error) {
+		data, ok, err := storage.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok || len(data) == 0 {
+			return nil, fmt.Errorf("%w %x", errMissingTrieNode, hash)
+		}
+
+		proof = append(proof, data)
+
+		return data, nil
+	}
+
+	if _, err := walkTrie(root.Bytes(), bytesToHexNibbles(key), resolve); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks the merkle proof of the given key against the trie root
+// and returns the value of the key, or nil if the proof shows the key is not in the trie
+func VerifyProof(root types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == types.EmptyRootHash {
+		if len(proof) != 0 {
+			return nil, errors.New("proof of an empty trie must be empty")
+		}
+
+		return nil, nil
+	}
+
+	nodes := make(map[types.Hash][]byte, len(proof))
+	for _, node := range proof {
+		nodes[types.BytesToHash(crypto.Keccak256(node))] = node
+	}
+
+	resolve := func(hash []byte) ([]byte, error) {
+		data, ok := nodes[types.BytesToHash(hash)]
+		if !ok {
+			return nil, fmt.Errorf("%w %x in proof", errMissingTrieNode, hash)
+		}
+
+		return data, nil
+	}
+
+	return walkTrie(root.Bytes(), bytesToHexNibbles(key), resolve)
+}
+
+// walkTrie walks the trie from the node with the given hash following the key nibbles
+// and returns the value stored at the key (nil if not found).
+// Every hashed node on the path is loaded through the resolver
+func walkTrie(hash []byte, key []byte, resolve nodeResolver) ([]byte, error) {
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	for {
+		data, err := resolve(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := p.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+
+		var value []byte
+
+		value, hash, key, err = walkNode(v, key)
+		if err != nil || hash == nil {
+			// copy the value, since the parser is reused
+			return append([]byte(nil), value...), err
+		}
+	}
+}
+
+// walkNode follows the key inside the given node (including its embedded nodes).
+// It returns either the value found at the end of the path or, if the path continues
+// in another hashed node, the hash of that node and the rest of the key
+func walkNode(v *fastrlp.Value, key []byte) ([]byte, []byte, []byte, error) {
+	for {
+		if v.Type() == fastrlp.TypeBytes {
+			raw := v.Raw()
+
+			switch len(raw) {
+			case 0:
+				// empty reference, the key is not in the trie
+				return nil, nil, nil, nil
+			case types.HashLength:
+				return nil, raw, key, nil
+			default:
+				return nil, nil, nil, fmt.Errorf("%w: invalid reference length %d", errInvalidTrieNode, len(raw))
+			}
+		}
+
+		switch v.Elems() {
+		case 2:
+			keyElem := v.Get(0)
+			if keyElem.Type() != fastrlp.TypeBytes {
+				return nil, nil, nil, fmt.Errorf("%w: short key expected to be bytes", errInvalidTrieNode)
+			}
+
+			nodeKey := decodeCompact(keyElem.Raw())
+			if len(nodeKey) > len(key) || prefixLen(key, nodeKey) != len(nodeKey) {
+				// the path diverges
+				return nil, nil, nil, nil
+			}
+
+			key = key[len(nodeKey):]
+
+			if hasTerminator(nodeKey) {
+				// leaf node
+				if v.Get(1).Type() != fastrlp.TypeBytes {
+					return nil, nil, nil, fmt.Errorf("%w: leaf value expected to be bytes", errInvalidTrieNode)
+				}
+
+				return v.Get(1).Raw(), nil, nil, nil
+			}
+
+			v = v.Get(1)
+
+		case 17:
+			if len(key) == 0 || key[0] == 16 {
+				return v.Get(16).Raw(), nil, nil, nil
+			}
+
+			v = v.Get(int(key[0]))
+			key = key[1:]
+
+		default:
+			return nil, nil, nil, fmt.Errorf("%w: node has incorrect number of leafs", errInvalidTrieNode)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProof_Trie(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	trie := NewTrie()
+	batch := storage.Batch()
+	txn := trie.Txn(storage)
+	txn.batch = batch
+
+	values := map[string][]byte{}
+
+	for i := 0; i < 500; i++ {
+		key := crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+		value := []byte{byte(i), byte(i >> 8)}
+
+		txn.Insert(key, value)
+		values[string(key)] = value
+	}
+
+	// short value, so the leaf nodes are embedded in the parent nodes
+	txn.Insert([]byte{0x1}, []byte{0x2})
+	values[string([]byte{0x1})] = []byte{0x2}
+
+	root, err := txn.Hash()
+	require.NoError(t, err)
+
+	txn.Commit()
+	require.NoError(t, batch.Write())
+
+	rootHash := types.BytesToHash(root)
+
+	for key, value := range values {
+		proof, err := Prove(rootHash, []byte(key), storage)
+		require.NoError(t, err)
+		require.NotEmpty(t, proof)
+
+		res, err := VerifyProof(rootHash, []byte(key), proof)
+		require.NoError(t, err)
+		require.Equal(t, value, res)
+	}
+
+	// proof of absence
+	missingKey := crypto.Keccak256([]byte("missing"))
+
+	proof, err := Prove(rootHash, missingKey, storage)
+	require.NoError(t, err)
+	require.NotEmpty(t, proof)
+
+	res, err := VerifyProof(rootHash, missingKey, proof)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// tampered proof
+	existingKey := crypto.Keccak256(big.NewInt(1).Bytes())
+
+	proof, err = Prove(rootHash, existingKey, storage)
+	require.NoError(t, err)
+
+	proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	proof[len(proof)-1][len(proof[len(proof)-1])-1] ^= 0xff
+
+	_, err = VerifyProof(rootHash, existingKey, proof)
+	require.ErrorIs(t, err, errMissingTrieNode)
+
+	// proof against the wrong root
+	_, err = VerifyProof(types.StringToHash("0x1"), existingKey, proof[:1])
+	require.ErrorIs(t, err, errMissingTrieNode)
+}
+
+func TestProof_EmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	key := crypto.Keccak256([]byte{0x1})
+
+	proof, err := Prove(types.EmptyRootHash, key, NewMemoryStorage())
+	require.NoError(t, err)
+	require.Empty(t, proof)
+
+	res, err := VerifyProof(types.EmptyRootHash, key, proof)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestProof_AccountAndStorage(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("0x1")
+	slot := types.StringToHash("0x2")
+	value := types.StringToHash("0x3")
+
+	snap := NewState(NewMemoryStorage()).NewSnapshot()
+
+	objs := []*state.Object{
+		{
+			Address:  addr,
+			Balance:  big.NewInt(100),
+			Nonce:    2,
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+			Storage: []*state.StorageObject{
+				{Key: slot.Bytes(), Val: value.Bytes()},
+			},
+		},
+		{
+			Address:  types.StringToAddress("0x4"),
+			Balance:  big.NewInt(1),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+	}
+
+	snap, root, err := snap.Commit(objs)
+	require.NoError(t, err)
+
+	stateRoot := types.BytesToHash(root)
+	storage := snap.(*Snapshot).state.storage
+
+	accountProof, err := Prove(stateRoot, crypto.Keccak256(addr.Bytes()), storage)
+	require.NoError(t, err)
+
+	data, err := VerifyProof(stateRoot, crypto.Keccak256(addr.Bytes()), accountProof)
+	require.NoError(t, err)
+
+	var account state.Account
+	require.NoError(t, account.UnmarshalRlp(data))
+	require.Equal(t, big.NewInt(100), account.Balance)
+	require.Equal(t, uint64(2), account.Nonce)
+
+	storageProof, err := Prove(account.Root, crypto.Keccak256(slot.Bytes()), storage)
+	require.NoError(t, err)
+
+	data, err = VerifyProof(account.Root, crypto.Keccak256(slot.Bytes()), storageProof)
+	require.NoError(t, err)
+
+	require.Equal(t, value, snap.GetStorage(addr, account.Root, slot))
+	require.NotEmpty(t, data)
+}
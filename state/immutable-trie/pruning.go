@@ -0,0 +1,412 @@
+package itrie
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/umbracle/fastrlp"
+)
+
+// pruneDeleteBatchSize is the number of keys removed from the storage at once while sweeping
+const pruneDeleteBatchSize = 10000
+
+// PrunableStorage is a trie storage whose entries can be iterated and removed
+type PrunableStorage interface {
+	Storage
+	// Iterate calls fn for every key/value pair in the storage until fn returns false
+	Iterate(fn func(k, v []byte) bool) error
+	// Delete removes the given keys from the storage
+	Delete(keys [][]byte) error
+	// Compact reclaims the space of the removed entries
+	Compact() error
+}
+
+// PruneResult holds the outcome of a single pruning run
+type PruneResult struct {
+	// RetainedRoots is the number of retained state roots
+	RetainedRoots uint64
+	// RetainedNodes is the number of trie nodes reachable from the retained roots
+	RetainedNodes uint64
+	// RetainedCode is the number of contract codes referenced from the retained roots
+	RetainedCode uint64
+	// RemovedNodes is the number of unreachable trie nodes removed
+	RemovedNodes uint64
+	// RemovedCode is the number of unreferenced contract codes removed
+	RemovedCode uint64
+	// FreedBytes is the total size of the removed keys and values
+	FreedBytes uint64
+	// Duration is the time the pruning took
+	Duration time.Duration
+}
+
+// PruningStorage is a storage which removes the trie nodes that are not reachable
+// from a set of retained state roots (mark and sweep).
+//
+// Pruning can run while blocks are being processed: the written keys are recorded
+// since the checkpoint before the last one (see Checkpoint) and never removed by a pruning run.
+// The checkpoints are suspended while the run is in progress, so the state of a block which is
+// being processed, but is not yet among the retained roots, is never removed.
+type PruningStorage struct {
+	PrunableStorage
+
+	// lock guards the written keys and makes writes and removals of the same key mutually exclusive
+	lock sync.Mutex
+	// written holds the keys written since the last checkpoint
+	written map[string]struct{}
+	// previous holds the keys written between the two last checkpoints
+	previous map[string]struct{}
+	// pruning is set while a pruning run is in progress
+	pruning bool
+	// pruneLock makes sure there is only one pruning run at a time
+	pruneLock sync.Mutex
+}
+
+// NewPruningStorage wraps the given storage into a storage which supports online pruning
+func NewPruningStorage(storage PrunableStorage) *PruningStorage {
+	return &PruningStorage{
+		PrunableStorage: storage,
+		written:         map[string]struct{}{},
+		previous:        map[string]struct{}{},
+	}
+}
+
+// Checkpoint forgets the keys written before the previous checkpoint. It is expected to be called
+// on every new block, so the keys of the last two blocks are kept. Ignored while pruning is running
+func (p *PruningStorage) Checkpoint() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.pruning {
+		return
+	}
+
+	p.previous, p.written = p.written, map[string]struct{}{}
+}
+
+func (p *PruningStorage) Put(k, v []byte) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.recordWrite(k)
+
+	return p.PrunableStorage.Put(k, v)
+}
+
+func (p *PruningStorage) SetCode(hash types.Hash, code []byte) error {
+	return p.Put(GetCodeKey(hash), code)
+}
+
+func (p *PruningStorage) Batch() Batch {
+	return &pruningBatch{storage: p, batch: p.PrunableStorage.Batch()}
+}
+
+// recordWrite records the written key. Lock must be held
+func (p *PruningStorage) recordWrite(k []byte) {
+	p.written[string(k)] = struct{}{}
+}
+
+// isWritten checks if the key was written recently. Lock must be held
+func (p *PruningStorage) isWritten(k []byte) bool {
+	if _, ok := p.written[string(k)]; ok {
+		return true
+	}
+
+	_, ok := p.previous[string(k)]
+
+	return ok
+}
+
+// Prune removes all trie nodes and contract codes which are not reachable from the state roots
+// returned by retainedRoots, or written recently. The roots are resolved once the checkpoints
+// are suspended, so the keys written before the roots are known are never removed
+func (p *PruningStorage) Prune(retainedRoots func() []types.Hash) (*PruneResult, error) {
+	p.pruneLock.Lock()
+	defer p.pruneLock.Unlock()
+
+	start := time.Now()
+
+	p.lock.Lock()
+	p.pruning = true
+	p.lock.Unlock()
+
+	defer func() {
+		p.lock.Lock()
+		p.pruning = false
+		p.lock.Unlock()
+	}()
+
+	roots := retainedRoots()
+	m := newTrieMarker(p.PrunableStorage)
+
+	for _, root := range roots {
+		if err := m.markTrie(root, false); err != nil {
+			return nil, fmt.Errorf("failed to mark state root %s: %w", root, err)
+		}
+	}
+
+	result := &PruneResult{
+		RetainedRoots: uint64(len(roots)),
+		RetainedNodes: uint64(len(m.nodes)),
+		RetainedCode:  uint64(len(m.code)),
+	}
+
+	if err := p.sweep(m, result); err != nil {
+		return nil, err
+	}
+
+	if err := p.Compact(); err != nil {
+		return nil, fmt.Errorf("failed to compact storage: %w", err)
+	}
+
+	result.Duration = time.Since(start)
+
+	return result, nil
+}
+
+// sweep removes all the nodes and codes which are not marked
+func (p *PruningStorage) sweep(m *trieMarker, result *PruneResult) error {
+	var (
+		keys      [][]byte
+		size      = map[string]uint64{}
+		deleteErr error
+	)
+
+	err := p.PrunableStorage.Iterate(func(k, v []byte) bool {
+		switch {
+		case len(k) == types.HashLength:
+			if _, ok := m.nodes[types.BytesToHash(k)]; ok {
+				return true
+			}
+		case len(k) == len(codePrefix)+types.HashLength && bytes.HasPrefix(k, codePrefix):
+			if _, ok := m.code[types.BytesToHash(k[len(codePrefix):])]; ok {
+				return true
+			}
+		default:
+			// not a trie entry
+			return true
+		}
+
+		key := append([]byte(nil), k...)
+		keys = append(keys, key)
+		size[string(key)] = uint64(len(k) + len(v))
+
+		if len(keys) < pruneDeleteBatchSize {
+			return true
+		}
+
+		deleteErr = p.deleteUnwritten(keys, size, result)
+		keys, size = nil, map[string]uint64{}
+
+		return deleteErr == nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to iterate storage: %w", err)
+	}
+
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	return p.deleteUnwritten(keys, size, result)
+}
+
+// deleteUnwritten removes the given keys, skipping the ones written recently
+func (p *PruningStorage) deleteUnwritten(keys [][]byte, size map[string]uint64, result *PruneResult) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	toDelete := make([][]byte, 0, len(keys))
+
+	for _, k := range keys {
+		if p.isWritten(k) {
+			continue
+		}
+
+		toDelete = append(toDelete, k)
+
+		if len(k) == types.HashLength {
+			result.RemovedNodes++
+		} else {
+			result.RemovedCode++
+		}
+
+		result.FreedBytes += size[string(k)]
+	}
+
+	if err := p.PrunableStorage.Delete(toDelete); err != nil {
+		return fmt.Errorf("failed to remove pruned entries: %w", err)
+	}
+
+	return nil
+}
+
+// pruningBatch is a batch which records the written keys in the pruning storage
+type pruningBatch struct {
+	storage *PruningStorage
+	batch   Batch
+	keys    [][]byte
+}
+
+func (b *pruningBatch) Put(k, v []byte) {
+	b.keys = append(b.keys, append([]byte(nil), k...))
+	b.batch.Put(k, v)
+}
+
+func (b *pruningBatch) Write() error {
+	b.storage.lock.Lock()
+	defer b.storage.lock.Unlock()
+
+	for _, k := range b.keys {
+		b.storage.recordWrite(k)
+	}
+
+	return b.batch.Write()
+}
+
+// trieMarker collects the hashes of all the nodes and codes reachable from a state root
+type trieMarker struct {
+	storage Storage
+	nodes   map[types.Hash]struct{}
+	code    map[types.Hash]struct{}
+}
+
+func newTrieMarker(storage Storage) *trieMarker {
+	return &trieMarker{
+		storage: storage,
+		nodes:   map[types.Hash]struct{}{},
+		code:    map[types.Hash]struct{}{},
+	}
+}
+
+// markTrie marks all nodes of the trie with the given root.
+// For the account trie, the storage tries and the codes of the accounts are marked as well
+func (m *trieMarker) markTrie(root types.Hash, isStorage bool) error {
+	if root == types.EmptyRootHash || root == types.ZeroHash {
+		return nil
+	}
+
+	return m.markHash(root.Bytes(), isStorage)
+}
+
+func (m *trieMarker) markHash(hash []byte, isStorage bool) error {
+	nodeHash := types.BytesToHash(hash)
+	if _, ok := m.nodes[nodeHash]; ok {
+		// the subtrie is already marked
+		return nil
+	}
+
+	data, ok, err := m.storage.Get(hash)
+	if err != nil {
+		return err
+	}
+
+	if !ok || len(data) == 0 {
+		return fmt.Errorf("%w %x", errMissingTrieNode, hash)
+	}
+
+	m.nodes[nodeHash] = struct{}{}
+
+	// the parsed value is used while marking the children, so each node gets its own parser
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	return m.markNode(v, isStorage)
+}
+
+func (m *trieMarker) markNode(v *fastrlp.Value, isStorage bool) error {
+	if v.Type() == fastrlp.TypeBytes {
+		switch len(v.Raw()) {
+		case 0:
+			return nil
+		case types.HashLength:
+			return m.markHash(v.Raw(), isStorage)
+		default:
+			return fmt.Errorf("%w: invalid reference length %d", errInvalidTrieNode, len(v.Raw()))
+		}
+	}
+
+	switch v.Elems() {
+	case 2:
+		key := v.Get(0)
+		if key.Type() != fastrlp.TypeBytes {
+			return fmt.Errorf("%w: short key expected to be bytes", errInvalidTrieNode)
+		}
+
+		if hasTerminator(decodeCompact(key.Raw())) {
+			return m.markValue(v.Get(1).Raw(), isStorage)
+		}
+
+		return m.markNode(v.Get(1), isStorage)
+
+	case 17:
+		for i := 0; i < 16; i++ {
+			if err := m.markNode(v.Get(i), isStorage); err != nil {
+				return err
+			}
+		}
+
+		if len(v.Get(16).Raw()) != 0 {
+			return m.markValue(v.Get(16).Raw(), isStorage)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: node has incorrect number of leafs", errInvalidTrieNode)
+	}
+}
+
+// markValue marks the storage trie and the code of an account
+func (m *trieMarker) markValue(value []byte, isStorage bool) error {
+	if isStorage {
+		return nil
+	}
+
+	var account state.Account
+	if err := account.UnmarshalRlp(value); err != nil {
+		return fmt.Errorf("can't parse account: %w", err)
+	}
+
+	if len(account.CodeHash) != 0 && !bytes.Equal(account.CodeHash, emptyCodeHash) {
+		m.code[types.BytesToHash(account.CodeHash)] = struct{}{}
+	}
+
+	return m.markTrie(account.Root, true)
+}
+
+func (kv *KVStorage) Iterate(fn func(k, v []byte) bool) error {
+	iter := kv.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !fn(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
+func (kv *KVStorage) Delete(keys [][]byte) error {
+	batch := &leveldb.Batch{}
+	for _, k := range keys {
+		batch.Delete(k)
+	}
+
+	return kv.db.Write(batch, nil)
+}
+
+func (kv *KVStorage) Compact() error {
+	return kv.db.CompactRange(util.Range{})
+}
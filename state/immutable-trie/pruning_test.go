@@ -0,0 +1,354 @@
+package itrie
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	ldbstorage "github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// commitBlocks commits a state change per block and returns the state root of every block
+func commitBlocks(t *testing.T, st *State, blocks int) []types.Hash {
+	t.Helper()
+
+	var (
+		snap  = st.NewSnapshot()
+		roots = make([]types.Hash, 0, blocks)
+		code  = []byte{0x60, 0x01}
+	)
+
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+	require.NoError(t, st.SetCode(codeHash, code))
+
+	for i := 0; i < blocks; i++ {
+		objs := make([]*state.Object, 0, 20)
+
+		for j := 0; j < 20; j++ {
+			obj := &state.Object{
+				Address:  types.BytesToAddress(big.NewInt(int64(j + 1)).Bytes()),
+				Balance:  big.NewInt(int64(i*100 + j)),
+				Nonce:    uint64(i),
+				CodeHash: types.EmptyCodeHash,
+				Root:     types.EmptyRootHash,
+			}
+
+			if j == 0 {
+				obj.CodeHash = codeHash
+			}
+
+			if i > 0 {
+				prev, err := snap.GetAccount(obj.Address)
+				require.NoError(t, err)
+
+				obj.Root = prev.Root
+			}
+
+			obj.Storage = []*state.StorageObject{
+				{
+					Key: types.BytesToHash(big.NewInt(int64(j)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(i + 1)).Bytes()).Bytes(),
+				},
+			}
+
+			objs = append(objs, obj)
+		}
+
+		nextSnap, root, err := snap.Commit(objs)
+		require.NoError(t, err)
+
+		snap = nextSnap
+		roots = append(roots, types.BytesToHash(root))
+	}
+
+	return roots
+}
+
+// retain returns the retained roots resolver of a pruning run
+func retain(roots []types.Hash) func() []types.Hash {
+	return func() []types.Hash {
+		return roots
+	}
+}
+
+func TestPruningStorage_Prune(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 10)
+
+	// the blocks are not written recently anymore
+	storage.Checkpoint()
+	storage.Checkpoint()
+
+	retained := roots[len(roots)-3:]
+
+	result, err := storage.Prune(retain(retained))
+	require.NoError(t, err)
+
+	require.NotZero(t, result.RetainedNodes)
+	require.Equal(t, uint64(1), result.RetainedCode)
+	require.NotZero(t, result.RemovedNodes)
+	require.Zero(t, result.RemovedCode)
+	require.NotZero(t, result.FreedBytes)
+
+	// the retained state is complete
+	for i, root := range retained {
+		hash, err := HashChecker(root.Bytes(), storage)
+		require.NoError(t, err)
+		require.Equal(t, root, hash)
+
+		snap, err := st.NewSnapshotAt(root)
+		require.NoError(t, err)
+
+		account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(1).Bytes()))
+		require.NoError(t, err)
+		require.NotNil(t, account)
+
+		block := len(roots) - len(retained) + i
+		require.Equal(t, uint64(block), account.Nonce)
+		require.Equal(t,
+			types.BytesToHash(big.NewInt(int64(block+1)).Bytes()),
+			snap.GetStorage(types.Address{}, account.Root, types.BytesToHash(big.NewInt(0).Bytes())))
+
+		_, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+		require.True(t, ok)
+	}
+
+	// the pruned state is gone
+	_, ok, err := storage.Get(roots[0].Bytes())
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// pruning again does not remove anything
+	result, err = storage.Prune(retain(retained))
+	require.NoError(t, err)
+	require.Zero(t, result.RemovedNodes)
+	require.Zero(t, result.FreedBytes)
+}
+
+func TestPruningStorage_UnreferencedCode(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 2)
+
+	unusedCodeHash := types.StringToHash("0x1")
+	require.NoError(t, storage.SetCode(unusedCodeHash, []byte{0x1}))
+
+	// the blocks are not written recently anymore
+	storage.Checkpoint()
+	storage.Checkpoint()
+
+	result, err := storage.Prune(retain(roots[1:]))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.RemovedCode)
+
+	_, ok := storage.GetCode(unusedCodeHash)
+	require.False(t, ok)
+}
+
+func TestPruningStorage_KeepsWrittenKeys(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 2)
+
+	// simulate a node written by block processing before the last two checkpoints
+	written := types.StringToHash("0x2")
+	require.NoError(t, storage.Put(written.Bytes(), []byte{0x1}))
+
+	m := newTrieMarker(storage.PrunableStorage)
+	require.NoError(t, m.markTrie(roots[1], false))
+
+	for i := 0; i < 2; i++ {
+		result := &PruneResult{}
+		require.NoError(t, storage.sweep(m, result))
+
+		_, ok, err := storage.Get(written.Bytes())
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		storage.Checkpoint()
+	}
+
+	// the key is removed once it is not written since the checkpoint before the last one
+	result := &PruneResult{}
+	require.NoError(t, storage.sweep(m, result))
+	require.NotZero(t, result.RemovedNodes)
+
+	_, ok, err := storage.Get(written.Bytes())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestPruningStorage_ConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 10)
+
+	// the blocks are not written recently anymore
+	storage.Checkpoint()
+	storage.Checkpoint()
+
+	head, err := st.NewSnapshotAt(roots[len(roots)-1])
+	require.NoError(t, err)
+
+	var (
+		wg          sync.WaitGroup
+		chainLock   sync.Mutex
+		commitErr   error
+		pruneResult *PruneResult
+		pruneErr    error
+	)
+
+	// the blocks are built on top of the head and imported while the pruning is running
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		snap := head
+
+		for i := 0; i < 50; i++ {
+			obj := &state.Object{
+				Address:  types.BytesToAddress(big.NewInt(int64(i%5 + 100)).Bytes()),
+				Balance:  big.NewInt(int64(i + 1)),
+				CodeHash: types.EmptyCodeHash,
+				Root:     types.EmptyRootHash,
+			}
+
+			nextSnap, root, err := snap.Commit([]*state.Object{obj})
+			if err != nil {
+				commitErr = err
+
+				return
+			}
+
+			snap = nextSnap
+
+			chainLock.Lock()
+			roots = append(roots, types.BytesToHash(root))
+			chainLock.Unlock()
+
+			storage.Checkpoint()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		pruneResult, pruneErr = storage.Prune(func() []types.Hash {
+			chainLock.Lock()
+			defer chainLock.Unlock()
+
+			return append([]types.Hash{}, roots[len(roots)-3:]...)
+		})
+	}()
+
+	wg.Wait()
+
+	require.NoError(t, commitErr)
+	require.NoError(t, pruneErr)
+	require.NotZero(t, pruneResult.RemovedNodes)
+
+	// the state of the latest blocks is complete
+	for _, root := range roots[len(roots)-3:] {
+		hash, err := HashChecker(root.Bytes(), storage)
+		require.NoError(t, err)
+		require.Equal(t, root, hash)
+	}
+}
+
+func TestPruningStorage_WritesBeforeRoots(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 5)
+
+	// the blocks are not written recently anymore
+	storage.Checkpoint()
+	storage.Checkpoint()
+
+	head, err := st.NewSnapshotAt(roots[len(roots)-1])
+	require.NoError(t, err)
+
+	// the block which is processed, but not yet imported when the retained roots are resolved
+	_, pending, err := head.Commit([]*state.Object{{
+		Address:  types.StringToAddress("0x100"),
+		Balance:  big.NewInt(1),
+		CodeHash: types.EmptyCodeHash,
+		Root:     types.EmptyRootHash,
+	}})
+	require.NoError(t, err)
+
+	result, err := storage.Prune(func() []types.Hash {
+		// the checkpoints are suspended while resolving the roots
+		storage.Checkpoint()
+		storage.Checkpoint()
+
+		return roots[len(roots)-1:]
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.RemovedNodes)
+
+	hash, err := HashChecker(pending, storage)
+	require.NoError(t, err)
+	require.Equal(t, types.BytesToHash(pending), hash)
+}
+
+func TestPruningStorage_MissingRoot(t *testing.T) {
+	t.Parallel()
+
+	storage := NewPruningStorage(NewMemoryStorage().(PrunableStorage))
+
+	_, err := storage.Prune(retain([]types.Hash{types.StringToHash("0x1")}))
+	require.ErrorIs(t, err, errMissingTrieNode)
+}
+
+func TestPruningStorage_LevelDB(t *testing.T) {
+	t.Parallel()
+
+	ldb, err := leveldb.Open(ldbstorage.NewMemStorage(), nil)
+	require.NoError(t, err)
+
+	defer ldb.Close()
+
+	storage := NewPruningStorage(NewKV(ldb))
+	st := NewState(storage)
+
+	roots := commitBlocks(t, st, 5)
+
+	// the blocks are not written recently anymore
+	storage.Checkpoint()
+	storage.Checkpoint()
+
+	result, err := storage.Prune(retain(roots[4:]))
+	require.NoError(t, err)
+	require.NotZero(t, result.RemovedNodes)
+	require.NotZero(t, result.FreedBytes)
+
+	hash, err := HashChecker(roots[4].Bytes(), storage)
+	require.NoError(t, err)
+	require.Equal(t, roots[4], hash)
+
+	_, ok, err := storage.Get(roots[0].Bytes())
+	require.NoError(t, err)
+	require.False(t, ok)
+}
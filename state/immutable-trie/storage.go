@@ -159,13 +159,51 @@ func (m *memStorage) GetCode(hash types.Hash) ([]byte, bool) {
 }
 
 func (m *memStorage) Batch() Batch {
-	return &memBatch{db: &m.db, l: new(sync.Mutex)}
+	return &memBatch{db: &m.db, l: m.l}
 }
 
 func (m *memStorage) Close() error {
 	return nil
 }
 
+func (m *memStorage) Iterate(fn func(k, v []byte) bool) error {
+	m.l.Lock()
+	entries := make(map[string][]byte, len(m.db))
+
+	for k, v := range m.db {
+		entries[k] = v
+	}
+	m.l.Unlock()
+
+	for k, v := range entries {
+		key, err := hex.DecodeHex(k)
+		if err != nil {
+			return err
+		}
+
+		if !fn(key, v) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (m *memStorage) Delete(keys [][]byte) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	for _, k := range keys {
+		delete(m.db, hex.EncodeToHex(k))
+	}
+
+	return nil
+}
+
+func (m *memStorage) Compact() error {
+	return nil
+}
+
 func (m *memBatch) Put(p, v []byte) {
 	m.l.Lock()
 	defer m.l.Unlock()
@@ -142,6 +142,24 @@ func (m *mockHostF) GetRefund() uint64 {
 	return m.refund
 }
 
+func (m *mockHostF) AddAddressToAccessList(addr types.Address) {}
+
+func (m *mockHostF) AddSlotToAccessList(addr types.Address, slot types.Hash) {}
+
+func (m *mockHostF) ContainsAccessListAddress(addr types.Address) bool {
+	return false
+}
+
+func (m *mockHostF) ContainsAccessListSlot(addr types.Address, slot types.Hash) (bool, bool) {
+	return false, false
+}
+
+func (m *mockHostF) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return types.Hash{}
+}
+
+func (m *mockHostF) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {}
+
 func FuzzTestEVM(f *testing.F) {
 	seed := []byte{
 		PUSH1, 0x01, PUSH1, 0x02, ADD,
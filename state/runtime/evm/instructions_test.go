@@ -15,7 +15,13 @@ import (
 var (
 	two = big.NewInt(2)
 
-	allEnabledForks = chain.AllForksEnabled.At(0)
+	allEnabledForks = func() chain.ForksInTime {
+		forks := chain.AllForksEnabled.At(0)
+		// the optional forks are not enabled by default
+		forks.Berlin, forks.Shanghai, forks.Cancun = true, true, true
+
+		return forks
+	}()
 )
 
 type cases2To1 []struct {
@@ -107,6 +113,52 @@ type mockHostForInstructions struct {
 	nonce       uint64
 	code        []byte
 	callxResult *runtime.ExecutionResult
+	accessList  map[types.Address]map[types.Hash]struct{}
+	transient   map[types.Address]map[types.Hash]types.Hash
+}
+
+func (m *mockHostForInstructions) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return m.transient[addr][key]
+}
+
+func (m *mockHostForInstructions) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {
+	if m.transient == nil {
+		m.transient = map[types.Address]map[types.Hash]types.Hash{}
+	}
+
+	if _, ok := m.transient[addr]; !ok {
+		m.transient[addr] = map[types.Hash]types.Hash{}
+	}
+
+	m.transient[addr][key] = value
+}
+
+func (m *mockHostForInstructions) AddAddressToAccessList(addr types.Address) {
+	if m.accessList == nil {
+		m.accessList = map[types.Address]map[types.Hash]struct{}{}
+	}
+
+	if _, ok := m.accessList[addr]; !ok {
+		m.accessList[addr] = map[types.Hash]struct{}{}
+	}
+}
+
+func (m *mockHostForInstructions) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	m.AddAddressToAccessList(addr)
+	m.accessList[addr][slot] = struct{}{}
+}
+
+func (m *mockHostForInstructions) ContainsAccessListAddress(addr types.Address) bool {
+	_, ok := m.accessList[addr]
+
+	return ok
+}
+
+func (m *mockHostForInstructions) ContainsAccessListSlot(addr types.Address, slot types.Hash) (bool, bool) {
+	slots, addrOk := m.accessList[addr]
+	_, slotOk := slots[slot]
+
+	return addrOk, slotOk
 }
 
 func (m *mockHostForInstructions) GetNonce(types.Address) uint64 {
@@ -697,12 +749,16 @@ func Test_opCall(t *testing.T) {
 				memory: []byte{0x01},
 				stop:   false,
 				err:    nil,
-				gas:    300,
+				gas:    900,
 			},
 			mockHost: &mockHostForInstructions{
 				callxResult: &runtime.ExecutionResult{
 					ReturnValue: []byte{0x03},
 				},
+				// warm address (EIP-2929)
+				accessList: map[types.Address]map[types.Hash]struct{}{
+					types.ZeroAddress: {},
+				},
 			},
 		},
 		{
@@ -797,3 +853,228 @@ func Test_opCall(t *testing.T) {
 		})
 	}
 }
+
+func Test_accessListGas(t *testing.T) {
+	t.Parallel()
+
+	s, closeFn := getState()
+	defer closeFn()
+
+	host := &mockHostForInstructions{}
+	s.host = host
+	s.msg = newMockContract(big.NewInt(0), 0, nil)
+
+	slot := types.StringToHash("1")
+
+	// the first access is cold and adds the address to the access list
+	assert.Equal(t, coldAccountAccessCost, s.accountAccessGas(addr1))
+	assert.Equal(t, warmStorageReadCost, s.accountAccessGas(addr1))
+	assert.True(t, host.ContainsAccessListAddress(addr1))
+
+	assert.Equal(t, coldSloadCost, s.slotAccessGas(slot))
+	assert.Equal(t, uint64(0), s.slotAccessGas(slot))
+
+	addrOk, slotOk := host.ContainsAccessListSlot(s.msg.Address, slot)
+	assert.True(t, addrOk)
+	assert.True(t, slotOk)
+}
+
+func TestPush0(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shanghai enabled", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.config = &chain.ForksInTime{Shanghai: true}
+
+		opPush0(s)
+
+		assert.Nil(t, s.err)
+		assert.Equal(t, 1, s.stackSize())
+		assert.Equal(t, zero, s.pop())
+	})
+
+	t.Run("shanghai disabled", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.config = &chain.ForksInTime{}
+
+		opPush0(s)
+
+		assert.ErrorIs(t, s.err, errOpCodeNotFound)
+		assert.Equal(t, 0, s.stackSize())
+	})
+}
+
+func TestMCopy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *chain.ForksInTime
+		memory []byte
+		dst    int64
+		src    int64
+		length int64
+		// expected values
+		result []byte
+		gas    uint64
+		err    error
+	}{
+		{
+			name:   "should copy forward",
+			config: &chain.ForksInTime{Cancun: true},
+			memory: []byte{1, 2, 3, 4},
+			dst:    32,
+			src:    0,
+			length: 4,
+			result: append(append([]byte{1, 2, 3, 4}, make([]byte, 28)...), append([]byte{1, 2, 3, 4}, make([]byte, 28)...)...),
+			// memory expansion to 2 words and the copy of 1 word
+			gas: 1000 - 6 - 3,
+		},
+		{
+			name:   "should handle overlapping areas",
+			config: &chain.ForksInTime{Cancun: true},
+			memory: append([]byte{1, 2, 3, 4}, make([]byte, 28)...),
+			dst:    1,
+			src:    0,
+			length: 4,
+			result: append([]byte{1, 1, 2, 3, 4}, make([]byte, 27)...),
+			gas:    1000 - 3,
+		},
+		{
+			name:   "should not expand memory for zero length",
+			config: &chain.ForksInTime{Cancun: true},
+			memory: []byte{},
+			dst:    1024,
+			src:    2048,
+			length: 0,
+			result: []byte{},
+			gas:    1000,
+		},
+		{
+			name:   "should throw errOpCodeNotFound when cancun is disabled",
+			config: &chain.ForksInTime{},
+			memory: []byte{},
+			result: []byte{},
+			gas:    1000,
+			err:    errOpCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, closeFn := getState()
+			defer closeFn()
+
+			s.config = tt.config
+			s.gas = 1000
+			s.memory = append(s.memory[:0], tt.memory...)
+
+			s.push(big.NewInt(tt.length))
+			s.push(big.NewInt(tt.src))
+			s.push(big.NewInt(tt.dst))
+
+			opMCopy(s)
+
+			assert.Equal(t, tt.err, s.err)
+			assert.Equal(t, tt.result, s.memory)
+			assert.Equal(t, tt.gas, s.gas)
+		})
+	}
+}
+
+func TestTransientStorage(t *testing.T) {
+	t.Parallel()
+
+	key := big.NewInt(1)
+	value := big.NewInt(10)
+
+	t.Run("store and load", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		host := &mockHostForInstructions{}
+		s.host = host
+		s.msg = newMockContract(big.NewInt(0), 0, nil)
+		s.config = &chain.ForksInTime{Cancun: true}
+
+		s.push(value)
+		s.push(key)
+
+		opTstore(s)
+
+		assert.Nil(t, s.err)
+		assert.Equal(t, 0, s.stackSize())
+		assert.Equal(t, bigToHash(value), host.GetTransientState(s.msg.Address, bigToHash(key)))
+
+		s.push(key)
+
+		opTload(s)
+
+		assert.Nil(t, s.err)
+		assert.Equal(t, value, s.pop())
+
+		// unknown slot
+		s.push(two)
+
+		opTload(s)
+
+		assert.Equal(t, uint64(0), s.pop().Uint64())
+	})
+
+	t.Run("should throw errWriteProtection in static call", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		host := &mockHostForInstructions{}
+		s.host = host
+		s.msg = newMockContract(big.NewInt(0), 0, nil)
+		s.msg.Static = true
+		s.config = &chain.ForksInTime{Cancun: true}
+
+		s.push(value)
+		s.push(key)
+
+		opTstore(s)
+
+		assert.ErrorIs(t, s.err, errWriteProtection)
+		assert.Empty(t, host.transient)
+	})
+
+	t.Run("should throw errOpCodeNotFound when cancun is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.host = &mockHostForInstructions{}
+		s.msg = newMockContract(big.NewInt(0), 0, nil)
+		s.config = &chain.ForksInTime{}
+
+		s.push(value)
+		s.push(key)
+
+		opTstore(s)
+		assert.ErrorIs(t, s.err, errOpCodeNotFound)
+
+		s.err = nil
+
+		opTload(s)
+		assert.ErrorIs(t, s.err, errOpCodeNotFound)
+	})
+}
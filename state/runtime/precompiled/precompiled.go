@@ -1,8 +1,10 @@
 package precompiled
 
 import (
+	"bytes"
 	"encoding/binary"
 	"log"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/contracts"
@@ -99,12 +101,34 @@ var (
 
 // CanRun implements the runtime interface
 func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.ForksInTime) bool {
-	if _, ok := p.contracts[c.CodeAddress]; !ok {
+	return p.isActive(c.CodeAddress, config)
+}
+
+// Addresses returns the addresses of the precompiled contracts active in the given forks
+func (p *Precompiled) Addresses(config *chain.ForksInTime) []types.Address {
+	addrs := make([]types.Address, 0, len(p.contracts))
+
+	for addr := range p.contracts {
+		if p.isActive(addr, config) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	return addrs
+}
+
+// isActive returns true if the precompiled contract exists and is enabled in the given forks
+func (p *Precompiled) isActive(addr types.Address, config *chain.ForksInTime) bool {
+	if _, ok := p.contracts[addr]; !ok {
 		return false
 	}
 
 	// byzantium precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case five:
 		fallthrough
 	case six:
@@ -116,7 +140,7 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 	}
 
 	// istanbul precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case nine:
 		return config.Istanbul
 	}
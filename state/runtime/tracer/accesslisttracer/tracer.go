@@ -0,0 +1,200 @@
+package accesslisttracer
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// accessList is the set of the accessed addresses and their storage slots
+type accessList map[types.Address]map[types.Hash]struct{}
+
+func (al accessList) addAddress(addr types.Address) {
+	if _, ok := al[addr]; !ok {
+		al[addr] = map[types.Hash]struct{}{}
+	}
+}
+
+func (al accessList) addSlot(addr types.Address, slot types.Hash) {
+	al.addAddress(addr)
+	al[addr][slot] = struct{}{}
+}
+
+// AccessListTracer collects the addresses and the storage slots accessed during the execution,
+// in order to build the access list (EIP-2930) of the transaction
+type AccessListTracer struct {
+	list     accessList
+	excluded map[types.Address]struct{}
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewAccessListTracer creates a tracer which starts from the given access list.
+// The excluded addresses (e.g. the sender, the recipient and the precompiles)
+// are warm anyway, so they are not added to the access list
+func NewAccessListTracer(initial types.TxAccessList, excluded ...types.Address) *AccessListTracer {
+	t := &AccessListTracer{
+		list:     accessList{},
+		excluded: make(map[types.Address]struct{}, len(excluded)),
+	}
+
+	for _, addr := range excluded {
+		t.excluded[addr] = struct{}{}
+	}
+
+	for _, tuple := range initial {
+		if _, ok := t.excluded[tuple.Address]; ok {
+			continue
+		}
+
+		t.list.addAddress(tuple.Address)
+
+		for _, key := range tuple.StorageKeys {
+			t.list.addSlot(tuple.Address, key)
+		}
+	}
+
+	return t
+}
+
+// AccessList returns the collected access list, sorted by the addresses and the storage slots
+func (t *AccessListTracer) AccessList() types.TxAccessList {
+	list := make(types.TxAccessList, 0, len(t.list))
+
+	for addr, slots := range t.list {
+		tuple := types.AccessTuple{
+			Address:     addr,
+			StorageKeys: make([]types.Hash, 0, len(slots)),
+		}
+
+		for slot := range slots {
+			tuple.StorageKeys = append(tuple.StorageKeys, slot)
+		}
+
+		sort.Slice(tuple.StorageKeys, func(i, j int) bool {
+			return bytes.Compare(tuple.StorageKeys[i].Bytes(), tuple.StorageKeys[j].Bytes()) < 0
+		})
+
+		list = append(list, tuple)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address.Bytes(), list[j].Address.Bytes()) < 0
+	})
+
+	return list
+}
+
+func (t *AccessListTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.stop = true
+}
+
+func (t *AccessListTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.stop
+}
+
+func (t *AccessListTracer) Clear() {
+	t.list = accessList{}
+}
+
+func (t *AccessListTracer) GetResult() (interface{}, error) {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.AccessList(), nil
+}
+
+func (t *AccessListTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *AccessListTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *AccessListTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+}
+
+func (t *AccessListTracer) CallEnd(
+	depth int,
+	output []byte,
+	err error,
+) {
+}
+
+func (t *AccessListTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	switch opCode {
+	case evm.SLOAD, evm.SSTORE:
+		if sp >= 1 {
+			t.list.addSlot(contractAddress, types.BytesToHash(stack[sp-1].Bytes()))
+		}
+
+	case evm.BALANCE, evm.EXTCODESIZE, evm.EXTCODECOPY, evm.EXTCODEHASH, evm.SELFDESTRUCT:
+		if sp >= 1 {
+			t.addAddress(types.BytesToAddress(stack[sp-1].Bytes()))
+		}
+
+	case evm.CALL, evm.CALLCODE, evm.DELEGATECALL, evm.STATICCALL:
+		if sp >= 2 {
+			t.addAddress(types.BytesToAddress(stack[sp-2].Bytes()))
+		}
+	}
+}
+
+func (t *AccessListTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+func (t *AccessListTracer) addAddress(addr types.Address) {
+	if _, ok := t.excluded[addr]; ok {
+		return
+	}
+
+	t.list.addAddress(addr)
+}
@@ -0,0 +1,77 @@
+package accesslisttracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+func TestAccessListTracer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from     = types.StringToAddress("0x100")
+		contract = types.StringToAddress("0x200")
+		other    = types.StringToAddress("0x300")
+		initial  = types.StringToAddress("0x400")
+		slot1    = types.StringToHash("0x1")
+		slot2    = types.StringToHash("0x2")
+	)
+
+	tracer := NewAccessListTracer(
+		types.TxAccessList{
+			{Address: initial, StorageKeys: []types.Hash{slot1}},
+			{Address: from},
+		},
+		from, contract,
+	)
+
+	capture := func(opCode int, stack ...*big.Int) {
+		tracer.CaptureState(nil, stack, opCode, contract, len(stack), nil, &mockState{})
+	}
+
+	capture(evm.SLOAD, new(big.Int).SetBytes(slot2.Bytes()))
+	capture(evm.SSTORE, big.NewInt(5), new(big.Int).SetBytes(slot1.Bytes()))
+	capture(evm.BALANCE, new(big.Int).SetBytes(from.Bytes()))
+	// gas, address
+	capture(evm.STATICCALL, new(big.Int).SetBytes(other.Bytes()), big.NewInt(1000))
+	// not an access list opcode
+	capture(evm.ADD, new(big.Int).SetBytes(types.StringToAddress("0x500").Bytes()))
+
+	expected := types.TxAccessList{
+		{Address: contract, StorageKeys: []types.Hash{slot1, slot2}},
+		{Address: other, StorageKeys: []types.Hash{}},
+		{Address: initial, StorageKeys: []types.Hash{slot1}},
+	}
+
+	assert.Equal(t, expected, tracer.AccessList())
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+
+	// cancelled tracer halts the execution
+	cancelErr := errors.New("cancelled")
+	tracer.Cancel(cancelErr)
+
+	state := &mockState{}
+	tracer.CaptureState(nil, nil, evm.SLOAD, contract, 0, nil, state)
+	assert.True(t, state.halted)
+
+	_, err = tracer.GetResult()
+	assert.ErrorIs(t, err, cancelErr)
+}
@@ -29,6 +29,7 @@ type Call struct {
 	GasUsed string  `json:"gasUsed"`
 	Input   string  `json:"input"`
 	Output  string  `json:"output"`
+	Error   string  `json:"error,omitempty"`
 	Calls   []*Call `json:"calls,omitempty"`
 
 	parent   *Call
@@ -36,6 +37,10 @@ type Call struct {
 }
 
 type CallTracer struct {
+	// WithErrors records the errors of the failed calls in their Call objects,
+	// instead of cancelling the tracing at the first failed call
+	WithErrors bool
+
 	call               *Call
 	activeCall         *Call
 	activeGas          uint64
@@ -134,11 +139,15 @@ func (c *CallTracer) CallEnd(depth int, output []byte, err error) {
 	c.activeCall.GasUsed = hex.EncodeUint64(gasUsed)
 	c.activeGas = 0
 
+	if err != nil && c.WithErrors {
+		c.activeCall.Error = err.Error()
+	}
+
 	if depth > 1 {
 		c.activeCall = c.activeCall.parent
 	}
 
-	if err != nil {
+	if err != nil && !c.WithErrors {
 		c.Cancel(err)
 	}
 }
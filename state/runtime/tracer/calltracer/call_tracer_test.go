@@ -286,3 +286,33 @@ func TestCallTracer_CallEnd(t *testing.T) {
 		require.Equal(t, uint64(500), tracer.activeCall.startGas)
 	})
 }
+
+func TestCallTracer_WithErrors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = types.StringToAddress("0xFrom")
+		to   = types.StringToAddress("0xTo")
+		err  = errors.New("execution reverted")
+	)
+
+	tracer := &CallTracer{WithErrors: true}
+
+	tracer.CallStart(1, from, to, 0, 1000, nil, nil)
+	tracer.CallStart(2, to, from, 3, 500, nil, nil)
+	tracer.CallEnd(2, nil, err)
+	tracer.CallEnd(1, nil, nil)
+
+	// the failed call doesn't cancel the tracing
+	require.False(t, tracer.cancelled())
+
+	result, resultErr := tracer.GetResult()
+	require.NoError(t, resultErr)
+
+	call, ok := result.(*Call)
+	require.True(t, ok)
+	require.Empty(t, call.Error)
+	require.Len(t, call.Calls, 1)
+	require.Equal(t, "STATICCALL", call.Calls[0].Type)
+	require.Equal(t, err.Error(), call.Calls[0].Error)
+}
@@ -0,0 +1,129 @@
+package fourbytetracer
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const selectorLength = 4
+
+// FourByteTracer counts the function selectors of the calls made by the transaction,
+// together with the size of their call data. The result is keyed by "selector-size",
+// e.g. "0x27dc297e-128", where the size doesn't include the selector
+type FourByteTracer struct {
+	ids      map[string]int
+	excluded map[types.Address]struct{}
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewFourByteTracer creates a tracer which ignores the calls to the excluded addresses (e.g. the precompiles)
+func NewFourByteTracer(excluded ...types.Address) *FourByteTracer {
+	t := &FourByteTracer{
+		ids:      map[string]int{},
+		excluded: make(map[types.Address]struct{}, len(excluded)),
+	}
+
+	for _, addr := range excluded {
+		t.excluded[addr] = struct{}{}
+	}
+
+	return t
+}
+
+func (t *FourByteTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.stop = true
+}
+
+func (t *FourByteTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.stop
+}
+
+func (t *FourByteTracer) Clear() {
+	t.ids = map[string]int{}
+}
+
+func (t *FourByteTracer) GetResult() (interface{}, error) {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.ids, nil
+}
+
+func (t *FourByteTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *FourByteTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *FourByteTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	// the input of the creations is the init code
+	if callType == int(runtime.Create) || len(input) < selectorLength {
+		return
+	}
+
+	if _, ok := t.excluded[to]; ok {
+		return
+	}
+
+	t.ids[fmt.Sprintf("0x%x-%d", input[:selectorLength], len(input)-selectorLength)]++
+}
+
+func (t *FourByteTracer) CallEnd(
+	depth int,
+	output []byte,
+	err error,
+) {
+}
+
+func (t *FourByteTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+	}
+}
+
+func (t *FourByteTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
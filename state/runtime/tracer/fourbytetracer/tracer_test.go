@@ -0,0 +1,70 @@
+package fourbytetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+func TestFourByteTracer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from       = types.StringToAddress("0x100")
+		contract   = types.StringToAddress("0x200")
+		precompile = types.StringToAddress("0x2")
+	)
+
+	tracer := NewFourByteTracer(precompile)
+
+	call := func(depth int, to types.Address, callType int, input []byte) {
+		tracer.CallStart(depth, from, to, callType, 1000, big.NewInt(0), input)
+	}
+
+	call(1, contract, 0, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x1, 0x2})
+	call(2, contract, 2, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x3, 0x4})
+	call(2, contract, 3, []byte{0x70, 0xa0, 0x82, 0x31})
+	// too short input, precompile and creation are not counted
+	call(2, contract, 0, []byte{0x1, 0x2})
+	call(2, precompile, 3, []byte{0x1, 0x2, 0x3, 0x4})
+	call(2, contract, int(runtime.Create), []byte{0x60, 0x80, 0x60, 0x40})
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		"0xa9059cbb-2": 2,
+		"0x70a08231-0": 1,
+	}, result)
+
+	tracer.Clear()
+
+	result, err = tracer.GetResult()
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	// cancelled tracer halts the execution
+	cancelErr := errors.New("cancelled")
+	tracer.Cancel(cancelErr)
+
+	state := &mockState{}
+	tracer.CaptureState(nil, nil, evm.ADD, contract, 0, nil, state)
+	assert.True(t, state.halted)
+
+	_, err = tracer.GetResult()
+	assert.ErrorIs(t, err, cancelErr)
+}
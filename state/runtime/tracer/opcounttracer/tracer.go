@@ -0,0 +1,106 @@
+package opcounttracer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// OpCountTracer counts the executed instructions of the transaction by opcode name
+type OpCountTracer struct {
+	counts map[string]uint64
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewOpCountTracer creates an opcode counting tracer
+func NewOpCountTracer() *OpCountTracer {
+	return &OpCountTracer{
+		counts: map[string]uint64{},
+	}
+}
+
+func (t *OpCountTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.stop = true
+}
+
+func (t *OpCountTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.stop
+}
+
+func (t *OpCountTracer) Clear() {
+	t.counts = map[string]uint64{}
+}
+
+func (t *OpCountTracer) GetResult() (interface{}, error) {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.counts, nil
+}
+
+func (t *OpCountTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *OpCountTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *OpCountTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+}
+
+func (t *OpCountTracer) CallEnd(
+	depth int,
+	output []byte,
+	err error,
+) {
+}
+
+func (t *OpCountTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+	}
+}
+
+func (t *OpCountTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+	t.counts[opcode]++
+}
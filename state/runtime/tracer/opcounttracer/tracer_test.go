@@ -0,0 +1,57 @@
+package opcounttracer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+func TestOpCountTracer(t *testing.T) {
+	t.Parallel()
+
+	contract := types.StringToAddress("0x200")
+	tracer := NewOpCountTracer()
+
+	for _, opcode := range []string{"PUSH1", "PUSH1", "MSTORE", "PUSH1", "CALL", "STOP"} {
+		tracer.ExecuteState(contract, 0, opcode, 1000, 3, nil, 1, nil, nil)
+	}
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{
+		"PUSH1":  3,
+		"MSTORE": 1,
+		"CALL":   1,
+		"STOP":   1,
+	}, result)
+
+	tracer.Clear()
+
+	result, err = tracer.GetResult()
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	// cancelled tracer halts the execution
+	cancelErr := errors.New("cancelled")
+	tracer.Cancel(cancelErr)
+
+	state := &mockState{}
+	tracer.CaptureState(nil, nil, evm.ADD, contract, 0, nil, state)
+	assert.True(t, state.halted)
+
+	_, err = tracer.GetResult()
+	assert.ErrorIs(t, err, cancelErr)
+}
@@ -0,0 +1,381 @@
+package prestatetracer
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxInitCodePadding limits the zero padding of the init code read from the memory,
+// the larger sizes cannot be paid for by the instruction anyway
+const maxInitCodePadding = 1 << 20
+
+// Config is the configuration of the prestate tracer
+type Config struct {
+	// DiffMode makes the tracer return the state before and after the transaction,
+	// limited to the modified accounts and storage slots
+	DiffMode bool `json:"diffMode"`
+}
+
+// Account is the traced state of an account
+type Account struct {
+	Balance string                    `json:"balance,omitempty"`
+	Nonce   uint64                    `json:"nonce,omitempty"`
+	Code    string                    `json:"code,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+// DiffResult is the result of the tracer in the diff mode
+type DiffResult struct {
+	Post map[types.Address]*Account `json:"post"`
+	Pre  map[types.Address]*Account `json:"pre"`
+}
+
+// account is the state of an account read before it is modified by the transaction
+type account struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+	exists  bool
+}
+
+func (a *account) toResult() *Account {
+	res := &Account{
+		Balance: hex.EncodeBig(a.balance),
+		Nonce:   a.nonce,
+	}
+
+	if len(a.code) > 0 {
+		res.Code = hex.EncodeToHex(a.code)
+	}
+
+	if len(a.storage) > 0 {
+		res.Storage = a.storage
+	}
+
+	return res
+}
+
+// PrestateTracer collects the state of the accounts touched by the transaction before its execution,
+// and in the diff mode the changes made to them by the transaction
+type PrestateTracer struct {
+	config Config
+	host   tracer.StateHost
+
+	pre  map[types.Address]*account
+	post map[types.Address]*Account
+	// created are the accounts created by the transaction
+	created map[types.Address]struct{}
+	// deleted are the accounts self destructed by the transaction
+	deleted map[types.Address]struct{}
+	// createdTo is the account created by the transaction itself, if any
+	createdTo *types.Address
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewPrestateTracer creates a prestate tracer with the given configuration
+func NewPrestateTracer(config Config) *PrestateTracer {
+	t := &PrestateTracer{config: config}
+	t.Clear()
+
+	return t
+}
+
+func (t *PrestateTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.stop = true
+}
+
+func (t *PrestateTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.stop
+}
+
+func (t *PrestateTracer) Clear() {
+	t.host = nil
+	t.pre = map[types.Address]*account{}
+	t.post = map[types.Address]*Account{}
+	t.created = map[types.Address]struct{}{}
+	t.deleted = map[types.Address]struct{}{}
+	t.createdTo = nil
+}
+
+func (t *PrestateTracer) GetResult() (interface{}, error) {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	pre := make(map[types.Address]*Account, len(t.pre))
+	for addr, acc := range t.pre {
+		pre[addr] = acc.toResult()
+	}
+
+	if !t.config.DiffMode {
+		return pre, nil
+	}
+
+	return &DiffResult{
+		Post: t.post,
+		Pre:  pre,
+	}, nil
+}
+
+func (t *PrestateTracer) TxPrepare(host tracer.StateHost, accounts []types.Address) {
+	t.host = host
+
+	for _, addr := range accounts {
+		t.lookupAccount(addr)
+	}
+}
+
+func (t *PrestateTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *PrestateTracer) TxEnd(gasLeft uint64) {
+	if t.host == nil {
+		return
+	}
+
+	if !t.config.DiffMode {
+		// keep the account which existed at the address before the contract creation
+		if t.createdTo != nil {
+			if acc, ok := t.pre[*t.createdTo]; ok && !acc.exists {
+				delete(t.pre, *t.createdTo)
+			}
+		}
+
+		return
+	}
+
+	t.processDiffState()
+
+	// the state of the created accounts was empty before the transaction
+	for addr := range t.created {
+		if acc, ok := t.pre[addr]; ok && !acc.exists {
+			delete(t.pre, addr)
+		}
+	}
+}
+
+func (t *PrestateTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	// the creations are reported after the account is created,
+	// so its state is read before by TxPrepare or by the CREATE and CREATE2 instructions
+	if callType != int(runtime.Create) {
+		return
+	}
+
+	t.created[to] = struct{}{}
+
+	if depth == 1 {
+		t.createdTo = &to
+	}
+}
+
+func (t *PrestateTracer) CallEnd(
+	depth int,
+	output []byte,
+	err error,
+) {
+}
+
+func (t *PrestateTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	switch opCode {
+	case evm.SLOAD, evm.SSTORE:
+		if sp >= 1 {
+			t.lookupStorage(contractAddress, types.BytesToHash(stack[sp-1].Bytes()))
+		}
+
+	case evm.BALANCE, evm.EXTCODESIZE, evm.EXTCODECOPY, evm.EXTCODEHASH:
+		if sp >= 1 {
+			t.lookupAccount(types.BytesToAddress(stack[sp-1].Bytes()))
+		}
+
+	case evm.SELFDESTRUCT:
+		if sp >= 1 {
+			t.lookupAccount(types.BytesToAddress(stack[sp-1].Bytes()))
+			t.deleted[contractAddress] = struct{}{}
+		}
+
+	case evm.CALL, evm.CALLCODE, evm.DELEGATECALL, evm.STATICCALL:
+		if sp >= 2 {
+			t.lookupAccount(types.BytesToAddress(stack[sp-2].Bytes()))
+		}
+
+	case evm.CREATE:
+		if t.host != nil {
+			t.lookupAccount(crypto.CreateAddress(contractAddress, t.host.GetNonce(contractAddress)))
+		}
+
+	case evm.CREATE2:
+		if sp >= 4 {
+			offset, size := stack[sp-2], stack[sp-3]
+			salt := types.BytesToHash(stack[sp-4].Bytes())
+
+			t.lookupAccount(crypto.CreateAddress2(contractAddress, salt, memorySlice(memory, offset, size)))
+		}
+	}
+}
+
+func (t *PrestateTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// lookupAccount reads the state of the account, if it is not read yet
+func (t *PrestateTracer) lookupAccount(addr types.Address) {
+	if t.host == nil {
+		return
+	}
+
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+
+	t.pre[addr] = &account{
+		balance: new(big.Int).Set(t.host.GetBalance(addr)),
+		nonce:   t.host.GetNonce(addr),
+		code:    t.host.GetCode(addr),
+		storage: map[types.Hash]types.Hash{},
+		exists:  t.host.AccountExists(addr),
+	}
+}
+
+// lookupStorage reads the storage slot of the account, if it is not read yet
+func (t *PrestateTracer) lookupStorage(addr types.Address, key types.Hash) {
+	if t.host == nil {
+		return
+	}
+
+	t.lookupAccount(addr)
+
+	if _, ok := t.pre[addr].storage[key]; ok {
+		return
+	}
+
+	t.pre[addr].storage[key] = t.host.GetStorage(addr, key)
+}
+
+// memorySlice returns the copy of the memory range, padded with zeros if the memory is not expanded yet
+func memorySlice(memory []byte, offset, size *big.Int) []byte {
+	if !offset.IsUint64() || !size.IsUint64() || size.Uint64() > uint64(len(memory))+maxInitCodePadding {
+		return nil
+	}
+
+	res := make([]byte, size.Uint64())
+
+	if start := offset.Uint64(); start < uint64(len(memory)) {
+		copy(res, memory[start:])
+	}
+
+	return res
+}
+
+// processDiffState collects the changes of the touched accounts into the post state,
+// and removes the unchanged accounts and storage slots from the pre state
+func (t *PrestateTracer) processDiffState() {
+	for addr, acc := range t.pre {
+		// the state of the deleted account is kept in the pre state only
+		if _, ok := t.deleted[addr]; ok {
+			continue
+		}
+
+		modified := false
+		post := &Account{Storage: map[types.Hash]types.Hash{}}
+
+		if balance := t.host.GetBalance(addr); balance.Cmp(acc.balance) != 0 {
+			modified = true
+			post.Balance = hex.EncodeBig(balance)
+		}
+
+		if nonce := t.host.GetNonce(addr); nonce != acc.nonce {
+			modified = true
+			post.Nonce = nonce
+		}
+
+		if code := t.host.GetCode(addr); !bytes.Equal(code, acc.code) {
+			modified = true
+			post.Code = hex.EncodeToHex(code)
+		}
+
+		for key, val := range acc.storage {
+			newVal := t.host.GetStorage(addr, key)
+
+			if val == newVal {
+				delete(acc.storage, key)
+
+				continue
+			}
+
+			modified = true
+
+			if val == types.ZeroHash {
+				// the empty slots are not included in the pre state
+				delete(acc.storage, key)
+			}
+
+			if newVal != types.ZeroHash {
+				post.Storage[key] = newVal
+			}
+		}
+
+		if !modified {
+			delete(t.pre, addr)
+
+			continue
+		}
+
+		if len(post.Storage) == 0 {
+			post.Storage = nil
+		}
+
+		t.post[addr] = post
+	}
+}
@@ -0,0 +1,197 @@
+package prestatetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+type mockAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+type mockHost struct {
+	accounts map[types.Address]*mockAccount
+}
+
+func (m *mockHost) account(addr types.Address) *mockAccount {
+	acc, ok := m.accounts[addr]
+	if !ok {
+		acc = &mockAccount{balance: big.NewInt(0), storage: map[types.Hash]types.Hash{}}
+		m.accounts[addr] = acc
+	}
+
+	return acc
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.storage[key]
+	}
+
+	return types.ZeroHash
+}
+
+func (m *mockHost) AccountExists(addr types.Address) bool {
+	_, ok := m.accounts[addr]
+
+	return ok
+}
+
+func (m *mockHost) GetBalance(addr types.Address) *big.Int {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.balance
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(addr types.Address) uint64 {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.nonce
+	}
+
+	return 0
+}
+
+func (m *mockHost) GetCode(addr types.Address) []byte {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.code
+	}
+
+	return nil
+}
+
+var (
+	sender   = types.StringToAddress("0x100")
+	contract = types.StringToAddress("0x200")
+	other    = types.StringToAddress("0x300")
+	// the contract has no nonce, so it creates the account at the address of nonce 0
+	created = crypto.CreateAddress(contract, 0)
+	slot1   = types.StringToHash("0x1")
+	slot2   = types.StringToHash("0x2")
+	value1  = types.StringToHash("0x11")
+	value2  = types.StringToHash("0x22")
+)
+
+// traceTx traces a call from the sender to the contract, which reads slot1, writes slot2,
+// checks the balance of the other account and creates a new contract (at the created address)
+func traceTx(t *testing.T, config Config) interface{} {
+	t.Helper()
+
+	host := &mockHost{accounts: map[types.Address]*mockAccount{
+		sender:   {balance: big.NewInt(1000), nonce: 1, storage: map[types.Hash]types.Hash{}},
+		contract: {balance: big.NewInt(0), code: []byte{0x1}, storage: map[types.Hash]types.Hash{slot1: value1}},
+		other:    {balance: big.NewInt(5), storage: map[types.Hash]types.Hash{}},
+	}}
+
+	tracer := NewPrestateTracer(config)
+
+	capture := func(opCode int, stack ...*big.Int) {
+		tracer.CaptureState(nil, stack, opCode, contract, len(stack), host, &mockState{})
+	}
+
+	tracer.TxPrepare(host, []types.Address{sender, contract})
+	tracer.TxStart(100)
+
+	host.account(sender).balance = big.NewInt(900)
+	host.account(sender).nonce = 2
+
+	tracer.CallStart(1, sender, contract, 0, 100, big.NewInt(0), nil)
+
+	capture(evm.SLOAD, new(big.Int).SetBytes(slot1.Bytes()))
+	capture(evm.SSTORE, new(big.Int).SetBytes(value2.Bytes()), new(big.Int).SetBytes(slot2.Bytes()))
+	host.account(contract).storage[slot2] = value2
+
+	capture(evm.BALANCE, new(big.Int).SetBytes(other.Bytes()))
+
+	capture(evm.CREATE)
+	host.account(contract).nonce = 1
+	host.account(created).nonce = 1
+
+	tracer.CallStart(2, contract, created, int(runtime.Create), 50, big.NewInt(0), []byte{0x2})
+	host.account(created).code = []byte{0x3}
+	tracer.CallEnd(2, nil, nil)
+
+	tracer.CallEnd(1, nil, nil)
+	tracer.TxEnd(50)
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestPrestateTracer(t *testing.T) {
+	t.Parallel()
+
+	result := traceTx(t, Config{})
+
+	assert.Equal(t, map[types.Address]*Account{
+		sender: {Balance: "0x3e8", Nonce: 1},
+		contract: {
+			Balance: "0x0",
+			Code:    "0x01",
+			Storage: map[types.Hash]types.Hash{slot1: value1, slot2: types.ZeroHash},
+		},
+		other:   {Balance: "0x5"},
+		created: {Balance: "0x0"},
+	}, result)
+}
+
+func TestPrestateTracer_DiffMode(t *testing.T) {
+	t.Parallel()
+
+	result := traceTx(t, Config{DiffMode: true})
+
+	assert.Equal(t, &DiffResult{
+		Pre: map[types.Address]*Account{
+			sender:   {Balance: "0x3e8", Nonce: 1},
+			contract: {Balance: "0x0", Code: "0x01"},
+		},
+		Post: map[types.Address]*Account{
+			sender:   {Balance: "0x384", Nonce: 2},
+			contract: {Nonce: 1, Storage: map[types.Hash]types.Hash{slot2: value2}},
+			created:  {Nonce: 1, Code: "0x03"},
+		},
+	}, result)
+}
+
+func TestPrestateTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewPrestateTracer(Config{})
+
+	cancelErr := errors.New("cancelled")
+	tracer.Cancel(cancelErr)
+
+	state := &mockState{}
+	tracer.CaptureState(nil, nil, evm.SLOAD, contract, 0, nil, state)
+	assert.True(t, state.halted)
+
+	_, err := tracer.GetResult()
+	assert.ErrorIs(t, err, cancelErr)
+}
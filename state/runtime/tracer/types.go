@@ -14,6 +14,27 @@ type RuntimeHost interface {
 	GetStorage(types.Address, types.Hash) types.Hash
 }
 
+// StateHost is the interface defining the methods for reading the state of the accounts by tracer
+type StateHost interface {
+	RuntimeHost
+	// AccountExists returns true if the account exists in the state
+	AccountExists(types.Address) bool
+	// GetBalance returns the balance of the account
+	GetBalance(types.Address) *big.Int
+	// GetNonce returns the nonce of the account
+	GetNonce(types.Address) uint64
+	// GetCode returns the code of the account
+	GetCode(types.Address) []byte
+}
+
+// StateTracer is implemented by the tracers which read the state of the accounts touched by the transaction
+type StateTracer interface {
+	Tracer
+	// TxPrepare is called before the transaction modifies the state, with the accounts
+	// touched by the transaction outside of the EVM execution (the sender, the recipient and the fee receivers)
+	TxPrepare(host StateHost, accounts []types.Address)
+}
+
 type VMState interface {
 	// Halt tells VM to terminate its process
 	Halt()
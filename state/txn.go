@@ -28,6 +28,12 @@ var (
 
 	// refundIndex is the index of the refund
 	refundIndex = types.BytesToHash([]byte{3}).Bytes()
+
+	// accessListIndex is the prefix of the access list (EIP-2929) entries in the trie
+	accessListIndex = types.BytesToHash([]byte{4}).Bytes()
+
+	// transientStorageIndex is the prefix of the transient storage (EIP-1153) entries in the trie
+	transientStorageIndex = types.BytesToHash([]byte{5}).Bytes()
 )
 
 // Txn is a reference of the state
@@ -272,13 +278,17 @@ func (txn *Txn) SetStorage(
 	if original == value {
 		if original == types.ZeroHash { // reset to original nonexistent slot (2.2.2.1)
 			// Storage was used as memory (allocation and deallocation occurred within the same contract)
-			if config.Istanbul {
+			if config.Berlin {
+				txn.AddRefund(19900)
+			} else if config.Istanbul {
 				txn.AddRefund(19200)
 			} else {
 				txn.AddRefund(19800)
 			}
 		} else { // reset to original existing slot (2.2.2.2)
-			if config.Istanbul {
+			if config.Berlin {
+				txn.AddRefund(2800)
+			} else if config.Istanbul {
 				txn.AddRefund(4200)
 			} else {
 				txn.AddRefund(4800)
@@ -458,6 +468,86 @@ func (txn *Txn) SubRefund(gas uint64) {
 	txn.txn.Insert(refundIndex, refund)
 }
 
+// Access list (EIP-2929)
+
+func accessListAddressKey(addr types.Address) []byte {
+	key := make([]byte, 0, len(accessListIndex)+types.AddressLength)
+	key = append(key, accessListIndex...)
+
+	return append(key, addr.Bytes()...)
+}
+
+func accessListSlotKey(addr types.Address, slot types.Hash) []byte {
+	return append(accessListAddressKey(addr), slot.Bytes()...)
+}
+
+// AddAddressToAccessList adds the address to the access list of the transaction
+func (txn *Txn) AddAddressToAccessList(addr types.Address) {
+	txn.txn.Insert(accessListAddressKey(addr), true)
+}
+
+// AddSlotToAccessList adds the address and its storage slot to the access list of the transaction
+func (txn *Txn) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	txn.AddAddressToAccessList(addr)
+	txn.txn.Insert(accessListSlotKey(addr, slot), true)
+}
+
+// ContainsAccessListAddress returns true if the address is in the access list
+func (txn *Txn) ContainsAccessListAddress(addr types.Address) bool {
+	_, exists := txn.txn.Get(accessListAddressKey(addr))
+
+	return exists
+}
+
+// ContainsAccessListSlot returns whether the address and the storage slot are in the access list
+func (txn *Txn) ContainsAccessListSlot(addr types.Address, slot types.Hash) (bool, bool) {
+	_, slotExists := txn.txn.Get(accessListSlotKey(addr, slot))
+
+	return txn.ContainsAccessListAddress(addr), slotExists
+}
+
+// ClearAccessList removes all the entries of the access list
+func (txn *Txn) ClearAccessList() {
+	txn.txn.DeletePrefix(accessListIndex)
+}
+
+// Transient storage (EIP-1153)
+
+func transientStorageKey(addr types.Address, key types.Hash) []byte {
+	k := make([]byte, 0, len(transientStorageIndex)+types.AddressLength+types.HashLength)
+	k = append(k, transientStorageIndex...)
+	k = append(k, addr.Bytes()...)
+
+	return append(k, key.Bytes()...)
+}
+
+// GetTransientState returns the value of the transient storage slot
+func (txn *Txn) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	val, exists := txn.txn.Get(transientStorageKey(addr, key))
+	if !exists {
+		return types.ZeroHash
+	}
+
+	//nolint:forcetypeassert
+	return val.(types.Hash)
+}
+
+// SetTransientState sets the value of the transient storage slot
+func (txn *Txn) SetTransientState(addr types.Address, key, value types.Hash) {
+	if value == types.ZeroHash {
+		txn.txn.Delete(transientStorageKey(addr, key))
+
+		return
+	}
+
+	txn.txn.Insert(transientStorageKey(addr, key), value)
+}
+
+// ClearTransientStorage removes all the transient storage slots
+func (txn *Txn) ClearTransientStorage() {
+	txn.txn.DeletePrefix(transientStorageIndex)
+}
+
 func (txn *Txn) Logs() []*types.Log {
 	data, exists := txn.txn.Get(logIndex)
 	if !exists {
@@ -584,6 +674,10 @@ func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) error {
 	// delete refunds
 	txn.txn.Delete(refundIndex)
 
+	// delete the access list and the transient storage of the transaction
+	txn.ClearAccessList()
+	txn.ClearTransientStorage()
+
 	return nil
 }
 
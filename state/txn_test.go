@@ -92,3 +92,70 @@ func TestIncrNonce(t *testing.T) {
 	require.NoError(t, txn.IncrNonce(address1))
 	require.Equal(t, nonMaxUint64NonceValue+1, txn.GetNonce(address1))
 }
+
+func TestAccessList(t *testing.T) {
+	t.Parallel()
+
+	txn := newTestTxn(defaultPreState)
+
+	txn.AddAddressToAccessList(addr1)
+	assert.True(t, txn.ContainsAccessListAddress(addr1))
+
+	addrOk, slotOk := txn.ContainsAccessListSlot(addr2, hash1)
+	assert.False(t, addrOk)
+	assert.False(t, slotOk)
+
+	// the access list entries are reverted with the snapshot
+	ss := txn.Snapshot()
+
+	txn.AddSlotToAccessList(addr2, hash1)
+
+	addrOk, slotOk = txn.ContainsAccessListSlot(addr2, hash1)
+	assert.True(t, addrOk)
+	assert.True(t, slotOk)
+
+	require.NoError(t, txn.RevertToSnapshot(ss))
+
+	addrOk, slotOk = txn.ContainsAccessListSlot(addr2, hash1)
+	assert.False(t, addrOk)
+	assert.False(t, slotOk)
+	assert.True(t, txn.ContainsAccessListAddress(addr1))
+
+	// the access list is not committed to the state
+	objs, err := txn.Commit(false)
+	require.NoError(t, err)
+	assert.Empty(t, objs)
+	assert.False(t, txn.ContainsAccessListAddress(addr1))
+}
+
+func TestTransientStorage(t *testing.T) {
+	t.Parallel()
+
+	txn := newTestTxn(defaultPreState)
+
+	hash2 := types.StringToHash("2")
+
+	txn.SetTransientState(addr1, hash1, hash2)
+	assert.Equal(t, hash2, txn.GetTransientState(addr1, hash1))
+	assert.Equal(t, types.ZeroHash, txn.GetTransientState(addr2, hash1))
+
+	// the transient storage is reverted with the snapshot
+	ss := txn.Snapshot()
+
+	txn.SetTransientState(addr1, hash1, types.ZeroHash)
+	txn.SetTransientState(addr2, hash1, hash2)
+	assert.Equal(t, types.ZeroHash, txn.GetTransientState(addr1, hash1))
+
+	require.NoError(t, txn.RevertToSnapshot(ss))
+
+	assert.Equal(t, hash2, txn.GetTransientState(addr1, hash1))
+	assert.Equal(t, types.ZeroHash, txn.GetTransientState(addr2, hash1))
+
+	// the transient storage is reset at the end of the transaction
+	require.NoError(t, txn.CleanDeleteObjects(true))
+	assert.Equal(t, types.ZeroHash, txn.GetTransientState(addr1, hash1))
+
+	objs, err := txn.Commit(false)
+	require.NoError(t, err)
+	assert.Empty(t, objs)
+}
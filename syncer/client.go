@@ -190,7 +190,7 @@ func (m *syncPeerClient) GetPeerConnectionUpdateEventCh() <-chan *event.PeerEven
 
 // startGossip creates new topic and starts subscribing
 func (m *syncPeerClient) startGossip() error {
-	topic, err := m.network.NewTopic(statusTopicName, &proto.SyncPeerStatus{})
+	topic, err := m.network.NewTopic(statusTopicName, &proto.SyncPeerStatus{}, nil)
 	if err != nil {
 		return err
 	}
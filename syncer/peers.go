@@ -67,3 +67,20 @@ func (m *PeerMap) BestPeer(skipMap map[peer.ID]bool) *NoForkPeer {
 
 	return bestPeer
 }
+
+// PeersFrom returns the peers whose latest block number is not lower than the given one
+func (m *PeerMap) PeersFrom(number uint64) []*NoForkPeer {
+	peers := []*NoForkPeer{}
+
+	m.Range(func(key, value interface{}) bool {
+		peer, _ := value.(*NoForkPeer)
+
+		if peer.Number >= number {
+			peers = append(peers, peer)
+		}
+
+		return true
+	})
+
+	return peers
+}
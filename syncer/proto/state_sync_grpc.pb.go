@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.4
+// source: syncer/proto/state_sync.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StateSyncPeerClient is the client API for StateSyncPeer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StateSyncPeerClient interface {
+	// Returns a range of accounts of the state trie with the given root
+	GetAccountRange(ctx context.Context, in *TrieRangeRequest, opts ...grpc.CallOption) (*TrieRange, error)
+	// Returns a range of storage slots of the storage trie with the given root
+	GetStorageRange(ctx context.Context, in *TrieRangeRequest, opts ...grpc.CallOption) (*TrieRange, error)
+	// Returns the contract codes with the given hashes
+	GetCode(ctx context.Context, in *CodeRequest, opts ...grpc.CallOption) (*CodeResponse, error)
+	// Returns the receipts of the blocks with the given hashes
+	GetReceipts(ctx context.Context, in *ReceiptsRequest, opts ...grpc.CallOption) (*ReceiptsResponse, error)
+}
+
+type stateSyncPeerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStateSyncPeerClient(cc grpc.ClientConnInterface) StateSyncPeerClient {
+	return &stateSyncPeerClient{cc}
+}
+
+func (c *stateSyncPeerClient) GetAccountRange(ctx context.Context, in *TrieRangeRequest, opts ...grpc.CallOption) (*TrieRange, error) {
+	out := new(TrieRange)
+	err := c.cc.Invoke(ctx, "/v1.StateSyncPeer/GetAccountRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateSyncPeerClient) GetStorageRange(ctx context.Context, in *TrieRangeRequest, opts ...grpc.CallOption) (*TrieRange, error) {
+	out := new(TrieRange)
+	err := c.cc.Invoke(ctx, "/v1.StateSyncPeer/GetStorageRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateSyncPeerClient) GetCode(ctx context.Context, in *CodeRequest, opts ...grpc.CallOption) (*CodeResponse, error) {
+	out := new(CodeResponse)
+	err := c.cc.Invoke(ctx, "/v1.StateSyncPeer/GetCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stateSyncPeerClient) GetReceipts(ctx context.Context, in *ReceiptsRequest, opts ...grpc.CallOption) (*ReceiptsResponse, error) {
+	out := new(ReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/v1.StateSyncPeer/GetReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateSyncPeerServer is the server API for StateSyncPeer service.
+// All implementations must embed UnimplementedStateSyncPeerServer
+// for forward compatibility
+type StateSyncPeerServer interface {
+	// Returns a range of accounts of the state trie with the given root
+	GetAccountRange(context.Context, *TrieRangeRequest) (*TrieRange, error)
+	// Returns a range of storage slots of the storage trie with the given root
+	GetStorageRange(context.Context, *TrieRangeRequest) (*TrieRange, error)
+	// Returns the contract codes with the given hashes
+	GetCode(context.Context, *CodeRequest) (*CodeResponse, error)
+	// Returns the receipts of the blocks with the given hashes
+	GetReceipts(context.Context, *ReceiptsRequest) (*ReceiptsResponse, error)
+	mustEmbedUnimplementedStateSyncPeerServer()
+}
+
+// UnimplementedStateSyncPeerServer must be embedded to have forward compatible implementations.
+type UnimplementedStateSyncPeerServer struct {
+}
+
+func (UnimplementedStateSyncPeerServer) GetAccountRange(context.Context, *TrieRangeRequest) (*TrieRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountRange not implemented")
+}
+func (UnimplementedStateSyncPeerServer) GetStorageRange(context.Context, *TrieRangeRequest) (*TrieRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageRange not implemented")
+}
+func (UnimplementedStateSyncPeerServer) GetCode(context.Context, *CodeRequest) (*CodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
+}
+func (UnimplementedStateSyncPeerServer) GetReceipts(context.Context, *ReceiptsRequest) (*ReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipts not implemented")
+}
+func (UnimplementedStateSyncPeerServer) mustEmbedUnimplementedStateSyncPeerServer() {}
+
+// UnsafeStateSyncPeerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StateSyncPeerServer will
+// result in compilation errors.
+type UnsafeStateSyncPeerServer interface {
+	mustEmbedUnimplementedStateSyncPeerServer()
+}
+
+func RegisterStateSyncPeerServer(s grpc.ServiceRegistrar, srv StateSyncPeerServer) {
+	s.RegisterService(&StateSyncPeer_ServiceDesc, srv)
+}
+
+func _StateSyncPeer_GetAccountRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrieRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateSyncPeerServer).GetAccountRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StateSyncPeer/GetAccountRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateSyncPeerServer).GetAccountRange(ctx, req.(*TrieRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateSyncPeer_GetStorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrieRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateSyncPeerServer).GetStorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StateSyncPeer/GetStorageRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateSyncPeerServer).GetStorageRange(ctx, req.(*TrieRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateSyncPeer_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateSyncPeerServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StateSyncPeer/GetCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateSyncPeerServer).GetCode(ctx, req.(*CodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StateSyncPeer_GetReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateSyncPeerServer).GetReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StateSyncPeer/GetReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateSyncPeerServer).GetReceipts(ctx, req.(*ReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StateSyncPeer_ServiceDesc is the grpc.ServiceDesc for StateSyncPeer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StateSyncPeer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.StateSyncPeer",
+	HandlerType: (*StateSyncPeerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccountRange",
+			Handler:    _StateSyncPeer_GetAccountRange_Handler,
+		},
+		{
+			MethodName: "GetStorageRange",
+			Handler:    _StateSyncPeer_GetStorageRange_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _StateSyncPeer_GetCode_Handler,
+		},
+		{
+			MethodName: "GetReceipts",
+			Handler:    _StateSyncPeer_GetReceipts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "syncer/proto/state_sync.proto",
+}
@@ -0,0 +1,470 @@
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/syncer/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rawGrpc "google.golang.org/grpc"
+)
+
+const (
+	stateSyncProto      = "/statesync/0.1"
+	stateSyncLoggerName = "state-sync"
+
+	// stateSyncChunks is the number of key ranges of the state trie which are downloaded in parallel
+	stateSyncChunks = 16
+	// stateSyncPivotDistance is the number of blocks between the head of the best peer
+	// and the block whose state is downloaded, so the state is not pruned on the peers during the sync
+	stateSyncPivotDistance = 64
+	// stateSyncMinDistance is the minimal distance to the best peer for which a new node
+	// downloads the state instead of executing all the blocks
+	stateSyncMinDistance = 1024
+	// stateSyncRequestTimeout is the timeout of a single state sync request
+	stateSyncRequestTimeout = 30 * time.Second
+)
+
+var (
+	errNoStateSyncPeers        = errors.New("no peers to sync the state from")
+	errStateRootMismatch       = errors.New("state root mismatch")
+	errStorageRootMismatch     = errors.New("storage root mismatch")
+	errCodeHashMismatch        = errors.New("code hash mismatch")
+	errInvalidTrieRange        = errors.New("invalid trie range")
+	errInvalidCodeResponse     = errors.New("invalid code response")
+	errInvalidReceiptsResponse = errors.New("invalid receipts response")
+)
+
+// stateDownloader downloads the state trie with the given root from the peers
+// and writes it into the state storage
+type stateDownloader struct {
+	logger  hclog.Logger
+	storage itrie.Storage
+	peers   []peer.ID
+
+	// newClient creates a state sync client for the given peer
+	newClient func(peer.ID) (proto.StateSyncPeerClient, error)
+}
+
+func newStateDownloader(
+	logger hclog.Logger,
+	storage itrie.Storage,
+	peers []peer.ID,
+	newClient func(peer.ID) (proto.StateSyncPeerClient, error),
+) *stateDownloader {
+	return &stateDownloader{
+		logger:    logger.Named(stateSyncLoggerName),
+		storage:   storage,
+		peers:     peers,
+		newClient: newClient,
+	}
+}
+
+// download downloads the state with the given root. The account trie is split into key ranges
+// which are downloaded from the peers in parallel, together with the storage of the accounts.
+// The state is accepted only if the rebuilt tries match the given root
+func (d *stateDownloader) download(root types.Hash) error {
+	if len(d.peers) == 0 {
+		return errNoStateSyncPeers
+	}
+
+	d.logger.Info("state sync started", "root", root, "peers", len(d.peers))
+
+	start := time.Now()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		accountCh = make(chan *syncedAccount, maxStateSyncRangeResults)
+		errCh     = make(chan error, stateSyncChunks)
+	)
+
+	for i := 0; i < stateSyncChunks; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if err := d.downloadChunk(ctx, root, i, accountCh); err != nil {
+				errCh <- err
+
+				cancel()
+			}
+		}(i)
+	}
+
+	go func() {
+		wg.Wait()
+		close(accountCh)
+	}()
+
+	// accounts are inserted into a single trie, so they are collected from all the chunks.
+	// The builder writes the nodes in batches, so the whole trie is never held in memory
+	var (
+		builder    = itrie.NewTrieBuilder(d.storage)
+		codeHashes = make([]types.Hash, 0)
+		accounts   = 0
+		insertErr  error
+	)
+
+	for acc := range accountCh {
+		if insertErr != nil {
+			// the chunks are drained until they stop
+			continue
+		}
+
+		if insertErr = builder.Insert(acc.key, acc.value); insertErr != nil {
+			cancel()
+
+			continue
+		}
+
+		if codeHash := types.BytesToHash(acc.account.CodeHash); codeHash != types.EmptyCodeHash &&
+			codeHash != types.ZeroHash {
+			codeHashes = append(codeHashes, codeHash)
+		}
+
+		accounts++
+	}
+
+	if insertErr != nil {
+		return insertErr
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+	}
+
+	if err := d.downloadCode(ctx, codeHashes); err != nil {
+		return err
+	}
+
+	stateRoot, err := builder.Commit()
+	if err != nil {
+		return err
+	}
+
+	if stateRoot != root {
+		return fmt.Errorf("%w: expected %s, got %s", errStateRootMismatch, root, stateRoot)
+	}
+
+	// double check the written state
+	checkedRoot, err := itrie.HashChecker(root.Bytes(), d.storage)
+	if err != nil {
+		return err
+	}
+
+	if checkedRoot != root {
+		return fmt.Errorf("%w: expected %s, written %s", errStateRootMismatch, root, checkedRoot)
+	}
+
+	d.logger.Info("state sync finished",
+		"root", root,
+		"accounts", accounts,
+		"codes", len(codeHashes),
+		"duration", time.Since(start),
+	)
+
+	metrics.SetGauge([]string{syncerMetrics, "state_sync_accounts"}, float32(accounts))
+	metrics.SetGauge([]string{syncerMetrics, "state_sync_duration_seconds"}, float32(time.Since(start).Seconds()))
+
+	return nil
+}
+
+// syncedAccount is a downloaded account whose storage is already written
+type syncedAccount struct {
+	key     []byte
+	value   []byte
+	account *state.Account
+}
+
+// downloadChunk downloads the accounts of the given key range, together with their storage.
+// On failure, the download continues from the last received account with the next peer
+func (d *stateDownloader) downloadChunk(ctx context.Context, root types.Hash, chunk int,
+	accountCh chan<- *syncedAccount) error {
+	origin, limit := chunkBounds(chunk)
+	peerIdx := chunk
+	failures := 0
+
+	for {
+		peerID := d.peers[peerIdx%len(d.peers)]
+
+		next, done, err := d.downloadAccounts(ctx, peerID, root, origin, limit, accountCh)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			failures++
+			if failures > len(d.peers) {
+				return fmt.Errorf("failed to download state chunk %d: %w", chunk, err)
+			}
+
+			d.logger.Debug("failed to download accounts, trying the next peer",
+				"peer", peerID, "chunk", chunk, "err", err)
+
+			peerIdx++
+		}
+
+		if done {
+			return nil
+		}
+
+		origin = next
+	}
+}
+
+// downloadAccounts downloads a single range of accounts from the peer and returns
+// the origin of the next range and whether the chunk is completed
+func (d *stateDownloader) downloadAccounts(ctx context.Context, peerID peer.ID, root types.Hash,
+	origin, limit []byte, accountCh chan<- *syncedAccount) ([]byte, bool, error) {
+	clt, err := d.newClient(peerID)
+	if err != nil {
+		return origin, false, err
+	}
+
+	resp, err := requestTrieRange(ctx, clt.GetAccountRange, root, origin, limit)
+	if err != nil {
+		return origin, false, err
+	}
+
+	for _, entry := range resp.Entries {
+		var account state.Account
+		if err := account.UnmarshalRlp(entry.Value); err != nil {
+			return origin, false, err
+		}
+
+		if err := d.downloadStorage(ctx, clt, account.Root); err != nil {
+			return origin, false, err
+		}
+
+		select {
+		case accountCh <- &syncedAccount{key: entry.Key, value: entry.Value, account: &account}:
+		case <-ctx.Done():
+			return origin, false, ctx.Err()
+		}
+
+		origin = nextTrieKey(entry.Key)
+	}
+
+	return origin, resp.Complete, nil
+}
+
+// downloadStorage downloads the storage trie with the given root and writes it into the storage
+func (d *stateDownloader) downloadStorage(ctx context.Context, clt proto.StateSyncPeerClient,
+	root types.Hash) error {
+	if root == types.EmptyRootHash || root == types.ZeroHash {
+		return nil
+	}
+
+	// the same storage may be shared by many accounts
+	if _, ok, err := d.storage.Get(root.Bytes()); err == nil && ok {
+		return nil
+	}
+
+	builder := itrie.NewTrieBuilder(d.storage)
+
+	var origin []byte
+
+	for {
+		resp, err := requestTrieRange(ctx, clt.GetStorageRange, root, origin, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range resp.Entries {
+			if err := builder.Insert(entry.Key, entry.Value); err != nil {
+				return err
+			}
+		}
+
+		if resp.Complete {
+			break
+		}
+
+		origin = nextTrieKey(resp.Entries[len(resp.Entries)-1].Key)
+	}
+
+	storageRoot, err := builder.Commit()
+	if err != nil {
+		return err
+	}
+
+	if storageRoot != root {
+		return fmt.Errorf("%w: expected %s, got %s", errStorageRootMismatch, root, storageRoot)
+	}
+
+	return nil
+}
+
+// downloadCode downloads the contract codes with the given hashes which are missing in the storage
+func (d *stateDownloader) downloadCode(ctx context.Context, hashes []types.Hash) error {
+	missing := make([]types.Hash, 0, len(hashes))
+	seen := make(map[types.Hash]struct{}, len(hashes))
+
+	for _, hash := range hashes {
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+
+		seen[hash] = struct{}{}
+
+		if _, ok := d.storage.GetCode(hash); !ok {
+			missing = append(missing, hash)
+		}
+	}
+
+	peerIdx := 0
+
+	for len(missing) > 0 {
+		batch := missing
+		if len(batch) > maxStateSyncCodes {
+			batch = batch[:maxStateSyncCodes]
+		}
+
+		var err error
+
+		for attempt := 0; attempt < len(d.peers); attempt++ {
+			peerID := d.peers[peerIdx%len(d.peers)]
+
+			if err = d.downloadCodeBatch(ctx, peerID, batch); err == nil {
+				break
+			}
+
+			d.logger.Debug("failed to download code, trying the next peer", "peer", peerID, "err", err)
+
+			peerIdx++
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to download code: %w", err)
+		}
+
+		missing = missing[len(batch):]
+	}
+
+	return nil
+}
+
+// downloadCodeBatch downloads the contract codes with the given hashes from the peer
+func (d *stateDownloader) downloadCodeBatch(ctx context.Context, peerID peer.ID, hashes []types.Hash) error {
+	clt, err := d.newClient(peerID)
+	if err != nil {
+		return err
+	}
+
+	req := &proto.CodeRequest{
+		Hashes: make([][]byte, len(hashes)),
+	}
+
+	for i, hash := range hashes {
+		req.Hashes[i] = hash.Bytes()
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, stateSyncRequestTimeout)
+	defer cancel()
+
+	resp, err := clt.GetCode(reqCtx, req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Codes) != len(hashes) {
+		return fmt.Errorf("%w: expected %d codes, got %d", errInvalidCodeResponse, len(hashes), len(resp.Codes))
+	}
+
+	for i, code := range resp.Codes {
+		if types.BytesToHash(crypto.Keccak256(code)) != hashes[i] {
+			return fmt.Errorf("%w: %s", errCodeHashMismatch, hashes[i])
+		}
+	}
+
+	for i, code := range resp.Codes {
+		if err := d.storage.SetCode(hashes[i], code); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// requestTrieRange requests a range of trie leaves and validates that
+// the leaves are ordered and belong to the requested range
+func requestTrieRange(
+	ctx context.Context,
+	request func(context.Context, *proto.TrieRangeRequest, ...rawGrpc.CallOption) (*proto.TrieRange, error),
+	root types.Hash,
+	origin, limit []byte,
+) (*proto.TrieRange, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, stateSyncRequestTimeout)
+	defer cancel()
+
+	resp, err := request(reqCtx, &proto.TrieRangeRequest{
+		Root:       root.Bytes(),
+		Origin:     origin,
+		Limit:      limit,
+		MaxResults: maxStateSyncRangeResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Complete && len(resp.Entries) == 0 {
+		return nil, fmt.Errorf("%w: empty incomplete range", errInvalidTrieRange)
+	}
+
+	prev := origin
+
+	for i, entry := range resp.Entries {
+		if len(entry.Key) != types.HashLength {
+			return nil, fmt.Errorf("%w: invalid key length %d", errInvalidTrieRange, len(entry.Key))
+		}
+
+		if cmp := bytes.Compare(entry.Key, prev); cmp < 0 || (cmp == 0 && i > 0) {
+			return nil, fmt.Errorf("%w: keys are not ordered", errInvalidTrieRange)
+		}
+
+		if len(limit) != 0 && bytes.Compare(entry.Key, limit) >= 0 {
+			return nil, fmt.Errorf("%w: key out of range", errInvalidTrieRange)
+		}
+
+		prev = entry.Key
+	}
+
+	return resp, nil
+}
+
+// chunkBounds returns the origin and the (exclusive) limit of the key range of the given chunk.
+// The key space is split evenly by the first nibble of the key
+func chunkBounds(chunk int) ([]byte, []byte) {
+	step := 256 / stateSyncChunks
+	origin := []byte{byte(chunk * step)}
+
+	if chunk == stateSyncChunks-1 {
+		return origin, nil
+	}
+
+	return origin, []byte{byte((chunk + 1) * step)}
+}
+
+// nextTrieKey returns the lowest key which is greater than the given one
+func nextTrieKey(key []byte) []byte {
+	next := make([]byte, len(key)+1)
+	copy(next, key)
+
+	return next
+}
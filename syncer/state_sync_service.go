@@ -0,0 +1,174 @@
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/syncer/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// maxStateSyncRangeResults is the maximal number of trie leaves served in a single response
+	maxStateSyncRangeResults = 1024
+	// maxStateSyncCodes is the maximal number of contract codes served in a single response
+	maxStateSyncCodes = 64
+	// maxStateSyncReceipts is the maximal number of block receipts served in a single response
+	maxStateSyncReceipts = 64
+)
+
+var (
+	ErrStateNotAvailable = errors.New("state not available")
+	ErrCodeNotFound      = errors.New("code not found")
+	ErrReceiptsNotFound  = errors.New("receipts not found")
+)
+
+type stateSyncPeerService struct {
+	proto.UnimplementedStateSyncPeerServer
+
+	blockchain Blockchain       // reference to the blockchain module
+	storage    itrie.Storage    // reference to the state storage
+	network    Network          // reference to the network module
+	stream     *grpc.GrpcStream // reference to the grpc stream
+}
+
+func NewStateSyncPeerService(
+	network Network,
+	blockchain Blockchain,
+	storage itrie.Storage,
+) SyncPeerService {
+	return &stateSyncPeerService{
+		blockchain: blockchain,
+		storage:    storage,
+		network:    network,
+	}
+}
+
+// Start starts stateSyncPeerService
+func (s *stateSyncPeerService) Start() {
+	s.stream = grpc.NewGrpcStream()
+
+	proto.RegisterStateSyncPeerServer(s.stream.GrpcServer(), s)
+	s.stream.Serve()
+	s.network.RegisterProtocol(stateSyncProto, s.stream)
+}
+
+// Close closes stateSyncPeerService
+func (s *stateSyncPeerService) Close() error {
+	return s.stream.Close()
+}
+
+// GetAccountRange is a gRPC endpoint to return a range of accounts of the state trie
+func (s *stateSyncPeerService) GetAccountRange(
+	ctx context.Context,
+	req *proto.TrieRangeRequest,
+) (*proto.TrieRange, error) {
+	return s.getTrieRange(req)
+}
+
+// GetStorageRange is a gRPC endpoint to return a range of slots of a storage trie
+func (s *stateSyncPeerService) GetStorageRange(
+	ctx context.Context,
+	req *proto.TrieRangeRequest,
+) (*proto.TrieRange, error) {
+	return s.getTrieRange(req)
+}
+
+// GetCode is a gRPC endpoint to return contract codes by their hashes
+func (s *stateSyncPeerService) GetCode(
+	ctx context.Context,
+	req *proto.CodeRequest,
+) (*proto.CodeResponse, error) {
+	hashes := req.Hashes
+	if len(hashes) > maxStateSyncCodes {
+		hashes = hashes[:maxStateSyncCodes]
+	}
+
+	resp := &proto.CodeResponse{
+		Codes: make([][]byte, 0, len(hashes)),
+	}
+
+	for _, hash := range hashes {
+		code, ok := s.storage.GetCode(types.BytesToHash(hash))
+		if !ok {
+			return nil, ErrCodeNotFound
+		}
+
+		resp.Codes = append(resp.Codes, code)
+	}
+
+	return resp, nil
+}
+
+// GetReceipts is a gRPC endpoint to return the receipts of the blocks by their hashes
+func (s *stateSyncPeerService) GetReceipts(
+	ctx context.Context,
+	req *proto.ReceiptsRequest,
+) (*proto.ReceiptsResponse, error) {
+	hashes := req.Hashes
+	if len(hashes) > maxStateSyncReceipts {
+		hashes = hashes[:maxStateSyncReceipts]
+	}
+
+	resp := &proto.ReceiptsResponse{
+		Receipts: make([][]byte, 0, len(hashes)),
+	}
+
+	for _, hash := range hashes {
+		receipts, err := s.blockchain.GetReceiptsByHash(types.BytesToHash(hash))
+		if err != nil {
+			return nil, ErrReceiptsNotFound
+		}
+
+		resp.Receipts = append(resp.Receipts, types.Receipts(receipts).MarshalRLPTo(nil))
+	}
+
+	return resp, nil
+}
+
+// getTrieRange returns the leaves of the trie with the requested root,
+// starting from the origin and ending before the limit
+func (s *stateSyncPeerService) getTrieRange(req *proto.TrieRangeRequest) (*proto.TrieRange, error) {
+	root := types.BytesToHash(req.Root)
+	if root != types.EmptyRootHash {
+		if _, ok, err := s.storage.Get(root.Bytes()); err != nil || !ok {
+			return nil, ErrStateNotAvailable
+		}
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 || maxResults > maxStateSyncRangeResults {
+		maxResults = maxStateSyncRangeResults
+	}
+
+	resp := &proto.TrieRange{
+		Complete: true,
+	}
+
+	err := itrie.Iterate(root, s.storage, req.Origin, func(key, value []byte) bool {
+		if len(req.Limit) != 0 && bytes.Compare(key, req.Limit) >= 0 {
+			return false
+		}
+
+		if uint64(len(resp.Entries)) == maxResults {
+			resp.Complete = false
+
+			return false
+		}
+
+		resp.Entries = append(resp.Entries, &proto.TrieEntry{Key: key, Value: value})
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.IncrCounter([]string{syncerMetrics, "state_egress_leaves"}, float32(len(resp.Entries)))
+
+	return resp, nil
+}
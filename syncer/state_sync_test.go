@@ -0,0 +1,546 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/syncer/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+var errMockPeerFailure = errors.New("peer failure")
+
+// mockStateSyncPeerClient calls the state sync service of the peer directly
+type mockStateSyncPeerClient struct {
+	service *stateSyncPeerService
+
+	// failing makes all the requests fail
+	failing bool
+	// tamper modifies the returned leaves
+	tamper bool
+}
+
+func (m *mockStateSyncPeerClient) GetAccountRange(
+	ctx context.Context,
+	in *proto.TrieRangeRequest,
+	opts ...grpc.CallOption,
+) (*proto.TrieRange, error) {
+	if m.failing {
+		return nil, errMockPeerFailure
+	}
+
+	resp, err := m.service.GetAccountRange(ctx, in)
+	if err == nil && m.tamper && len(resp.Entries) > 0 {
+		resp.Entries = resp.Entries[1:]
+	}
+
+	return resp, err
+}
+
+func (m *mockStateSyncPeerClient) GetStorageRange(
+	ctx context.Context,
+	in *proto.TrieRangeRequest,
+	opts ...grpc.CallOption,
+) (*proto.TrieRange, error) {
+	if m.failing {
+		return nil, errMockPeerFailure
+	}
+
+	return m.service.GetStorageRange(ctx, in)
+}
+
+func (m *mockStateSyncPeerClient) GetCode(
+	ctx context.Context,
+	in *proto.CodeRequest,
+	opts ...grpc.CallOption,
+) (*proto.CodeResponse, error) {
+	if m.failing {
+		return nil, errMockPeerFailure
+	}
+
+	return m.service.GetCode(ctx, in)
+}
+
+func (m *mockStateSyncPeerClient) GetReceipts(
+	ctx context.Context,
+	in *proto.ReceiptsRequest,
+	opts ...grpc.CallOption,
+) (*proto.ReceiptsResponse, error) {
+	if m.failing {
+		return nil, errMockPeerFailure
+	}
+
+	return m.service.GetReceipts(ctx, in)
+}
+
+// createTestState commits the given number of accounts with storage and code and returns the state root
+func createTestState(t *testing.T, storage itrie.Storage, accounts int) types.Hash {
+	t.Helper()
+
+	code := []byte{0x60, 0x01, 0x60, 0x02}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	objs := make([]*state.Object, 0, accounts)
+
+	for i := 0; i < accounts; i++ {
+		obj := &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i + 1)).Bytes()),
+			Balance:  big.NewInt(int64(i + 1)),
+			Nonce:    uint64(i),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		}
+
+		if i%10 == 0 {
+			obj.CodeHash = codeHash
+			obj.DirtyCode = true
+			obj.Code = code
+
+			for j := 0; j < i%64+8; j++ {
+				obj.Storage = append(obj.Storage, &state.StorageObject{
+					Key: types.BytesToHash(big.NewInt(int64(j)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(j + 1)).Bytes()).Bytes(),
+				})
+			}
+		}
+
+		objs = append(objs, obj)
+	}
+
+	_, root, err := itrie.NewState(storage).NewSnapshot().Commit(objs)
+	require.NoError(t, err)
+
+	return types.BytesToHash(root)
+}
+
+func newTestStateDownloader(storage itrie.Storage, clients map[peer.ID]proto.StateSyncPeerClient,
+	peers ...peer.ID) *stateDownloader {
+	return newStateDownloader(hclog.NewNullLogger(), storage, peers, func(id peer.ID) (proto.StateSyncPeerClient, error) {
+		return clients[id], nil
+	})
+}
+
+func TestStateDownloader_Download(t *testing.T) {
+	t.Parallel()
+
+	source := itrie.NewMemoryStorage()
+	root := createTestState(t, source, 2*maxStateSyncRangeResults)
+
+	service := &stateSyncPeerService{storage: source}
+
+	tests := []struct {
+		name    string
+		clients map[peer.ID]proto.StateSyncPeerClient
+		peers   []peer.ID
+		err     error
+	}{
+		{
+			name: "single peer",
+			clients: map[peer.ID]proto.StateSyncPeerClient{
+				"A": &mockStateSyncPeerClient{service: service},
+			},
+			peers: []peer.ID{"A"},
+		},
+		{
+			name: "failing peer is replaced",
+			clients: map[peer.ID]proto.StateSyncPeerClient{
+				"A": &mockStateSyncPeerClient{service: service, failing: true},
+				"B": &mockStateSyncPeerClient{service: service},
+			},
+			peers: []peer.ID{"A", "B"},
+		},
+		{
+			name: "all peers failing",
+			clients: map[peer.ID]proto.StateSyncPeerClient{
+				"A": &mockStateSyncPeerClient{service: service, failing: true},
+			},
+			peers: []peer.ID{"A"},
+			err:   errMockPeerFailure,
+		},
+		{
+			name: "tampered state",
+			clients: map[peer.ID]proto.StateSyncPeerClient{
+				"A": &mockStateSyncPeerClient{service: service, tamper: true},
+			},
+			peers: []peer.ID{"A"},
+			err:   errStateRootMismatch,
+		},
+		{
+			name:  "no peers",
+			peers: []peer.ID{},
+			err:   errNoStateSyncPeers,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := itrie.NewMemoryStorage()
+
+			err := newTestStateDownloader(target, test.clients, test.peers...).download(root)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			checked, err := itrie.HashChecker(root.Bytes(), target)
+			require.NoError(t, err)
+			assert.Equal(t, root, checked)
+
+			// the synced state is readable
+			snap, err := itrie.NewState(target).NewSnapshotAt(root)
+			require.NoError(t, err)
+
+			account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(11).Bytes()))
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(11), account.Balance)
+
+			value := snap.GetStorage(
+				types.BytesToAddress(big.NewInt(11).Bytes()),
+				account.Root,
+				types.BytesToHash(big.NewInt(5).Bytes()),
+			)
+			assert.Equal(t, types.BytesToHash(big.NewInt(6).Bytes()), value)
+
+			code, ok := target.GetCode(types.BytesToHash(account.CodeHash))
+			require.True(t, ok)
+			assert.Equal(t, []byte{0x60, 0x01, 0x60, 0x02}, code)
+		})
+	}
+}
+
+func TestStateSyncPeerService_GetAccountRange(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+	root := createTestState(t, storage, 50)
+
+	service := &stateSyncPeerService{storage: storage}
+
+	// the first page is limited by the max results
+	resp, err := service.GetAccountRange(context.Background(), &proto.TrieRangeRequest{
+		Root:       root.Bytes(),
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 10)
+	assert.False(t, resp.Complete)
+
+	// the next page starts after the last key
+	next, err := service.GetAccountRange(context.Background(), &proto.TrieRangeRequest{
+		Root:       root.Bytes(),
+		Origin:     nextTrieKey(resp.Entries[9].Key),
+		MaxResults: 100,
+	})
+	require.NoError(t, err)
+	require.Len(t, next.Entries, 40)
+	assert.True(t, next.Complete)
+	assert.Less(t, string(resp.Entries[9].Key), string(next.Entries[0].Key))
+
+	// the range is bounded by the limit
+	origin, limit := chunkBounds(0)
+
+	bounded, err := service.GetAccountRange(context.Background(), &proto.TrieRangeRequest{
+		Root:   root.Bytes(),
+		Origin: origin,
+		Limit:  limit,
+	})
+	require.NoError(t, err)
+	assert.True(t, bounded.Complete)
+
+	for _, entry := range bounded.Entries {
+		assert.Less(t, entry.Key[0], limit[0])
+	}
+
+	// unknown root
+	_, err = service.GetAccountRange(context.Background(), &proto.TrieRangeRequest{
+		Root: types.StringToHash("0x1").Bytes(),
+	})
+	require.ErrorIs(t, err, ErrStateNotAvailable)
+}
+
+func TestStateSyncPeerService_GetCode(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+	code := []byte{0x60, 0x01}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	require.NoError(t, storage.SetCode(codeHash, code))
+
+	service := &stateSyncPeerService{storage: storage}
+
+	resp, err := service.GetCode(context.Background(), &proto.CodeRequest{Hashes: [][]byte{codeHash.Bytes()}})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{code}, resp.Codes)
+
+	_, err = service.GetCode(context.Background(), &proto.CodeRequest{Hashes: [][]byte{types.ZeroHash.Bytes()}})
+	require.ErrorIs(t, err, ErrCodeNotFound)
+}
+
+// newTestReceipts returns a receipt with a log for every transaction of the block with the given number
+func newTestReceipts(number uint64, txs int) []*types.Receipt {
+	receipts := make([]*types.Receipt, txs)
+
+	for i := range receipts {
+		receipts[i] = &types.Receipt{
+			CumulativeGasUsed: uint64(i+1) * 21000,
+			Logs: []*types.Log{{
+				Address: types.StringToAddress("0x1"),
+				Topics:  []types.Hash{types.BytesToHash(big.NewInt(int64(number)).Bytes())},
+			}},
+		}
+		receipts[i].SetStatus(types.ReceiptSuccess)
+	}
+
+	return receipts
+}
+
+func TestStateSyncPeerService_GetReceipts(t *testing.T) {
+	t.Parallel()
+
+	known := types.StringToHash("0x1")
+
+	service := &stateSyncPeerService{
+		blockchain: &mockBlockchain{
+			getReceiptsByHashHandler: func(hash types.Hash) ([]*types.Receipt, error) {
+				if hash != known {
+					return nil, errors.New("not found")
+				}
+
+				return newTestReceipts(1, 2), nil
+			},
+		},
+	}
+
+	resp, err := service.GetReceipts(context.Background(), &proto.ReceiptsRequest{Hashes: [][]byte{known.Bytes()}})
+	require.NoError(t, err)
+	require.Len(t, resp.Receipts, 1)
+
+	var receipts types.Receipts
+	require.NoError(t, receipts.UnmarshalRLP(resp.Receipts[0]))
+	assert.Equal(t, types.Receipts(newTestReceipts(1, 2)), receipts)
+
+	_, err = service.GetReceipts(context.Background(), &proto.ReceiptsRequest{Hashes: [][]byte{types.ZeroHash.Bytes()}})
+	require.ErrorIs(t, err, ErrReceiptsNotFound)
+}
+
+func TestSyncer_writeBlocksWithoutState(t *testing.T) {
+	t.Parallel()
+
+	blocks := make([]*types.Block, 3)
+	for i := range blocks {
+		blocks[i] = &types.Block{Header: &types.Header{Number: uint64(i + 1)}}
+		blocks[i].Header.ComputeHash()
+	}
+
+	// the peer serves the receipts of the blocks
+	peerService := &stateSyncPeerService{
+		blockchain: &mockBlockchain{
+			getReceiptsByHashHandler: func(hash types.Hash) ([]*types.Receipt, error) {
+				for _, b := range blocks {
+					if b.Hash() == hash {
+						return newTestReceipts(b.Number(), int(b.Number())), nil
+					}
+				}
+
+				return nil, errors.New("not found")
+			},
+		},
+	}
+
+	written := make([]*types.FullBlock, 0, len(blocks))
+
+	s := &syncer{
+		blockchain: &mockBlockchain{
+			verifyWithoutStateHandler: func(b *types.Block, r []*types.Receipt) (*types.FullBlock, error) {
+				return &types.FullBlock{Block: b, Receipts: r}, nil
+			},
+			writeFullBlockHandler: func(fb *types.FullBlock) error {
+				written = append(written, fb)
+
+				return nil
+			},
+		},
+	}
+
+	require.NoError(t, s.writeBlocksWithoutState(&mockStateSyncPeerClient{service: peerService}, blocks))
+	require.Len(t, written, len(blocks))
+
+	for i, fb := range written {
+		assert.Equal(t, blocks[i], fb.Block)
+		assert.Equal(t, types.Receipts(newTestReceipts(fb.Block.Number(), i+1)), types.Receipts(fb.Receipts))
+	}
+
+	// nothing is written if the peer fails
+	written = written[:0]
+
+	err := s.writeBlocksWithoutState(&mockStateSyncPeerClient{service: peerService, failing: true}, blocks)
+	require.ErrorIs(t, err, errMockPeerFailure)
+	assert.Empty(t, written)
+}
+
+func TestSyncer_firstBlockWithoutState(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+	root := createTestState(t, storage, 5)
+
+	s := &syncer{
+		blockchain: &mockBlockchain{
+			getBlockByNumberHandler: func(number uint64, _ bool) (*types.Block, bool) {
+				// the blocks after the block 5 were written without state
+				stateRoot := types.StringToHash("0x1")
+				if number <= 5 {
+					stateRoot = root
+				}
+
+				return &types.Block{Header: &types.Header{Number: number, StateRoot: stateRoot}}, true
+			},
+		},
+		stateStorage: storage,
+	}
+
+	assert.Equal(t, uint64(6), s.firstBlockWithoutState(10))
+	assert.Equal(t, uint64(4), s.firstBlockWithoutState(3))
+}
+
+func TestSyncer_notifyStateSynced(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize = uint64(10)
+		pivot     = uint64(25)
+	)
+
+	storage := itrie.NewMemoryStorage()
+	root := createTestState(t, storage, 5)
+
+	s := &syncer{
+		blockchain: &mockBlockchain{
+			getBlockByNumberHandler: func(number uint64, _ bool) (*types.Block, bool) {
+				// only the pivot block has the synced state
+				stateRoot := types.StringToHash("0x1")
+				if number == pivot {
+					stateRoot = root
+				}
+
+				return &types.Block{Header: &types.Header{Number: number, StateRoot: stateRoot}}, true
+			},
+			getReceiptsByHashHandler: func(types.Hash) ([]*types.Receipt, error) {
+				return []*types.Receipt{}, nil
+			},
+		},
+		stateStorage: storage,
+	}
+
+	notified := make([]uint64, 0)
+	callback := func(fb *types.FullBlock) bool {
+		// the epoch ending blocks restart the epoch from their state (as the consensus does)
+		if fb.Block.Number()%epochSize == 0 || fb.Block.Number() == pivot {
+			require.True(t, s.hasState(fb.Block.Header.StateRoot), "block %d has no state", fb.Block.Number())
+		}
+
+		notified = append(notified, fb.Block.Number())
+
+		return false
+	}
+
+	// the epoch boundaries (blocks 10 and 20) fall between the first block without state and the pivot
+	shouldTerminate, err := s.notifyStateSynced(1, pivot, callback)
+	require.NoError(t, err)
+	assert.False(t, shouldTerminate)
+	assert.Equal(t, []uint64{pivot}, notified)
+
+	// nothing is passed if the pivot block had the state already
+	notified = notified[:0]
+
+	_, err = s.notifyStateSynced(pivot+1, pivot, callback)
+	require.NoError(t, err)
+	assert.Empty(t, notified)
+}
+
+func TestSyncer_shouldSyncState(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+	root := createTestState(t, storage, 5)
+
+	tests := []struct {
+		name       string
+		stateSync  bool
+		head       *types.Header
+		peerLatest uint64
+		expected   bool
+	}{
+		{
+			name:       "disabled",
+			stateSync:  false,
+			head:       &types.Header{Number: 0, StateRoot: root},
+			peerLatest: stateSyncMinDistance,
+			expected:   false,
+		},
+		{
+			name:       "new node far behind",
+			stateSync:  true,
+			head:       &types.Header{Number: 0, StateRoot: root},
+			peerLatest: stateSyncMinDistance,
+			expected:   true,
+		},
+		{
+			name:       "new node close to the peer",
+			stateSync:  true,
+			head:       &types.Header{Number: 0, StateRoot: root},
+			peerLatest: stateSyncMinDistance - 1,
+			expected:   false,
+		},
+		{
+			name:       "synced node",
+			stateSync:  true,
+			head:       &types.Header{Number: 10, StateRoot: root},
+			peerLatest: 10 + stateSyncMinDistance,
+			expected:   false,
+		},
+		{
+			name:       "missing head state",
+			stateSync:  true,
+			head:       &types.Header{Number: 10, StateRoot: types.StringToHash("0x1")},
+			peerLatest: 11,
+			expected:   true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &syncer{
+				blockchain: &mockBlockchain{
+					headerHandler: func() *types.Header { return test.head },
+				},
+				stateStorage: storage,
+				stateSync:    test.stateSync,
+			}
+
+			assert.Equal(t, test.expected, s.shouldSyncState(test.peerLatest))
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package syncer
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network/event"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/syncer/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
@@ -19,7 +23,9 @@ const (
 )
 
 var (
-	errTimeout = errors.New("timeout awaiting block from peer")
+	errTimeout            = errors.New("timeout awaiting block from peer")
+	errPivotNotReached    = errors.New("peer did not send all the blocks up to the state sync pivot")
+	errPivotBlockNotFound = errors.New("state sync pivot block not found")
 )
 
 // XXX: Don't use this syncer for the consensus that may cause fork.
@@ -36,6 +42,13 @@ type syncer struct {
 	// Timeout for syncing a block
 	blockTimeout time.Duration
 
+	network              Network
+	stateStorage         itrie.Storage
+	stateSyncPeerService SyncPeerService
+
+	// Flag for downloading the state of a recent block instead of executing all the blocks
+	stateSync bool
+
 	// Channel to notify Sync that a new status arrived
 	newStatusCh chan struct{}
 }
@@ -45,16 +58,22 @@ func NewSyncer(
 	network Network,
 	blockchain Blockchain,
 	blockTimeout time.Duration,
+	stateStorage itrie.Storage,
+	stateSync bool,
 ) Syncer {
 	return &syncer{
-		logger:          logger.Named(syncerName),
-		blockchain:      blockchain,
-		syncProgression: progress.NewProgressionWrapper(progress.ChainSyncBulk),
-		syncPeerService: NewSyncPeerService(network, blockchain),
-		syncPeerClient:  NewSyncPeerClient(logger, network, blockchain),
-		blockTimeout:    blockTimeout,
-		newStatusCh:     make(chan struct{}),
-		peerMap:         new(PeerMap),
+		logger:               logger.Named(syncerName),
+		blockchain:           blockchain,
+		syncProgression:      progress.NewProgressionWrapper(progress.ChainSyncBulk),
+		syncPeerService:      NewSyncPeerService(network, blockchain),
+		syncPeerClient:       NewSyncPeerClient(logger, network, blockchain),
+		blockTimeout:         blockTimeout,
+		newStatusCh:          make(chan struct{}),
+		peerMap:              new(PeerMap),
+		network:              network,
+		stateStorage:         stateStorage,
+		stateSyncPeerService: NewStateSyncPeerService(network, blockchain, stateStorage),
+		stateSync:            stateSync && stateStorage != nil,
 	}
 }
 
@@ -66,6 +85,10 @@ func (s *syncer) Start() error {
 
 	s.syncPeerService.Start()
 
+	if s.stateStorage != nil {
+		s.stateSyncPeerService.Start()
+	}
+
 	s.initializePeerMap()
 
 	go s.startPeerStatusUpdateProcess()
@@ -82,6 +105,12 @@ func (s *syncer) Close() error {
 		return err
 	}
 
+	if s.stateStorage != nil {
+		if err := s.stateSyncPeerService.Close(); err != nil {
+			return err
+		}
+	}
+
 	s.syncPeerClient.Close()
 
 	return nil
@@ -187,6 +216,23 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 			continue
 		}
 
+		if s.shouldSyncState(bestPeer.Number) {
+			shouldTerminate, err := s.syncStateWithPeers(bestPeer, callback)
+			if err != nil {
+				s.logger.Warn("failed to sync state, try to next peer", "peer ID", bestPeer.ID, "error", err)
+
+				skipList[bestPeer.ID] = true
+
+				continue
+			}
+
+			if shouldTerminate {
+				break
+			}
+
+			continue
+		}
+
 		// fetch block from the peer
 		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, bestPeer.Number, callback)
 		if err != nil {
@@ -272,6 +318,277 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, peerLatestBlock uint64,
 	}
 }
 
+// shouldSyncState returns true if the state should be downloaded instead of executing the blocks.
+// It is the case for a new node which is far behind the best peer, or a node whose
+// state sync was interrupted after writing the blocks preceding the synced state
+func (s *syncer) shouldSyncState(peerLatestBlock uint64) bool {
+	if !s.stateSync {
+		return false
+	}
+
+	header := s.blockchain.Header()
+	if !s.hasState(header.StateRoot) {
+		return true
+	}
+
+	return header.Number == 0 && peerLatestBlock >= stateSyncMinDistance
+}
+
+// hasState returns true if the state with the given root is in the state storage
+func (s *syncer) hasState(root types.Hash) bool {
+	if root == types.EmptyRootHash {
+		return true
+	}
+
+	_, ok, err := s.stateStorage.Get(root.Bytes())
+
+	return err == nil && ok
+}
+
+// syncStateWithPeers writes the blocks up to the pivot block along with their receipts without executing them,
+// downloads the state of the pivot block and passes the blocks written without state to the callback.
+// The blocks after the pivot block are synced and executed by the bulk sync
+func (s *syncer) syncStateWithPeers(bestPeer *NoForkPeer,
+	newBlockCallback func(*types.FullBlock) bool) (bool, error) {
+	localLatest := s.blockchain.Header().Number
+
+	pivot := localLatest
+	if bestPeer.Number > localLatest+stateSyncPivotDistance {
+		pivot = bestPeer.Number - stateSyncPivotDistance
+	}
+
+	// the best peer is asked first, followed by the other peers which have the pivot block
+	peers := []peer.ID{bestPeer.ID}
+
+	for _, p := range s.peerMap.PeersFrom(pivot) {
+		if p.ID != bestPeer.ID {
+			peers = append(peers, p.ID)
+		}
+	}
+
+	// a single stream is opened to each peer and shared by the parallel requests
+	var (
+		clientsLock sync.Mutex
+		clients     = make(map[peer.ID]proto.StateSyncPeerClient)
+	)
+
+	newClient := func(peerID peer.ID) (proto.StateSyncPeerClient, error) {
+		clientsLock.Lock()
+		defer clientsLock.Unlock()
+
+		if clt, ok := clients[peerID]; ok {
+			return clt, nil
+		}
+
+		clt, err := s.newStateSyncPeerClient(peerID)
+		if err != nil {
+			return nil, err
+		}
+
+		clients[peerID] = clt
+
+		return clt, nil
+	}
+
+	defer func() {
+		for peerID := range clients {
+			if err := s.network.CloseProtocolStream(stateSyncProto, peerID); err != nil {
+				s.logger.Debug("failed to close state sync stream", "peer ID", peerID, "error", err)
+			}
+		}
+	}()
+
+	// the blocks written without state (also by an interrupted state sync)
+	// are not passed to the callback, only the pivot block is, once its state is synced
+	firstWithoutState := s.firstBlockWithoutState(localLatest)
+
+	if pivot > localLatest {
+		clt, err := newClient(bestPeer.ID)
+		if err != nil {
+			return false, err
+		}
+
+		if err := s.bulkSyncWithoutState(bestPeer.ID, clt, pivot); err != nil {
+			return false, err
+		}
+	}
+
+	pivotBlock, ok := s.blockchain.GetBlockByNumber(pivot, true)
+	if !ok {
+		return false, errPivotBlockNotFound
+	}
+
+	downloader := newStateDownloader(s.logger, s.stateStorage, peers, newClient)
+	if err := downloader.download(pivotBlock.Header.StateRoot); err != nil {
+		return false, err
+	}
+
+	return s.notifyStateSynced(firstWithoutState, pivot, newBlockCallback)
+}
+
+// notifyStateSynced passes the pivot block to the callback once its state is synced.
+// The blocks before the pivot block have no state, so the state dependent hooks
+// (e.g. the epoch restart of the consensus) can't run for them, and they are not passed to the callback.
+// The consensus catches up from the state of the pivot block instead
+func (s *syncer) notifyStateSynced(
+	firstWithoutState, pivot uint64,
+	newBlockCallback func(*types.FullBlock) bool,
+) (bool, error) {
+	if firstWithoutState > pivot {
+		// the pivot block had the state already, so it was passed to the callback before
+		return false, nil
+	}
+
+	block, ok := s.blockchain.GetBlockByNumber(pivot, true)
+	if !ok {
+		return false, fmt.Errorf("block %d: %w", pivot, ErrBlockNotFound)
+	}
+
+	receipts, err := s.blockchain.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return false, fmt.Errorf("block %d: %w", pivot, err)
+	}
+
+	return newBlockCallback(&types.FullBlock{Block: block, Receipts: receipts}), nil
+}
+
+// firstBlockWithoutState returns the number of the block following the latest block with the local state
+func (s *syncer) firstBlockWithoutState(latest uint64) uint64 {
+	for n := latest; n > 0; n-- {
+		block, ok := s.blockchain.GetBlockByNumber(n, false)
+		if ok && s.hasState(block.Header.StateRoot) {
+			return n + 1
+		}
+	}
+
+	return 1
+}
+
+// bulkSyncWithoutState writes the blocks up to the pivot block from the given peer
+// after verifying them and their receipts without execution
+func (s *syncer) bulkSyncWithoutState(peerID peer.ID, clt proto.StateSyncPeerClient, pivot uint64) error {
+	localLatest := s.blockchain.Header().Number
+
+	blockCh, err := s.syncPeerClient.GetBlocks(peerID, localLatest+1, s.blockTimeout)
+	if err != nil {
+		return err
+	}
+
+	subscription := s.blockchain.SubscribeEvents()
+	s.syncProgression.StartProgression(localLatest+1, subscription)
+	s.syncProgression.UpdateHighestProgression(pivot)
+
+	defer func() {
+		err := s.syncPeerClient.CloseStream(peerID)
+		if err != nil {
+			s.logger.Error("Failed to close stream: ", err)
+		}
+
+		s.syncProgression.StopProgression()
+		s.blockchain.UnsubscribeEvents(subscription)
+	}()
+
+	for {
+		select {
+		case block, ok := <-blockCh:
+			if !ok {
+				return errPivotNotReached
+			}
+
+			// safe check
+			if block.Number() == 0 {
+				continue
+			}
+
+			// the blocks which are already received are written together,
+			// so their receipts are fetched by a single request
+			blocks := []*types.Block{block}
+
+		collect:
+			for len(blocks) < maxStateSyncReceipts && blocks[len(blocks)-1].Number() < pivot {
+				select {
+				case next, ok := <-blockCh:
+					if !ok {
+						break collect
+					}
+
+					blocks = append(blocks, next)
+				default:
+					break collect
+				}
+			}
+
+			if err := s.writeBlocksWithoutState(clt, blocks); err != nil {
+				return err
+			}
+
+			if blocks[len(blocks)-1].Number() >= pivot {
+				return nil
+			}
+		case <-time.After(s.blockTimeout):
+			return errTimeout
+		}
+	}
+}
+
+// writeBlocksWithoutState fetches the receipts of the blocks from the peer,
+// verifies the blocks along with the receipts and writes them
+func (s *syncer) writeBlocksWithoutState(clt proto.StateSyncPeerClient, blocks []*types.Block) error {
+	hashes := make([][]byte, len(blocks))
+	for i, block := range blocks {
+		hashes[i] = block.Hash().Bytes()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), stateSyncRequestTimeout)
+	defer cancel()
+
+	resp, err := clt.GetReceipts(ctx, &proto.ReceiptsRequest{Hashes: hashes})
+	if err != nil {
+		return fmt.Errorf("failed to get receipts: %w", err)
+	}
+
+	if len(resp.Receipts) != len(blocks) {
+		return fmt.Errorf("%w: expected receipts of %d blocks, got %d",
+			errInvalidReceiptsResponse, len(blocks), len(resp.Receipts))
+	}
+
+	for i, block := range blocks {
+		var receipts types.Receipts
+		if err := receipts.UnmarshalRLP(resp.Receipts[i]); err != nil {
+			return fmt.Errorf("block %d: %w: %w", block.Number(), errInvalidReceiptsResponse, err)
+		}
+
+		fullBlock, err := s.blockchain.VerifyFinalizedBlockWithoutState(block, receipts)
+		if err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+			return fmt.Errorf("unable to verify block, %w", err)
+		}
+
+		if err := s.blockchain.WriteFullBlock(fullBlock, syncerName); err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+			return fmt.Errorf("failed to write block while syncing state: %w", err)
+		}
+
+		updateMetrics(fullBlock)
+	}
+
+	return nil
+}
+
+// newStateSyncPeerClient creates gRPC client of the state sync protocol
+func (s *syncer) newStateSyncPeerClient(peerID peer.ID) (proto.StateSyncPeerClient, error) {
+	conn, err := s.network.NewProtoConnection(stateSyncProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	s.network.SaveProtocolStream(stateSyncProto, conn, peerID)
+
+	return proto.NewStateSyncPeerClient(conn), nil
+}
+
 func updateMetrics(fullBlock *types.FullBlock) {
 	metrics.SetGauge([]string{syncerMetrics, "tx_num"}, float32(len(fullBlock.Block.Transactions)))
 	metrics.SetGauge([]string{syncerMetrics, "receipts_num"}, float32(len(fullBlock.Receipts)))
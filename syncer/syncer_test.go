@@ -40,8 +40,10 @@ type mockBlockchain struct {
 	headerHandler               func() *types.Header
 	getBlockByNumberHandler     func(uint64, bool) (*types.Block, bool)
 	verifyFinalizedBlockHandler func(*types.Block) (*types.FullBlock, error)
+	verifyWithoutStateHandler   func(*types.Block, []*types.Receipt) (*types.FullBlock, error)
 	writeBlockHandler           func(*types.Block) error
 	writeFullBlockHandler       func(*types.FullBlock) error
+	getReceiptsByHashHandler    func(types.Hash) ([]*types.Receipt, error)
 }
 
 func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
@@ -63,6 +65,10 @@ func (m *mockBlockchain) VerifyFinalizedBlock(b *types.Block) (*types.FullBlock,
 	return m.verifyFinalizedBlockHandler(b)
 }
 
+func (m *mockBlockchain) VerifyFinalizedBlockWithoutState(b *types.Block, r []*types.Receipt) (*types.FullBlock, error) {
+	return m.verifyWithoutStateHandler(b, r)
+}
+
 func (m *mockBlockchain) WriteBlock(b *types.Block, s string) error {
 	return m.writeBlockHandler(b)
 }
@@ -71,6 +77,10 @@ func (m *mockBlockchain) WriteFullBlock(b *types.FullBlock, s string) error {
 	return m.writeFullBlockHandler(b)
 }
 
+func (m *mockBlockchain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return m.getReceiptsByHashHandler(hash)
+}
+
 func newSimpleHeaderHandler(num uint64) func() *types.Header {
 	return func() *types.Header {
 		return &types.Header{
@@ -29,10 +29,14 @@ type Blockchain interface {
 	GetBlockByNumber(uint64, bool) (*types.Block, bool)
 	// VerifyFinalizedBlock verifies finalized block
 	VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error)
+	// VerifyFinalizedBlockWithoutState verifies finalized block and its receipts without executing its transactions
+	VerifyFinalizedBlockWithoutState(block *types.Block, receipts []*types.Receipt) (*types.FullBlock, error)
 	// WriteBlock writes a given block to chain
 	WriteBlock(*types.Block, string) error
 	// WriteFullBlock writes a given block to chain and saves its receipts to cache
 	WriteFullBlock(*types.FullBlock, string) error
+	// GetReceiptsByHash returns the receipts of the block with the given hash
+	GetReceiptsByHash(types.Hash) ([]*types.Receipt, error)
 }
 
 type Network interface {
@@ -50,7 +54,7 @@ type Network interface {
 	// and returns a reference to the connection
 	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
 	// NewTopic Creates New Topic for gossip
-	NewTopic(protoID string, obj proto.Message) (*network.Topic, error)
+	NewTopic(protoID string, obj proto.Message, validator network.TopicValidator) (*network.Topic, error)
 	// IsConnected returns the node is connecting to the peer associated with the given ID
 	IsConnected(peerID peer.ID) bool
 	// SaveProtocolStream saves stream
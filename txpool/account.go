@@ -268,6 +268,36 @@ func (a *account) reset(nonce uint64, promoteCh chan<- promoteRequest) (
 	return
 }
 
+// evict removes the transactions with nonce not lower than the given one from both queues,
+// since they can not be executed without the evicted transaction. If promoted transactions
+// are removed, the account's next nonce is rolled back to the given nonce
+func (a *account) evict(nonce uint64) (
+	evictedPromoted,
+	evictedEnqueued []*types.Transaction,
+) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+	a.nonceToTx.lock()
+
+	defer func() {
+		a.nonceToTx.unlock()
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	evictedPromoted = a.promoted.removeFrom(nonce)
+	evictedEnqueued = a.enqueued.removeFrom(nonce)
+
+	a.nonceToTx.remove(evictedPromoted...)
+	a.nonceToTx.remove(evictedEnqueued...)
+
+	if len(evictedPromoted) > 0 && nonce < a.getNonce() {
+		a.setNonce(nonce)
+	}
+
+	return
+}
+
 // enqueue push the transaction onto the enqueued queue or replace it
 func (a *account) enqueue(tx *types.Transaction, replace bool) {
 	replaceInQueue := func(queue minNonceQueue) bool {
@@ -0,0 +1,247 @@
+package txpool
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var errJournalClosed = errors.New("journal is closed")
+
+// txJournal is an append-only file of the local transactions, which are replayed
+// into the pool on startup, so they are not lost when the node restarts.
+//
+// Every entry of the journal is an RLP encoded transaction prefixed with its length:
+//
+//	| length (4 bytes, big endian) | RLP encoded transaction |
+type txJournal struct {
+	path   string
+	writer *os.File
+	lock   sync.Mutex
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{
+		path: path,
+	}
+}
+
+// load reads the transactions from the journal and passes them to the add function.
+// A truncated entry at the end of the journal (e.g. after a crash) is ignored.
+// It returns the number of read transactions and the number of rejected ones
+func (j *txJournal) load(add func(*types.Transaction) error) (int, int, error) {
+	file, err := os.Open(j.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, 0, nil
+		}
+
+		return 0, 0, err
+	}
+
+	defer file.Close()
+
+	var (
+		reader   = bufio.NewReader(file)
+		lenBuf   = make([]byte, 4)
+		total    = 0
+		rejected = 0
+	)
+
+	for {
+		if _, err := io.ReadFull(reader, lenBuf); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return total, rejected, nil
+			}
+
+			return total, rejected, err
+		}
+
+		raw := make([]byte, binary.BigEndian.Uint32(lenBuf))
+		if _, err := io.ReadFull(reader, raw); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return total, rejected, nil
+			}
+
+			return total, rejected, err
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return total, rejected, fmt.Errorf("failed to decode journal transaction: %w", err)
+		}
+
+		total++
+
+		if err := add(tx); err != nil {
+			rejected++
+		}
+	}
+}
+
+// insert appends the transaction to the journal
+func (j *txJournal) insert(tx *types.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return errJournalClosed
+	}
+
+	_, err := j.writer.Write(encodeJournalEntry(tx))
+
+	return err
+}
+
+// rotate rewrites the journal with the given transactions only (compaction),
+// and reopens it for appending
+func (j *txJournal) rotate(txs []*types.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+
+		j.writer = nil
+	}
+
+	tmpPath := j.path + ".new"
+
+	tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(tmp)
+
+	for _, tx := range txs {
+		if _, err := writer.Write(encodeJournalEntry(tx)); err != nil {
+			tmp.Close()
+
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+
+	return err
+}
+
+// close closes the journal file
+func (j *txJournal) close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+
+	err := j.writer.Close()
+	j.writer = nil
+
+	return err
+}
+
+// encodeJournalEntry encodes the transaction as a length prefixed journal entry
+func encodeJournalEntry(tx *types.Transaction) []byte {
+	raw := tx.MarshalRLP()
+
+	entry := make([]byte, 4, 4+len(raw))
+	binary.BigEndian.PutUint32(entry, uint32(len(raw))) //nolint:gosec
+
+	return append(entry, raw...)
+}
+
+// loadJournal replays the journaled local transactions into the pool. The transactions
+// with stale nonces are rejected by the pool, and the journal is compacted afterwards
+func (p *TxPool) loadJournal() {
+	total, rejected, err := p.journal.load(func(tx *types.Transaction) error {
+		if err := p.addTx(local, tx); err != nil {
+			return err
+		}
+
+		p.locals.add(tx)
+
+		return nil
+	})
+	if err != nil {
+		p.logger.Error("failed to load transaction journal", "err", err)
+	}
+
+	p.logger.Info("loaded transaction journal", "transactions", total, "dropped", rejected)
+
+	p.rotateJournal()
+}
+
+// journalTx records the local transaction in the journal
+func (p *TxPool) journalTx(tx *types.Transaction) {
+	if p.journal == nil {
+		return
+	}
+
+	p.locals.add(tx)
+
+	if err := p.journal.insert(tx); err != nil {
+		p.logger.Error("failed to journal local transaction", "hash", tx.Hash, "err", err)
+	}
+}
+
+// rotateJournal compacts the journal to the local transactions which are still in the pool
+func (p *TxPool) rotateJournal() {
+	locals := p.locals.list()
+	stale := make([]*types.Transaction, 0)
+	current := make([]*types.Transaction, 0, len(locals))
+
+	for _, tx := range locals {
+		if _, ok := p.index.get(tx.Hash); ok {
+			current = append(current, tx)
+		} else {
+			stale = append(stale, tx)
+		}
+	}
+
+	p.locals.remove(stale...)
+
+	// keep the nonce order of the transactions of an account, so they are replayed in order
+	sort.Slice(current, func(i, j int) bool {
+		if current[i].From != current[j].From {
+			return bytes.Compare(current[i].From.Bytes(), current[j].From.Bytes()) < 0
+		}
+
+		return current[i].Nonce < current[j].Nonce
+	})
+
+	if err := p.journal.rotate(current); err != nil {
+		p.logger.Error("failed to rotate transaction journal", "err", err)
+
+		return
+	}
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("rotated transaction journal", "transactions", len(current), "dropped", len(stale))
+	}
+}
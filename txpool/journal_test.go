@@ -0,0 +1,142 @@
+package txpool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// loadJournalTxs returns all the transactions of the journal
+func loadJournalTxs(t *testing.T, journal *txJournal) []*types.Transaction {
+	t.Helper()
+
+	txs := []*types.Transaction{}
+
+	_, _, err := journal.load(func(tx *types.Transaction) error {
+		txs = append(txs, tx)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return txs
+}
+
+func TestTxJournal(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+	journal := newTxJournal(path)
+
+	// missing journal is empty
+	require.Empty(t, loadJournalTxs(t, journal))
+
+	// insert fails until the journal is opened by the rotation
+	require.ErrorIs(t, journal.insert(newTx(addr1, 0, 1)), errJournalClosed)
+	require.NoError(t, journal.rotate(nil))
+
+	txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1), newTx(addr2, 0, 2)}
+	for _, tx := range txs {
+		require.NoError(t, journal.insert(tx))
+	}
+
+	require.NoError(t, journal.close())
+
+	loaded := loadJournalTxs(t, journal)
+	require.Len(t, loaded, len(txs))
+
+	for i, tx := range loaded {
+		assert.Equal(t, txs[i].Nonce, tx.Nonce)
+		assert.Equal(t, txs[i].Input, tx.Input)
+	}
+
+	// truncated entry at the end of the journal is ignored
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+
+	_, err = file.Write([]byte{0, 0, 0, 100, 1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	require.Len(t, loadJournalTxs(t, journal), len(txs))
+
+	// rotation compacts the journal to the given transactions
+	require.NoError(t, journal.rotate(txs[1:2]))
+	require.NoError(t, journal.close())
+
+	loaded = loadJournalTxs(t, journal)
+	require.Len(t, loaded, 1)
+	assert.Equal(t, txs[1].Input, loaded[0].Input)
+}
+
+func TestTxPool_Journal(t *testing.T) {
+	t.Parallel()
+
+	poolSigner := crypto.NewEIP155Signer(100, true)
+	key, addr := tests.GenerateKeyAndAddr(t)
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	newJournalPool := func(nonce uint64) *TxPool {
+		store := NewDefaultMockStore(mockHeader)
+		store.nonce = nonce
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			getDefaultEnabledForks(),
+			store,
+			nil,
+			nil,
+			&Config{
+				PriceLimit:         defaultPriceLimit,
+				MaxSlots:           defaultMaxSlots,
+				MaxAccountEnqueued: defaultMaxAccountEnqueued,
+				JournalPath:        path,
+			},
+		)
+		require.NoError(t, err)
+
+		pool.SetSigner(poolSigner)
+		pool.SetBaseFee(mockHeader)
+		pool.Start()
+
+		return pool
+	}
+
+	pool := newJournalPool(0)
+
+	txs := make([]*types.Transaction, 3)
+
+	for i := range txs {
+		tx, err := poolSigner.SignTx(newTx(addr, uint64(i), 1), key)
+		require.NoError(t, err)
+
+		require.NoError(t, pool.AddTx(tx))
+
+		txs[i] = tx
+	}
+
+	pool.Close()
+
+	// the first transaction was included in a block while the node was down
+	pool = newJournalPool(1)
+	defer pool.Close()
+
+	_, ok := pool.index.get(txs[0].Hash)
+	assert.False(t, ok)
+
+	for _, tx := range txs[1:] {
+		_, ok := pool.index.get(tx.Hash)
+		assert.True(t, ok)
+	}
+
+	// the stale transaction is pruned from the journal
+	require.Len(t, loadJournalTxs(t, pool.journal), 2)
+}
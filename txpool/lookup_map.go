@@ -49,3 +49,24 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// length returns the number of transactions in the map. [thread-safe]
+func (m *lookupMap) length() int {
+	m.RLock()
+	defer m.RUnlock()
+
+	return len(m.all)
+}
+
+// list returns all the transactions in the map. [thread-safe]
+func (m *lookupMap) list() []*types.Transaction {
+	m.RLock()
+	defer m.RUnlock()
+
+	txs := make([]*types.Transaction, 0, len(m.all))
+	for _, tx := range m.all {
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
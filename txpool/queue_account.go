@@ -60,6 +60,31 @@ func (q *accountQueue) prune(nonce uint64) (
 	return
 }
 
+// removeFrom removes all transactions from the queue
+// with nonce not lower than given.
+func (q *accountQueue) removeFrom(nonce uint64) (
+	removed []*types.Transaction,
+) {
+	kept := make(minNonceQueue, 0, len(q.queue))
+
+	for _, tx := range q.queue {
+		if tx.Nonce >= nonce {
+			removed = append(removed, tx)
+		} else {
+			kept = append(kept, tx)
+		}
+	}
+
+	if len(removed) == 0 {
+		return
+	}
+
+	q.queue = kept
+	heap.Init(&q.queue)
+
+	return
+}
+
 // clear removes all transactions from the queue.
 func (q *accountQueue) clear() (removed []*types.Transaction) {
 	// store txs
@@ -0,0 +1,172 @@
+package txpool
+
+import (
+	"container/heap"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// minPricedCompactThreshold is the minimal number of transactions in the min priced index
+	// before the transactions which are no longer in the pool are removed from it
+	minPricedCompactThreshold = 1024
+)
+
+// minPricedIndex keeps track of the gossiped (remote) transactions in the pool sorted by
+// price (ascending), so the cheapest ones can be evicted when the pool is full.
+// The local transactions are never evicted, they are only recorded, so the gossiped
+// transactions they depend on are not evicted either.
+//
+// The transactions removed from the pool are not removed from the index right away,
+// they are skipped when popped and dropped once the index grows too large
+type minPricedIndex struct {
+	lock    sync.Mutex
+	remotes *minPriceQueue
+	locals  map[types.Hash]*types.Transaction
+}
+
+func newMinPricedIndex() *minPricedIndex {
+	return &minPricedIndex{
+		remotes: &minPriceQueue{baseFee: new(big.Int)},
+		locals:  make(map[types.Hash]*types.Transaction),
+	}
+}
+
+// push adds the transaction to the index
+func (m *minPricedIndex) push(tx *types.Transaction, local bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if local {
+		m.locals[tx.Hash] = tx
+	} else {
+		heap.Push(m.remotes, tx)
+	}
+}
+
+// pop removes the cheapest remote transaction which is still in the pool from the index
+func (m *minPricedIndex) pop(baseFee uint64, inPool func(*types.Transaction) bool) *types.Transaction {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.remotes.setBaseFee(baseFee)
+
+	for m.remotes.Len() > 0 {
+		tx, _ := heap.Pop(m.remotes).(*types.Transaction)
+		if inPool(tx) {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+// isLocal checks if the transaction was added to the pool as a local one
+func (m *minPricedIndex) isLocal(tx *types.Transaction) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.locals[tx.Hash] == tx
+}
+
+// compact removes the transactions which are no longer in the pool from the index,
+// if the index holds more than twice as many transactions as the pool
+func (m *minPricedIndex) compact(poolSize int, inPool func(*types.Transaction) bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	size := m.remotes.Len() + len(m.locals)
+	if size < minPricedCompactThreshold || size <= 2*poolSize {
+		return
+	}
+
+	for hash, tx := range m.locals {
+		if !inPool(tx) {
+			delete(m.locals, hash)
+		}
+	}
+
+	live := m.remotes.txs[:0]
+
+	for _, tx := range m.remotes.txs {
+		if inPool(tx) {
+			live = append(live, tx)
+		}
+	}
+
+	// avoid memory leak
+	for i := len(live); i < len(m.remotes.txs); i++ {
+		m.remotes.txs[i] = nil
+	}
+
+	m.remotes.txs = live
+	heap.Init(m.remotes)
+}
+
+// length returns the number of transactions in the index, including the removed ones
+func (m *minPricedIndex) length() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.remotes.Len() + len(m.locals)
+}
+
+// transactions sorted by gas price (ascending)
+type minPriceQueue struct {
+	baseFee *big.Int
+	txs     []*types.Transaction
+}
+
+// setBaseFee updates the base fee used for sorting and restores the order if it changed
+func (q *minPriceQueue) setBaseFee(baseFee uint64) {
+	if q.baseFee.IsUint64() && q.baseFee.Uint64() == baseFee {
+		return
+	}
+
+	q.baseFee.SetUint64(baseFee)
+	heap.Init(q)
+}
+
+/* Queue methods required by the heap interface */
+
+func (q *minPriceQueue) Len() int {
+	return len(q.txs)
+}
+
+func (q *minPriceQueue) Swap(i, j int) {
+	q.txs[i], q.txs[j] = q.txs[j], q.txs[i]
+}
+
+func (q *minPriceQueue) Push(x interface{}) {
+	transaction, ok := x.(*types.Transaction)
+	if !ok {
+		return
+	}
+
+	q.txs = append(q.txs, transaction)
+}
+
+func (q *minPriceQueue) Pop() interface{} {
+	old := q.txs
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	q.txs = old[0 : n-1]
+
+	return x
+}
+
+// Less orders the transactions by price. For the same price, the transaction
+// with the higher nonce comes first, because it is the last one to be executed
+func (q *minPriceQueue) Less(i, j int) bool {
+	switch cmp(q.txs[i], q.txs[j], q.baseFee) {
+	case -1:
+		return true
+	case 1:
+		return false
+	default:
+		return q.txs[i].Nonce > q.txs[j].Nonce
+	}
+}
@@ -0,0 +1,105 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_minPricedIndex(t *testing.T) {
+	t.Parallel()
+
+	newPricedTx := func(price int64, nonce uint64) *types.Transaction {
+		return &types.Transaction{
+			Type:     types.LegacyTx,
+			GasPrice: big.NewInt(price),
+			Nonce:    nonce,
+			Hash:     types.BytesToHash(big.NewInt(price*1000 + int64(nonce)).Bytes()),
+		}
+	}
+
+	var (
+		remote1  = newPricedTx(10, 0)
+		remote2  = newPricedTx(30, 0)
+		remote3  = newPricedTx(20, 0)
+		remote4  = newPricedTx(10, 1)
+		local1   = newPricedTx(1, 0)
+		removed  = newPricedTx(5, 0)
+		inPool   = map[*types.Transaction]bool{remote1: true, remote2: true, remote3: true, remote4: true, local1: true}
+		isInPool = func(tx *types.Transaction) bool { return inPool[tx] }
+	)
+
+	index := newMinPricedIndex()
+
+	for _, tx := range []*types.Transaction{remote1, remote2, remote3, remote4, removed} {
+		index.push(tx, false)
+	}
+
+	index.push(local1, true)
+
+	// remote transactions are popped by ascending price and descending nonce,
+	// the removed transactions are skipped and the local ones are never popped
+	expected := []*types.Transaction{remote4, remote1, remote3, remote2}
+
+	for _, tx := range expected {
+		require.Equal(t, tx, index.pop(0, isInPool))
+	}
+
+	assert.Nil(t, index.pop(0, isInPool))
+
+	assert.True(t, index.isLocal(local1))
+	assert.False(t, index.isLocal(remote1))
+}
+
+func Test_minPricedIndex_Compact(t *testing.T) {
+	t.Parallel()
+
+	var (
+		index      = newMinPricedIndex()
+		live       = make(map[*types.Transaction]bool)
+		liveLocals = 0
+	)
+
+	for i := 0; i < 2*minPricedCompactThreshold; i++ {
+		tx := &types.Transaction{
+			GasPrice: big.NewInt(int64(i)),
+			Hash:     types.BytesToHash(big.NewInt(int64(i + 1)).Bytes()),
+		}
+
+		local := i%2 == 0
+
+		if i%3 == 0 {
+			live[tx] = true
+
+			if local {
+				liveLocals++
+			}
+		}
+
+		index.push(tx, local)
+	}
+
+	isInPool := func(tx *types.Transaction) bool { return live[tx] }
+
+	// the index is not compacted while it is not too large
+	index.compact(minPricedCompactThreshold, isInPool)
+	require.Equal(t, 2*minPricedCompactThreshold, index.length())
+
+	index.compact(len(live), isInPool)
+	require.Equal(t, len(live), index.length())
+
+	// the order is kept after the compaction
+	prev := index.pop(0, isInPool)
+
+	for tx := index.pop(0, isInPool); tx != nil; tx = index.pop(0, isInPool) {
+		require.True(t, prev.GasPrice.Cmp(tx.GasPrice) < 0)
+
+		prev = tx
+	}
+
+	// only the local transactions are left
+	require.Equal(t, liveLocals, index.length())
+}
@@ -45,6 +45,34 @@ func (q *pricedQueue) pop() *types.Transaction {
 	return transaction
 }
 
+// remove removes the given transactions from the queue.
+func (q *pricedQueue) remove(txs ...*types.Transaction) {
+	removed := make(map[types.Hash]struct{}, len(txs))
+	for _, tx := range txs {
+		removed[tx.Hash] = struct{}{}
+	}
+
+	kept := q.queue.txs[:0]
+
+	for _, tx := range q.queue.txs {
+		if _, ok := removed[tx.Hash]; !ok {
+			kept = append(kept, tx)
+		}
+	}
+
+	if len(kept) == len(q.queue.txs) {
+		return
+	}
+
+	// avoid memory leak
+	for i := len(kept); i < len(q.queue.txs); i++ {
+		q.queue.txs[i] = nil
+	}
+
+	q.queue.txs = kept
+	heap.Init(q.queue)
+}
+
 // length returns the number of transactions in the queue.
 func (q *pricedQueue) length() int {
 	return q.queue.Len()
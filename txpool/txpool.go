@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
@@ -37,6 +39,9 @@ const (
 
 	pruningCooldown = 5000 * time.Millisecond
 
+	// DefaultJournalRotation is the default interval of the local transactions journal compaction
+	DefaultJournalRotation = time.Hour
+
 	// txPoolMetrics is a prefix used for txpool-related metrics
 	txPoolMetrics = "txpool"
 )
@@ -65,6 +70,7 @@ var (
 	ErrNonceExistsInPool       = errors.New("tx with the same nonce is already present")
 	ErrReplacementUnderpriced  = errors.New("replacement tx underpriced")
 	ErrDynamicTxNotAllowed     = errors.New("dynamic tx not allowed currently")
+	ErrAccessListTxNotAllowed  = errors.New("access list tx not allowed currently")
 )
 
 // indicates origin of a transaction
@@ -104,6 +110,11 @@ type Config struct {
 	MaxSlots           uint64
 	MaxAccountEnqueued uint64
 	ChainID            *big.Int
+
+	// JournalPath is the path of the local transactions journal (empty disables the journal)
+	JournalPath string
+	// JournalRotation is the interval of the journal compaction
+	JournalRotation time.Duration
 }
 
 /* All requests are passed to the main loop
@@ -145,15 +156,25 @@ type promoteRequest struct {
 // ready to be written to the state (primaries).
 type TxPool struct {
 	logger hclog.Logger
-	signer signer
 	forks  *chain.Forks
 	store  store
 
+	// signer is set after the gossip topic is subscribed,
+	// so it is guarded from the gossip validation workers
+	signer     signer
+	signerLock sync.RWMutex
+
 	// map of all accounts registered by the pool
 	accounts accountsMap
 
 	// all the primaries sorted by max gas price
 	executables *pricedQueue
+	// executablesLock guards the executables, since the evicted transactions are removed
+	// from them while a block is being built
+	executablesLock sync.Mutex
+
+	// the gossiped transactions sorted by min gas price, used for eviction
+	minPriced *minPricedIndex
 
 	// lookup map keeping track of all
 	// transactions present in the pool
@@ -196,6 +217,13 @@ type TxPool struct {
 
 	// chain id
 	chainID *big.Int
+
+	// journal of the local transactions, nil if disabled
+	journal *txJournal
+	// journalRotation is the interval of the journal compaction
+	journalRotation time.Duration
+	// locals keeps track of the local transactions written to the journal
+	locals lookupMap
 }
 
 // NewTxPool returns a new pool for processing incoming transactions.
@@ -212,11 +240,13 @@ func NewTxPool(
 		forks:       forks,
 		store:       store,
 		executables: newPricesQueue(0, nil),
+		minPriced:   newMinPricedIndex(),
 		accounts:    accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
 		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
 		chainID:     config.ChainID,
+		locals:      lookupMap{all: make(map[types.Hash]*types.Transaction)},
 
 		//	main loop channels
 		promoteReqCh: make(chan promoteRequest),
@@ -224,12 +254,21 @@ func NewTxPool(
 		shutdownCh:   make(chan struct{}),
 	}
 
+	if config.JournalPath != "" {
+		pool.journal = newTxJournal(config.JournalPath)
+
+		pool.journalRotation = config.JournalRotation
+		if pool.journalRotation == 0 {
+			pool.journalRotation = DefaultJournalRotation
+		}
+	}
+
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
 	if network != nil {
 		// subscribe to the gossip protocol
-		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
+		topic, err := network.NewTopic(topicNameV1, &proto.Txn{}, pool.validateGossipTx)
 		if err != nil {
 			return nil, err
 		}
@@ -276,6 +315,25 @@ func (p *TxPool) Start() {
 		}
 	}()
 
+	//	replay the local transactions and compact the journal periodically
+	if p.journal != nil {
+		p.loadJournal()
+
+		go func() {
+			ticker := time.NewTicker(p.journalRotation)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-p.shutdownCh:
+					return
+				case <-ticker.C:
+					p.rotateJournal()
+				}
+			}
+		}()
+	}
+
 	//	run the handler for the tx pipeline
 	go func() {
 		for {
@@ -293,14 +351,31 @@ func (p *TxPool) Start() {
 func (p *TxPool) Close() {
 	p.eventManager.Close()
 	close(p.shutdownCh)
+
+	if p.journal != nil {
+		if err := p.journal.close(); err != nil {
+			p.logger.Error("failed to close transaction journal", "err", err)
+		}
+	}
 }
 
 // SetSigner sets the signer the pool will use
 // to validate a transaction's signature.
 func (p *TxPool) SetSigner(s signer) {
+	p.signerLock.Lock()
+	defer p.signerLock.Unlock()
+
 	p.signer = s
 }
 
+// getSigner returns the signer of the pool, which is nil until it is set
+func (p *TxPool) getSigner() signer {
+	p.signerLock.RLock()
+	defer p.signerLock.RUnlock()
+
+	return p.signer
+}
+
 // SetSealing sets the sealing flag
 func (p *TxPool) SetSealing(sealing bool) {
 	p.sealing.CompareAndSwap(p.sealing.Load(), sealing)
@@ -315,6 +390,8 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		return err
 	}
 
+	p.journalTx(tx)
+
 	// broadcast the transaction only if a topic
 	// subscription is present
 	if p.topic != nil {
@@ -338,6 +415,9 @@ func (p *TxPool) Prepare() {
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
+	p.executablesLock.Lock()
+	defer p.executablesLock.Unlock()
+
 	// create new executables queue with base fee and initial transactions (primaries)
 	p.executables = newPricesQueue(p.GetBaseFee(), primaries)
 }
@@ -345,6 +425,9 @@ func (p *TxPool) Prepare() {
 // Peek returns the best-price selected
 // transaction ready for execution.
 func (p *TxPool) Peek() *types.Transaction {
+	p.executablesLock.Lock()
+	defer p.executablesLock.Unlock()
+
 	// Popping the executables queue
 	// does not remove the actual tx
 	// from the pool.
@@ -370,6 +453,11 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 		account.promoted.unlock()
 	}()
 
+	// the transaction could have been evicted in the meantime
+	if head := account.promoted.peek(); head == nil || head.Hash != tx.Hash {
+		return
+	}
+
 	// pop the top most promoted tx
 	account.promoted.pop()
 
@@ -387,7 +475,9 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 
 	// update executables
 	if tx := account.promoted.peek(); tx != nil {
+		p.executablesLock.Lock()
 		p.executables.push(tx)
+		p.executablesLock.Unlock()
 	}
 }
 
@@ -514,7 +604,7 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 			addr := tx.From
 			if addr == types.ZeroAddress {
 				// From field is not set, extract the signer
-				if addr, err = p.signer.Sender(tx); err != nil {
+				if addr, err = p.getSigner().Sender(tx); err != nil {
 					p.logger.Error(
 						fmt.Sprintf("unable to extract signer for transaction, %v", err),
 					)
@@ -578,7 +668,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	// Check if the transaction is signed properly
 
 	// Extract the sender
-	from, signerErr := p.signer.Sender(tx)
+	from, signerErr := p.getSigner().Sender(tx)
 	if signerErr != nil {
 		metrics.IncrCounter([]string{txPoolMetrics, "invalid_signature_txs"}, 1)
 
@@ -618,6 +708,23 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	latestBlockGasLimit := currentHeader.GasLimit
 	baseFee := p.GetBaseFee() // base fee is calculated for the next block
 
+	// Reject access list tx and access lists of other typed txs if berlin hardfork is not enabled
+	if !forks.Berlin && (tx.Type == types.AccessListTx || len(tx.AccessList) > 0) {
+		metrics.IncrCounter([]string{txPoolMetrics, "tx_type"}, 1)
+
+		return fmt.Errorf("%w: type %d rejected, berlin hardfork is not enabled", ErrTxTypeNotSupported, tx.Type)
+	}
+
+	if tx.Type == types.AccessListTx {
+		// AccessListTx should be rejected if TxHashWithType fork is registered but not enabled for current block
+		blockNumber, err := forkmanager.GetInstance().GetForkBlock(chain.TxHashWithType)
+		if err == nil && blockNumber > currentBlockNumber {
+			metrics.IncrCounter([]string{txPoolMetrics, "access_list_tx_not_allowed"}, 1)
+
+			return ErrAccessListTxNotAllowed
+		}
+	}
+
 	if tx.Type == types.DynamicFeeTx {
 		// Reject dynamic fee tx if london hardfork is not enabled
 		if !forks.London {
@@ -703,7 +810,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul, forks.Berlin)
 	if err != nil {
 		metrics.IncrCounter([]string{txPoolMetrics, "invalid_intrinsic_gas_tx"}, 1)
 
@@ -778,8 +885,8 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		return err
 	}
 
-	// add chainID to the tx - only dynamic fee tx
-	if tx.Type == types.DynamicFeeTx {
+	// add chainID to the tx - only typed txs
+	if tx.Type == types.DynamicFeeTx || tx.Type == types.AccessListTx {
 		tx.ChainID = p.chainID
 	}
 
@@ -789,6 +896,12 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	// initialize account for this address once or retrieve existing one
 	account := p.getOrCreateAccount(tx.From)
 
+	// when the pool is full, make room for the transaction by evicting cheaper ones.
+	// Future transactions are rejected under high pressure, so they don't evict anything
+	if slots := slotsRequired(tx); p.gauge.freeSlots() < slots && tx.Nonce <= account.getNonce() {
+		p.evict(tx, slots)
+	}
+
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
 	account.nonceToTx.lock()
@@ -877,11 +990,126 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 
 	account.enqueue(tx, oldTxWithSameNonce != nil) // add or replace tx into account
 
+	p.minPriced.push(tx, origin == local)
+	p.minPriced.compact(p.index.length(), p.isInPool)
+
 	go p.invokePromotion(tx, tx.Nonce <= accountNonce) // don't signal promotion for higher nonce txs
 
 	return nil
 }
 
+// evict frees the given number of slots by evicting the cheapest gossiped transactions,
+// which pay a lower effective tip than the given transaction. The local transactions
+// are never evicted. Returns false if not enough slots could be freed
+func (p *TxPool) evict(tx *types.Transaction, slots uint64) bool {
+	baseFeeValue := p.GetBaseFee()
+	baseFee := new(big.Int).SetUint64(baseFeeValue)
+
+	// the transactions of the sender are not evicted to make room for its own transaction,
+	// so they are returned to the index afterwards. The same goes for the transactions
+	// followed by local ones, which can not be executed without them
+	skipped := make([]*types.Transaction, 0)
+
+	defer func() {
+		for _, s := range skipped {
+			p.minPriced.push(s, false)
+		}
+	}()
+
+	for p.gauge.freeSlots() < slots {
+		victim := p.minPriced.pop(baseFeeValue, p.isInPool)
+		if victim == nil {
+			return false
+		}
+
+		if cmp(victim, tx, baseFee) >= 0 {
+			// the cheapest transaction is not cheaper than the new one
+			skipped = append(skipped, victim)
+
+			return false
+		}
+
+		if victim.From == tx.From || p.hasLocalTxsFrom(victim) {
+			skipped = append(skipped, victim)
+
+			continue
+		}
+
+		p.evictAccountTxs(victim)
+	}
+
+	return true
+}
+
+// hasLocalTxsFrom checks if the account of the given transaction has local transactions
+// with nonce not lower than the transaction's one, which would be evicted along with it
+func (p *TxPool) hasLocalTxsFrom(victim *types.Transaction) bool {
+	account := p.accounts.get(victim.From)
+	if account == nil {
+		return false
+	}
+
+	account.nonceToTx.lock()
+	defer account.nonceToTx.unlock()
+
+	for nonce, tx := range account.nonceToTx.mapping {
+		if nonce >= victim.Nonce && p.minPriced.isLocal(tx) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evictAccountTxs evicts the transaction and all the transactions
+// of the same account with higher nonces from the pool
+func (p *TxPool) evictAccountTxs(victim *types.Transaction) {
+	account := p.accounts.get(victim.From)
+	if account == nil {
+		return
+	}
+
+	evictedPromoted, evictedEnqueued := account.evict(victim.Nonce)
+	evicted := append(evictedPromoted, evictedEnqueued...)
+
+	if len(evicted) == 0 {
+		return
+	}
+
+	if len(evictedPromoted) > 0 {
+		// the primary of the account could be among the executables of the block being built
+		p.executablesLock.Lock()
+		p.executables.remove(evictedPromoted...)
+		p.executablesLock.Unlock()
+	}
+
+	slots := slotsRequired(evicted...)
+
+	p.index.remove(evicted...)
+	p.gauge.decrease(slots)
+	p.updatePending(-1 * int64(len(evictedPromoted)))
+
+	metrics.IncrCounter([]string{txPoolMetrics, "evicted_tx"}, float32(len(evicted)))
+	metrics.IncrCounter([]string{txPoolMetrics, "evicted_slots"}, float32(slots))
+
+	p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(evicted...)...)
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("evicted account txs",
+			"num", len(evicted),
+			"from_nonce", victim.Nonce,
+			"address", victim.From.String(),
+		)
+	}
+}
+
+// isInPool returns true if the given transaction is still in the pool
+func (p *TxPool) isInPool(tx *types.Transaction) bool {
+	current, ok := p.index.get(tx.Hash)
+
+	return ok && current == tx
+}
+
 func (p *TxPool) invokePromotion(tx *types.Transaction, callPromote bool) {
 	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
 
@@ -921,6 +1149,44 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 	p.eventManager.signalEvent(proto.EventType_PROMOTED, toHash(promoted...)...)
 }
 
+// validateGossipTx runs the cheap checks on the gossiped transaction before it is relayed to the other peers.
+// The transaction is decoded, and its size, type and signature are checked.
+// The transactions received before the signer is set are ignored
+func (p *TxPool) validateGossipTx(obj protobuf.Message, _ peer.ID) error {
+	raw, ok := obj.(*proto.Txn)
+	if !ok {
+		return errors.New("failed to cast gossiped message to txn")
+	}
+
+	if raw.Raw == nil {
+		return errors.New("malformed gossip transaction message")
+	}
+
+	if uint64(len(raw.Raw.Value)) > txMaxSize {
+		return ErrOversizedData
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalRLP(raw.Raw.Value); err != nil {
+		return fmt.Errorf("failed to decode broadcast tx: %w", err)
+	}
+
+	if tx.Type == types.StateTx {
+		return ErrInvalidTxType
+	}
+
+	signer := p.getSigner()
+	if signer == nil {
+		return network.ErrIgnoreMessage
+	}
+
+	if _, err := signer.Sender(tx); err != nil {
+		return ErrExtractSignature
+	}
+
+	return nil
+}
+
 // addGossipTx handles receiving transactions
 // gossiped by the network.
 func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
@@ -20,6 +20,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -175,6 +176,43 @@ func TestAddTxErrors(t *testing.T) {
 		)
 	})
 
+	t.Run("ErrTxTypeNotSupported Berlin hardfork not enabled", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.forks.RemoveFork(chain.Berlin)
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.AccessListTx
+
+		err := pool.addTx(local, signTx(tx))
+
+		assert.ErrorContains(t,
+			err,
+			ErrTxTypeNotSupported.Error(),
+		)
+		assert.ErrorContains(t,
+			err,
+			"berlin hardfork is not enabled",
+		)
+	})
+
+	t.Run("AccessListTx accepted with Berlin hardfork", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.SetSigner(crypto.NewBerlinSigner(100, true, poolSigner))
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.AccessListTx
+		tx.AccessList = types.TxAccessList{
+			{Address: addr1, StorageKeys: []types.Hash{types.StringToHash("1")}},
+		}
+
+		signedTx, err := crypto.NewBerlinSigner(100, true, poolSigner).SignTx(tx, defaultKey)
+		require.NoError(t, err)
+
+		require.NoError(t, pool.addTx(local, signedTx))
+	})
+
 	t.Run("ErrNegativeValue", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
@@ -637,6 +675,82 @@ func TestAddGossipTx(t *testing.T) {
 	})
 }
 
+func TestValidateGossipTx(t *testing.T) {
+	t.Parallel()
+
+	key, _ := tests.GenerateKeyAndAddr(t)
+	signer := crypto.NewEIP155Signer(100, true)
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(signer)
+
+	signedTx, err := signer.SignTx(newTx(types.ZeroAddress, 1, 1), key)
+	require.NoError(t, err)
+
+	stateTx := newTx(types.ZeroAddress, 1, 1)
+	stateTx.Type = types.StateTx
+
+	testTable := []struct {
+		name          string
+		raw           []byte
+		expectedError error
+	}{
+		{
+			"signed transaction",
+			signedTx.MarshalRLP(),
+			nil,
+		},
+		{
+			"unsigned transaction",
+			newTx(types.ZeroAddress, 1, 1).MarshalRLP(),
+			ErrExtractSignature,
+		},
+		{
+			"state transaction",
+			stateTx.MarshalRLP(),
+			ErrInvalidTxType,
+		},
+		{
+			"oversized transaction",
+			make([]byte, txMaxSize+1),
+			ErrOversizedData,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := pool.validateGossipTx(&proto.Txn{Raw: &any.Any{Value: testCase.raw}}, "")
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+
+	t.Run("malformed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		require.Error(t, pool.validateGossipTx(&proto.Txn{}, ""))
+		require.Error(t, pool.validateGossipTx(&proto.Txn{Raw: &any.Any{Value: []byte{0x1}}}, ""))
+	})
+
+	t.Run("signer not set", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+
+		err = pool.validateGossipTx(&proto.Txn{Raw: &any.Any{Value: signedTx.MarshalRLP()}}, "")
+		require.ErrorIs(t, err, network.ErrIgnoreMessage)
+	})
+}
+
 func TestDropKnownGossipTx(t *testing.T) {
 	t.Parallel()
 
@@ -3725,12 +3839,147 @@ func TestAddTx_TxReplacement(t *testing.T) {
 	assert.Equal(t, ac2.enqueued.queue[0], tx1)
 }
 
+func TestAddTx_Eviction(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPoolWithSlots(3)
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+	defer pool.Close()
+
+	newPricedTx := func(addr types.Address, nonce uint64, priceDelta int64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(defaultPriceLimit + uint64(priceDelta))
+
+		return tx
+	}
+
+	requireInPool := func(t *testing.T, expected bool, txs ...*types.Transaction) {
+		t.Helper()
+
+		for _, tx := range txs {
+			_, ok := pool.index.get(tx.Hash)
+			require.Equal(t, expected, ok, "nonce %d of %s", tx.Nonce, tx.From)
+		}
+	}
+
+	// fill the pool with the gossiped transactions
+	cheap0 := newPricedTx(addr1, 0, 1)
+	cheap1 := newPricedTx(addr1, 1, 1)
+	medium := newPricedTx(addr2, 0, 2)
+
+	for _, tx := range []*types.Transaction{cheap0, cheap1, medium} {
+		require.NoError(t, pool.addTx(gossip, tx))
+	}
+
+	require.Equal(t, uint64(3), pool.gauge.read())
+
+	// the transaction is not more expensive than the cheapest one
+	require.ErrorIs(t, pool.addTx(local, newPricedTx(addr3, 0, 1)), ErrTxPoolOverflow)
+
+	// the future transactions don't evict anything
+	require.ErrorIs(t, pool.addTx(local, newPricedTx(addr3, 5, 100)), ErrRejectFutureTx)
+
+	// the cheapest transaction with the highest nonce is evicted first
+	local1 := newPricedTx(addr3, 0, 3)
+	require.NoError(t, pool.addTx(local, local1))
+	requireInPool(t, false, cheap1)
+	requireInPool(t, true, cheap0, medium, local1)
+
+	// evicting a transaction evicts the following ones of the same account
+	local2 := newPricedTx(addr4, 0, 3)
+	require.NoError(t, pool.addTx(local, local2))
+	requireInPool(t, false, cheap0)
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length()+pool.accounts.get(addr1).enqueued.length())
+
+	remote := newPricedTx(addr5, 0, 4)
+	require.NoError(t, pool.addTx(gossip, remote))
+	requireInPool(t, false, medium)
+
+	// the gossiped transaction is evicted before the cheaper local ones
+	replacement := newPricedTx(addr1, 0, 5)
+	require.NoError(t, pool.addTx(gossip, replacement))
+	requireInPool(t, false, remote)
+	requireInPool(t, true, local1, local2, replacement)
+	assert.Equal(t, uint64(3), pool.gauge.read())
+}
+
+func TestAddTx_EvictionKeepsLocals(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPoolWithSlots(3)
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+	defer pool.Close()
+
+	newPricedTx := func(addr types.Address, nonce uint64, priceDelta int64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(defaultPriceLimit + uint64(priceDelta))
+
+		return tx
+	}
+
+	// the gossiped transaction is followed by a local one of the same account
+	remote := newPricedTx(addr1, 0, 1)
+	local1 := newPricedTx(addr1, 1, 1)
+	local2 := newPricedTx(addr2, 0, 1)
+
+	require.NoError(t, pool.addTx(gossip, remote))
+	require.NoError(t, pool.addTx(local, local1))
+	require.NoError(t, pool.addTx(local, local2))
+
+	require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr3, 0, 100)), ErrTxPoolOverflow)
+
+	for _, tx := range []*types.Transaction{remote, local1, local2} {
+		_, ok := pool.index.get(tx.Hash)
+		require.True(t, ok, "nonce %d of %s", tx.Nonce, tx.From)
+	}
+}
+
+func TestAddTx_EvictionRemovesExecutables(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPoolWithSlots(2)
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+	defer pool.Close()
+
+	newPricedTx := func(addr types.Address, nonce uint64, priceDelta int64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(defaultPriceLimit + uint64(priceDelta))
+
+		return tx
+	}
+
+	cheap := newPricedTx(addr1, 0, 1)
+	medium := newPricedTx(addr2, 0, 2)
+
+	for _, tx := range []*types.Transaction{cheap, medium} {
+		require.NoError(t, pool.addTx(gossip, tx))
+		pool.handlePromoteRequest(promoteRequest{account: tx.From})
+	}
+
+	// the block building starts with both transactions executable
+	pool.Prepare()
+
+	require.NoError(t, pool.addTx(gossip, newPricedTx(addr3, 0, 3)))
+
+	// the evicted transaction is removed from the promoted queue and the executables
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+	assert.Equal(t, medium, pool.Peek())
+	assert.Nil(t, pool.Peek())
+}
+
 // getDefaultEnabledForks returns hardcoded set of forks
 // that are enabled by default from the genesis block
 func getDefaultEnabledForks() *chain.Forks {
 	return &chain.Forks{
 		chain.Homestead: chain.NewFork(0),
 		chain.Istanbul:  chain.NewFork(0),
+		chain.Berlin:    chain.NewFork(0),
 		chain.London:    chain.NewFork(0),
 	}
 }
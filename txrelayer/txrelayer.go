@@ -38,6 +38,8 @@ type TxRelayer interface {
 	Call(from ethgo.Address, to ethgo.Address, input []byte) (string, error)
 	// SendTransaction signs given transaction by provided key and sends it to the blockchain
 	SendTransaction(txn *ethgo.Transaction, key ethgo.Key) (*ethgo.Receipt, error)
+	// SendRawTransaction sends the signed (RLP encoded) transaction to the blockchain
+	SendRawTransaction(data []byte) (*ethgo.Receipt, error)
 	// SendTransactionLocal sends non-signed transaction
 	// (this function is meant only for testing purposes and is about to be removed at some point)
 	SendTransactionLocal(txn *ethgo.Transaction) (*ethgo.Receipt, error)
@@ -45,6 +47,12 @@ type TxRelayer interface {
 	Client() *jsonrpc.Client
 }
 
+// TxSigner is implemented by the keys which sign the whole transaction instead of its signing hash
+// (e.g. the validator key held by the remote signer, which computes the signing hash itself)
+type TxSigner interface {
+	SignTx(txn *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error)
+}
+
 var _ TxRelayer = (*TxRelayerImpl)(nil)
 
 type TxRelayerImpl struct {
@@ -115,6 +123,16 @@ func (t *TxRelayerImpl) SendTransaction(txn *ethgo.Transaction, key ethgo.Key) (
 	return t.waitForReceipt(txnHash)
 }
 
+// SendRawTransaction sends the signed (RLP encoded) transaction to the blockchain
+func (t *TxRelayerImpl) SendRawTransaction(data []byte) (*ethgo.Receipt, error) {
+	txnHash, err := t.client.Eth().SendRawTransaction(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.waitForReceipt(txnHash)
+}
+
 // Client returns jsonrpc client
 func (t *TxRelayerImpl) Client() *jsonrpc.Client {
 	return t.client
@@ -188,8 +206,13 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 		txn.Gas = gasLimit + (gasLimit * gasLimitIncreasePercentage / 100)
 	}
 
-	signer := wallet.NewEIP155Signer(chainID.Uint64())
-	if txn, err = signer.SignTx(txn, key); err != nil {
+	if txSigner, ok := key.(TxSigner); ok {
+		txn, err = txSigner.SignTx(txn, chainID.Uint64())
+	} else {
+		txn, err = wallet.NewEIP155Signer(chainID.Uint64()).SignTx(txn, key)
+	}
+
+	if err != nil {
 		return ethgo.ZeroHash, err
 	}
 
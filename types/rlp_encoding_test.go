@@ -150,11 +150,16 @@ func TestRLPMarshall_And_Unmarshall_TypedTransaction(t *testing.T) {
 		V:         big.NewInt(25),
 		S:         big.NewInt(26),
 		R:         big.NewInt(27),
+		AccessList: TxAccessList{
+			{Address: addrTo, StorageKeys: []Hash{StringToHash("1"), StringToHash("2")}},
+			{Address: addrFrom, StorageKeys: []Hash{}},
+		},
 	}
 
 	txTypes := []TxType{
 		StateTx,
 		LegacyTx,
+		AccessListTx,
 		DynamicFeeTx,
 	}
 
@@ -171,6 +176,10 @@ func TestRLPMarshall_And_Unmarshall_TypedTransaction(t *testing.T) {
 			unmarshalledTx.ComputeHash(1)
 			assert.Equal(t, originalTx.Type, unmarshalledTx.Type)
 			assert.Equal(t, originalTx.Hash, unmarshalledTx.Hash)
+
+			if v == AccessListTx || v == DynamicFeeTx {
+				assert.Equal(t, originalTx.AccessList, unmarshalledTx.AccessList)
+			}
 		})
 	}
 }
@@ -181,11 +190,13 @@ func TestRLPMarshall_Unmarshall_Missing_Data(t *testing.T) {
 	txTypes := []TxType{
 		StateTx,
 		LegacyTx,
+		AccessListTx,
 		DynamicFeeTx,
 	}
 
 	for _, txType := range txTypes {
 		txType := txType
+		isTyped := txType == DynamicFeeTx || txType == AccessListTx
 		testTable := []struct {
 			name          string
 			expectedErr   bool
@@ -204,11 +215,11 @@ func TestRLPMarshall_Unmarshall_Missing_Data(t *testing.T) {
 				name:        fmt.Sprintf("[%s] Missing From", txType),
 				expectedErr: false,
 				omittedValues: map[string]bool{
-					"ChainID":    txType != DynamicFeeTx,
+					"ChainID":    !isTyped,
 					"GasTipCap":  txType != DynamicFeeTx,
 					"GasFeeCap":  txType != DynamicFeeTx,
 					"GasPrice":   txType == DynamicFeeTx,
-					"AccessList": txType != DynamicFeeTx,
+					"AccessList": !isTyped,
 					"From":       txType != StateTx,
 				},
 				fromAddrSet: txType == StateTx,
@@ -217,11 +228,11 @@ func TestRLPMarshall_Unmarshall_Missing_Data(t *testing.T) {
 				name:        fmt.Sprintf("[%s] Address set for state tx only", txType),
 				expectedErr: false,
 				omittedValues: map[string]bool{
-					"ChainID":    txType != DynamicFeeTx,
+					"ChainID":    !isTyped,
 					"GasTipCap":  txType != DynamicFeeTx,
 					"GasFeeCap":  txType != DynamicFeeTx,
 					"GasPrice":   txType == DynamicFeeTx,
-					"AccessList": txType != DynamicFeeTx,
+					"AccessList": !isTyped,
 					"From":       txType != StateTx,
 				},
 				fromAddrSet: txType == StateTx,
@@ -270,6 +281,10 @@ func TestRLPMarshall_And_Unmarshall_TxType(t *testing.T) {
 			name:   "LegacyTx",
 			txType: LegacyTx,
 		},
+		{
+			name:   "AccessListTx",
+			txType: AccessListTx,
+		},
 		{
 			name:   "DynamicFeeTx",
 			txType: DynamicFeeTx,
@@ -193,7 +193,8 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv := arena.NewArray()
 
 	// Check Transaction1559Payload there https://eips.ethereum.org/EIPS/eip-1559#specification
-	if t.Type == DynamicFeeTx {
+	// and TransactionPayload there https://eips.ethereum.org/EIPS/eip-2930#specification
+	if t.Type == DynamicFeeTx || t.Type == AccessListTx {
 		vv.Set(arena.NewBigInt(t.ChainID))
 	}
 
@@ -221,11 +222,9 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewCopyBytes(t.Input))
 
 	// Specify access list as per spec.
-	// This is needed to have the same format as other EVM chains do.
-	// There is no access list feature here, so it is always empty just to be compatible.
 	// Check Transaction1559Payload there https://eips.ethereum.org/EIPS/eip-1559#specification
-	if t.Type == DynamicFeeTx {
-		vv.Set(arena.NewArray())
+	if t.Type == DynamicFeeTx || t.Type == AccessListTx {
+		vv.Set(t.AccessList.MarshalRLPWith(arena))
 	}
 
 	// signature values
@@ -239,3 +238,23 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 
 	return vv
 }
+
+// MarshalRLPWith marshals the access list to RLP with a specific fastrlp.Arena
+func (al TxAccessList) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	vv := arena.NewArray()
+
+	for _, tuple := range al {
+		tupleVal := arena.NewArray()
+		tupleVal.Set(arena.NewCopyBytes(tuple.Address.Bytes()))
+
+		keys := arena.NewArray()
+		for _, key := range tuple.StorageKeys {
+			keys.Set(arena.NewCopyBytes(key.Bytes()))
+		}
+
+		tupleVal.Set(keys)
+		vv.Set(tupleVal)
+	}
+
+	return vv
+}
@@ -19,6 +19,7 @@ type TxType byte
 // List of supported transaction types
 const (
 	LegacyTx     TxType = 0x0
+	AccessListTx TxType = 0x01
 	StateTx      TxType = 0x7f
 	DynamicFeeTx TxType = 0x02
 )
@@ -27,7 +28,7 @@ func txTypeFromByte(b byte) (TxType, error) {
 	tt := TxType(b)
 
 	switch tt {
-	case LegacyTx, StateTx, DynamicFeeTx:
+	case LegacyTx, AccessListTx, StateTx, DynamicFeeTx:
 		return tt, nil
 	default:
 		return tt, fmt.Errorf("unknown transaction type: %d", b)
@@ -39,6 +40,8 @@ func (t TxType) String() (s string) {
 	switch t {
 	case LegacyTx:
 		return "LegacyTx"
+	case AccessListTx:
+		return "AccessListTx"
 	case StateTx:
 		return "StateTx"
 	case DynamicFeeTx:
@@ -65,10 +68,51 @@ type Transaction struct {
 
 	ChainID *big.Int
 
+	// AccessList is the list of the addresses and storage keys
+	// the transaction plans to access (EIP-2930)
+	AccessList TxAccessList
+
 	// Cache
 	size atomic.Pointer[uint64]
 }
 
+// AccessTuple is the element type of an access list
+type AccessTuple struct {
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
+}
+
+// TxAccessList is the list of the addresses and storage keys
+// accessed by the transaction (EIP-2930)
+type TxAccessList []AccessTuple
+
+// StorageKeys returns the total number of storage keys in the access list
+func (al TxAccessList) StorageKeys() int {
+	sum := 0
+	for _, tuple := range al {
+		sum += len(tuple.StorageKeys)
+	}
+
+	return sum
+}
+
+// Copy returns a deep copy of the access list
+func (al TxAccessList) Copy() TxAccessList {
+	if al == nil {
+		return nil
+	}
+
+	cp := make(TxAccessList, len(al))
+	for i, tuple := range al {
+		cp[i] = AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]Hash{}, tuple.StorageKeys...),
+		}
+	}
+
+	return cp
+}
+
 // IsContractCreation checks if tx is contract creation
 func (t *Transaction) IsContractCreation() bool {
 	return t.To == nil
@@ -141,6 +185,8 @@ func (t *Transaction) Copy() *Transaction {
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
+	tt.AccessList = t.AccessList.Copy()
+
 	return tt
 }
 
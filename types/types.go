@@ -209,3 +209,13 @@ type OverrideAccount struct {
 }
 
 type StateOverride map[Address]OverrideAccount
+
+// BlockOverride overrides the fields of the block context in which the transactions are executed
+type BlockOverride struct {
+	Number     *uint64
+	Timestamp  *uint64
+	GasLimit   *uint64
+	Coinbase   *Address
+	Difficulty *Hash
+	BaseFee    *big.Int
+}